@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/in-rich/lib-go/deploy"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"log"
+	"strings"
+)
+
+// Compares the Firebase accounts of every tenant with the users table, and deletes the rows whose account no longer
+// exists. With -dry-run, the differences are only reported.
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report the differences without fixing them")
+	flag.Parse()
+
+	db, closeDB, err := deploy.OpenDB(config.App.Postgres.DSN)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer closeDB()
+
+	service := services.NewReconcileUsersService(
+		config.AuthClient,
+		dao.NewListUsersRepository(db),
+		dao.NewListUsersAfterRepository(db),
+		dao.NewDeleteUserRepository(db),
+		dao.NewListUserAliasesRepository(db),
+		dao.NewListCanonicalUserAliasesRepository(db),
+		services.NewRecordAuditEventService(dao.NewCreateAuditEventRepository(db), 0),
+		identityProviderConfigs(),
+	)
+
+	reports, err := service.Exec(context.Background(), &models.ReconcileUsers{DryRun: *dryRun})
+	if err != nil {
+		log.Fatalf("failed to reconcile users: %v", err)
+	}
+
+	for _, report := range reports {
+		tenant := report.TenantID
+		if tenant == "" {
+			tenant = "project"
+		}
+
+		fmt.Printf(
+			"%s: checked %d firebase accounts and %d rows\n", tenant, report.FirebaseUsersChecked, report.RowsChecked,
+		)
+
+		orphansAction := "deleted"
+		if report.DryRun {
+			orphansAction = "to delete"
+		}
+
+		printUIDs(fmt.Sprintf("orphan rows (%s)", orphansAction), report.Orphans)
+		printUIDs("firebase accounts without a row", report.MissingRows)
+		printUIDs("disabled firebase accounts with a row", report.Disabled)
+	}
+}
+
+func printUIDs(label string, uids []string) {
+	if len(uids) == 0 {
+		return
+	}
+
+	fmt.Printf("  %d %s: %s\n", len(uids), label, strings.Join(uids, ", "))
+}
+
+// identityProviderConfigs lists the external identity providers, whose users have no Firebase account.
+func identityProviderConfigs() []models.IdentityProviderConfig {
+	configs := make([]models.IdentityProviderConfig, len(config.App.IdentityProviders))
+	for i, identityProvider := range config.App.IdentityProviders {
+		configs[i] = models.IdentityProviderConfig{
+			Name:         identityProvider.Name,
+			TenantID:     identityProvider.TenantID,
+			EmailDomains: identityProvider.EmailDomains,
+		}
+	}
+
+	return configs
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	"time"
+)
+
+// runPeriodically runs job every interval, until ctx is canceled. Failures are logged, and do not stop the job.
+func runPeriodically(
+	ctx context.Context, logger monitor.Logger, name string, interval time.Duration, job func(ctx context.Context) error,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				logger.Error(err, name+" failed")
+			}
+		}
+	}
+}
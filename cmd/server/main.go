@@ -1,17 +1,24 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/in-rich/lib-go/deploy"
 	"github.com/in-rich/lib-go/monitor"
 	authentication_pb "github.com/in-rich/proto/proto-go/authentication"
 	"github.com/in-rich/uservice-authentication/config"
 	"github.com/in-rich/uservice-authentication/migrations"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
 	"github.com/in-rich/uservice-authentication/pkg/dao"
 	"github.com/in-rich/uservice-authentication/pkg/handlers"
+	"github.com/in-rich/uservice-authentication/pkg/models"
 	"github.com/in-rich/uservice-authentication/pkg/services"
 	"github.com/rs/zerolog"
+	"github.com/samber/lo"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func getLogger() monitor.GRPCLogger {
@@ -22,10 +29,74 @@ func getLogger() monitor.GRPCLogger {
 	return monitor.NewConsoleGRPCLogger()
 }
 
+func getPublisher(logger monitor.Logger) (clients.Publisher, func()) {
+	if config.App.PubSub.ProjectID == "" {
+		return clients.NewLogPublisher(logger), func() {}
+	}
+
+	publisher, closePublisher, err := clients.NewPubSubPublisher(context.Background(), clients.PubSubConfig{
+		ProjectID: config.App.PubSub.ProjectID,
+		TopicID:   config.App.PubSub.UserEventsTopic,
+	})
+	if err != nil {
+		logger.Fatal(err, "failed to connect to pub/sub")
+	}
+
+	return publisher, closePublisher
+}
+
+func getGeoIPLocator(logger monitor.Logger) (clients.GeoIPLocator, func()) {
+	// Without a database, sign-ins are only compared by device and network.
+	if config.App.Risk.GeoIPDatabase == "" {
+		locator, err := clients.NewStaticGeoIPLocator(nil)
+		if err != nil {
+			logger.Fatal(err, "failed to create geoip locator")
+		}
+
+		return locator, func() {}
+	}
+
+	locator, closeLocator, err := clients.NewMaxMindGeoIPLocator(config.App.Risk.GeoIPDatabase)
+	if err != nil {
+		logger.Fatal(err, "failed to open geoip database")
+	}
+
+	return locator, closeLocator
+}
+
+func getRateLimiter(logger monitor.Logger, takeRateLimitTokenDAO dao.TakeRateLimitTokenRepository) clients.RateLimiter {
+	switch config.App.RateLimit.Backend {
+	case "memory":
+		return clients.NewMemoryRateLimiter()
+	case "postgres":
+		return takeRateLimitTokenDAO
+	default:
+		logger.Fatal(fmt.Errorf("unknown backend %q", config.App.RateLimit.Backend), "failed to configure rate limits")
+		return nil
+	}
+}
+
+func getServiceIdentityVerifier() clients.ServiceIdentityVerifier {
+	if config.App.ServiceIdentity.Audience == "" {
+		return nil
+	}
+
+	return clients.NewGoogleServiceIdentityVerifier(config.App.ServiceIdentity.Audience)
+}
+
 func main() {
 	logger := getLogger()
 
 	logger.Info("Starting server")
+	// Checkpoints signed with an empty key could be forged along with a rewritten audit trail.
+	if config.App.Audit.CheckpointKey == "" {
+		logger.Fatal(services.ErrAuditCheckpointKeyMissing, "failed to configure audit checkpoints")
+	}
+	// Webhooks of a batch are sent one after another, and must all be sent before the batch can be claimed again.
+	if config.App.Webhooks.Lease < time.Duration(config.App.Webhooks.BatchSize)*config.App.Webhooks.Timeout {
+		logger.Fatal(services.ErrWebhookLeaseTooShort, "failed to configure webhooks")
+	}
+
 	db, closeDB, err := deploy.OpenDB(config.App.Postgres.DSN)
 	if err != nil {
 		logger.Fatal(err, "failed to connect to database")
@@ -51,31 +122,335 @@ func main() {
 		},
 	}
 
+	publisher, closePublisher := getPublisher(logger)
+	defer closePublisher()
+
+	geoIPLocator, closeGeoIPLocator := getGeoIPLocator(logger)
+	defer closeGeoIPLocator()
+
 	getUsersDAO := dao.NewGetUserRepository(db)
 	listUsersDAO := dao.NewListUsersRepository(db)
+	listUsersAfterDAO := dao.NewListUsersAfterRepository(db)
+	deleteUserDAO := dao.NewDeleteUserRepository(db)
 	createUserDAO := dao.NewCreateUserRepository(db)
+	provisionUserDAO := dao.NewProvisionUserRepository(db)
+	getUserActivityDAO := dao.NewGetUserActivityRepository(db)
+	getUserAliasDAO := dao.NewGetUserAliasRepository(db)
+	listUserAliasesDAO := dao.NewListUserAliasesRepository(db)
+	listCanonicalUserAliasesDAO := dao.NewListCanonicalUserAliasesRepository(db)
+	recordUserActivitiesDAO := dao.NewRecordUserActivitiesRepository(db)
+	listSignInContextsDAO := dao.NewListSignInContextsRepository(db)
+	recordSignInContextDAO := dao.NewRecordSignInContextRepository(db)
+	deleteStaleSignInContextsDAO := dao.NewDeleteStaleSignInContextsRepository(db)
 	updateUserDAO := dao.NewUpdateUserRepository(db)
+	getPersonalAccessTokenDAO := dao.NewGetPersonalAccessTokenRepository(db)
+	updatePersonalAccessTokenLastUsedDAO := dao.NewUpdatePersonalAccessTokenLastUsedRepository(db)
+	listEmailDomainRulesDAO := dao.NewListEmailDomainRulesRepository(db)
+	getMembershipDAO := dao.NewGetMembershipRepository(db)
+	listMembershipsDAO := dao.NewListMembershipsRepository(db)
+	getImpersonationSessionDAO := dao.NewGetImpersonationSessionRepository(db)
+	deleteExpiredInvitationsDAO := dao.NewDeleteExpiredInvitationsRepository(db)
+	createAuditEventDAO := dao.NewCreateAuditEventRepository(db)
+	createAuditEventsDAO := dao.NewCreateAuditEventsRepository(db)
+	getLastAuditEventDAO := dao.NewGetLastAuditEventRepository(db)
+	getLastAuditCheckpointDAO := dao.NewGetLastAuditCheckpointRepository(db)
+	createAuditCheckpointDAO := dao.NewCreateAuditCheckpointRepository(db)
+	claimOutboxEventsDAO := dao.NewClaimOutboxEventsRepository(db)
+	markOutboxEventPublishedDAO := dao.NewMarkOutboxEventPublishedRepository(db)
+	markOutboxEventFailedDAO := dao.NewMarkOutboxEventFailedRepository(db)
+	markOutboxEventDeadDAO := dao.NewMarkOutboxEventDeadRepository(db)
+	deleteOutboxEventsDAO := dao.NewDeleteOutboxEventsRepository(db)
+	createWebhookDeliveriesDAO := dao.NewCreateWebhookDeliveriesRepository(db)
+	claimWebhookDeliveriesDAO := dao.NewClaimWebhookDeliveriesRepository(db)
+	recordWebhookDeliveryAttemptDAO := dao.NewRecordWebhookDeliveryAttemptRepository(db)
+	takeRateLimitTokenDAO := dao.NewTakeRateLimitTokenRepository(db)
+	deleteIdleRateLimitBucketsDAO := dao.NewDeleteIdleRateLimitBucketsRepository(db)
 
-	authenticateService := services.NewAuthenticateService(config.AuthClient, getUsersDAO)
-	getUserService := services.NewGetUserService(config.AuthClient, getUsersDAO)
-	listUsersService := services.NewListUsersService(config.AuthClient, listUsersDAO)
-	updateUserService := services.NewUpdateUserService(authenticateService, createUserDAO, updateUserDAO)
+	recordAuditEventService := services.NewRecordAuditEventService(
+		createAuditEventDAO, config.App.Server.TrustedProxies,
+	)
+	// Authentications are audited through a buffer, so a burst of failures does not queue up on the audit chain lock.
+	auditEventBuffer := services.NewAuditEventBuffer()
+	bufferAuditEventService := services.NewBufferAuditEventService(auditEventBuffer, config.App.Server.TrustedProxies)
+	flushAuditEventsService := services.NewFlushAuditEventsService(auditEventBuffer, createAuditEventsDAO)
+
+	userActivityBuffer := services.NewUserActivityBuffer()
+	trackUserActivityService := services.NewTrackUserActivityService(
+		userActivityBuffer, config.App.Server.TrustedProxies,
+	)
+	flushUserActivityService := services.NewFlushUserActivityService(userActivityBuffer, recordUserActivitiesDAO)
+	signInRiskConfig := models.SignInRiskConfig{
+		StepUp:           config.App.Risk.StepUp,
+		StepUpMaxAuthAge: config.App.Risk.StepUpMaxAuthAge,
+		MaxTravelSpeed:   config.App.Risk.MaxTravelSpeed,
+		CacheTTL:         config.App.Risk.CacheTTL,
+		ContextRetention: config.App.Risk.ContextRetention,
+	}
+	assessSignInRiskService := services.NewAssessSignInRiskService(
+		geoIPLocator,
+		listSignInContextsDAO,
+		recordSignInContextDAO,
+		bufferAuditEventService,
+		config.App.Server.TrustedProxies,
+		signInRiskConfig,
+	)
+	deleteStaleSignInContextsService := services.NewDeleteStaleSignInContextsService(
+		deleteStaleSignInContextsDAO, signInRiskConfig,
+	)
+
+	checkEmailDomainService := services.NewCheckEmailDomainService(listEmailDomainRulesDAO, config.App.EmailDomains.CacheTTL)
+	checkEmailVerificationService := services.NewCheckEmailVerificationService(models.EmailVerificationPolicy{
+		Default:     models.EmailVerificationPolicyKind(config.App.EmailVerification.Policy),
+		GracePeriod: config.App.EmailVerification.GracePeriod,
+		Providers: lo.MapValues(
+			config.App.EmailVerification.Providers,
+			func(value string, _ string) models.EmailVerificationPolicyKind {
+				return models.EmailVerificationPolicyKind(value)
+			},
+		),
+	})
+
+	checkMFAService := services.NewCheckMFAService(models.MFAPolicy{
+		Required: config.App.MFA.Required,
+		Roles: lo.Map(config.App.MFA.Roles, func(role string, _ int) models.MembershipRole {
+			return models.MembershipRole(role)
+		}),
+	})
+
+	identityProviderConfigs := make([]models.IdentityProviderConfig, len(config.App.IdentityProviders))
+	identityProviders := make([]services.ExternalIdentityProvider, len(config.App.IdentityProviders))
+	for i, identityProvider := range config.App.IdentityProviders {
+		identityProviderConfigs[i] = models.IdentityProviderConfig{
+			Name:         identityProvider.Name,
+			TenantID:     identityProvider.TenantID,
+			EmailDomains: identityProvider.EmailDomains,
+		}
+		identityProviders[i] = services.ExternalIdentityProvider{
+			Config: identityProviderConfigs[i],
+			Provider: clients.NewOIDCIdentityProvider(clients.OIDCConfig{
+				Issuer:   identityProvider.Issuer,
+				ClientID: identityProvider.ClientID,
+			}),
+		}
+	}
+
+	authenticateService := services.NewAuthenticateService(
+		config.AuthClient,
+		getUsersDAO,
+		getPersonalAccessTokenDAO,
+		updatePersonalAccessTokenLastUsedDAO,
+		checkEmailDomainService,
+		checkEmailVerificationService,
+		getMembershipDAO,
+		listMembershipsDAO,
+		bufferAuditEventService,
+		provisionUserDAO,
+		models.UserProvisioningConfig{Enabled: config.App.Provisioning.Enabled},
+		trackUserActivityService,
+		assessSignInRiskService,
+		checkMFAService,
+		getImpersonationSessionDAO,
+		identityProviders,
+		getUserAliasDAO,
+	)
+	getUserService := services.NewGetUserService(
+		config.AuthClient, getUsersDAO, getUserActivityDAO, getUserAliasDAO, identityProviderConfigs,
+	)
+	listUsersService := services.NewListUsersService(
+		config.AuthClient, listUsersDAO, listUserAliasesDAO, identityProviderConfigs,
+	)
+	updateUserService := services.NewUpdateUserService(
+		authenticateService,
+		createUserDAO,
+		updateUserDAO,
+		recordAuditEventService,
+		config.App.Reauthentication.MaxAuthAge.UpdateUser,
+	)
+	deleteExpiredInvitationsService := services.NewDeleteExpiredInvitationsService(deleteExpiredInvitationsDAO)
+	createAuditCheckpointService := services.NewCreateAuditCheckpointService(
+		getLastAuditEventDAO, getLastAuditCheckpointDAO, createAuditCheckpointDAO,
+		[]byte(config.App.Audit.CheckpointKey),
+	)
+	enqueueWebhookDeliveriesService := services.NewEnqueueWebhookDeliveriesService(createWebhookDeliveriesDAO)
+	outboxRelayConfig := models.OutboxRelayConfig{
+		BatchSize:   config.App.Outbox.BatchSize,
+		Lease:       config.App.Outbox.Lease,
+		MinBackoff:  config.App.Outbox.MinBackoff,
+		MaxBackoff:  config.App.Outbox.MaxBackoff,
+		MaxAttempts: config.App.Outbox.MaxAttempts,
+		Retention:   config.App.Outbox.Retention,
+	}
+	relayOutboxEventsService := services.NewRelayOutboxEventsService(
+		claimOutboxEventsDAO,
+		markOutboxEventPublishedDAO,
+		markOutboxEventFailedDAO,
+		markOutboxEventDeadDAO,
+		clients.NewMultiPublisher(publisher, clients.PublisherFunc(enqueueWebhookDeliveriesService.Exec)),
+		outboxRelayConfig,
+	)
+	deleteOutboxEventsService := services.NewDeleteOutboxEventsService(deleteOutboxEventsDAO, outboxRelayConfig)
+	deliverWebhooksService := services.NewDeliverWebhooksService(
+		claimWebhookDeliveriesDAO,
+		recordWebhookDeliveryAttemptDAO,
+		clients.NewHTTPWebhookSender(clients.NewWebhookHTTPClient()),
+		models.WebhookDeliveryConfig{
+			BatchSize:   config.App.Webhooks.BatchSize,
+			Lease:       config.App.Webhooks.Lease,
+			Timeout:     config.App.Webhooks.Timeout,
+			MinBackoff:  config.App.Webhooks.MinBackoff,
+			MaxBackoff:  config.App.Webhooks.MaxBackoff,
+			MaxAttempts: config.App.Webhooks.MaxAttempts,
+		},
+	)
+
+	rateLimitConfig := models.RateLimitConfig{
+		Limits: map[models.RateLimitScope]models.RateLimit{
+			models.RateLimitScopePeerIP:  models.RateLimit(config.App.RateLimit.PeerIP),
+			models.RateLimitScopeUID:     models.RateLimit(config.App.RateLimit.UID),
+			models.RateLimitScopeService: models.RateLimit(config.App.RateLimit.Service),
+		},
+		IdleTTL: config.App.RateLimit.IdleTTL,
+	}
+	checkRateLimitService := services.NewCheckRateLimitService(
+		getRateLimiter(logger, takeRateLimitTokenDAO), rateLimitConfig,
+	)
+	deleteIdleRateLimitBucketsService := services.NewDeleteIdleRateLimitBucketsService(
+		deleteIdleRateLimitBucketsDAO, rateLimitConfig,
+	)
+
+	reconcileUsersService := services.NewReconcileUsersService(
+		config.AuthClient, listUsersDAO, listUsersAfterDAO, deleteUserDAO, listUserAliasesDAO,
+		listCanonicalUserAliasesDAO, recordAuditEventService, identityProviderConfigs,
+	)
 
 	authenticateHandler := handlers.NewAuthenticateHandler(authenticateService, logger)
 	getUserHandler := handlers.NewGetUserHandler(getUserService, logger)
 	listUsersHandler := handlers.NewListUsersHandler(listUsersService, logger)
 	updateUserHandler := handlers.NewUpdateUserHandler(updateUserService, logger)
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	go runPeriodically(
+		jobsCtx, logger, "FlushUserActivity", config.App.Activity.FlushInterval,
+		func(ctx context.Context) error {
+			_, err := flushUserActivityService.Exec(ctx)
+			return err
+		},
+	)
+	// Write the activity buffered since the last flush before exiting.
+	defer func() {
+		if _, err := flushUserActivityService.Exec(context.Background()); err != nil {
+			logger.Error(err, "FlushUserActivity failed")
+		}
+	}()
+	go runPeriodically(
+		jobsCtx, logger, "FlushAuditEvents", config.App.Audit.FlushInterval,
+		func(ctx context.Context) error {
+			_, err := flushAuditEventsService.Exec(ctx)
+			return err
+		},
+	)
+	// Write the audit events buffered since the last flush before exiting.
+	defer func() {
+		if _, err := flushAuditEventsService.Exec(context.Background()); err != nil {
+			logger.Error(err, "FlushAuditEvents failed")
+		}
+	}()
+	go runPeriodically(
+		jobsCtx, logger, "DeleteExpiredInvitations", config.App.Invitations.CleanupInterval,
+		func(ctx context.Context) error {
+			_, err := deleteExpiredInvitationsService.Exec(ctx)
+			return err
+		},
+	)
+	go runPeriodically(
+		jobsCtx, logger, "DeleteStaleSignInContexts", config.App.Risk.CleanupInterval,
+		func(ctx context.Context) error {
+			_, err := deleteStaleSignInContextsService.Exec(ctx)
+			return err
+		},
+	)
+	go runPeriodically(
+		jobsCtx, logger, "CreateAuditCheckpoint", config.App.Audit.CheckpointInterval,
+		func(ctx context.Context) error {
+			_, err := createAuditCheckpointService.Exec(ctx)
+			return err
+		},
+	)
+	go runPeriodically(
+		jobsCtx, logger, "RelayOutboxEvents", config.App.Outbox.RelayInterval,
+		func(ctx context.Context) error {
+			_, err := relayOutboxEventsService.Exec(ctx)
+			return err
+		},
+	)
+	go runPeriodically(
+		jobsCtx, logger, "DeleteOutboxEvents", config.App.Outbox.CleanupInterval,
+		func(ctx context.Context) error {
+			_, err := deleteOutboxEventsService.Exec(ctx)
+			return err
+		},
+	)
+	go runPeriodically(
+		jobsCtx, logger, "DeliverWebhooks", config.App.Webhooks.DeliveryInterval,
+		func(ctx context.Context) error {
+			_, err := deliverWebhooksService.Exec(ctx)
+			return err
+		},
+	)
+	if config.App.RateLimit.Backend == "postgres" {
+		go runPeriodically(
+			jobsCtx, logger, "DeleteIdleRateLimitBuckets", config.App.RateLimit.CleanupInterval,
+			func(ctx context.Context) error {
+				_, err := deleteIdleRateLimitBucketsService.Exec(ctx)
+				return err
+			},
+		)
+	}
+	go runPeriodically(
+		jobsCtx, logger, "ReconcileUsers", config.App.Reconciliation.Interval,
+		func(ctx context.Context) error {
+			reports, err := reconcileUsersService.Exec(ctx, &models.ReconcileUsers{DryRun: config.App.Reconciliation.DryRun})
+			for _, report := range reports {
+				if len(report.Orphans) > 0 || len(report.MissingRows) > 0 || len(report.Disabled) > 0 {
+					logger.Warn(fmt.Sprintf(
+						"users of tenant %q out of sync with firebase: %d orphan rows, %d missing rows, %d disabled accounts",
+						report.TenantID, len(report.Orphans), len(report.MissingRows), len(report.Disabled),
+					))
+				}
+			}
+
+			return err
+		},
+	)
+
 	logger.Info(fmt.Sprintf("Starting to listen on port %v", config.App.Server.Port))
 	listener, server, health := deploy.StartGRPCServer(logger, config.App.Server.Port, depCheck)
 	defer deploy.CloseGRPCServer(listener, server)
 	go health()
 
-	authentication_pb.RegisterAuthenticateServer(server, authenticateHandler)
+	// Each authentication attempt costs a token verification, so it is the one call limited.
+	authentication_pb.RegisterAuthenticateServer(
+		handlers.NewInterceptedRegistrar(server, handlers.NewRateLimitInterceptor(
+			checkRateLimitService, getServiceIdentityVerifier(), config.App.Server.TrustedProxies, logger,
+		)),
+		authenticateHandler,
+	)
 	authentication_pb.RegisterGetUserServer(server, getUserHandler)
 	authentication_pb.RegisterListUsersServer(server, listUsersHandler)
 	authentication_pb.RegisterUpdateUserServer(server, updateUserHandler)
 
+	// Deploys stop the server with SIGTERM. Serve returns once the requests in flight are done, so the deferred flushes
+	// write everything they buffered.
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stopSignals()
+	go func() {
+		<-signalCtx.Done()
+		logger.Info("Stopping server")
+		server.GracefulStop()
+	}()
+
 	logger.Info("Server started")
 	if err := server.Serve(listener); err != nil {
 		logger.Fatal(err, "failed to serve")
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/lib-go/deploy"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"log"
+	"os"
+)
+
+// Walks the audit trail and reports the first event that was edited or removed after being recorded. Exits with a
+// non-zero status when the chain is broken. A chain that missed two checkpoints in a row is reported as broken too,
+// since the latest checkpoints were likely deleted.
+func main() {
+	if config.App.Audit.CheckpointKey == "" {
+		log.Fatalf("failed to verify audit chain: %v", services.ErrAuditCheckpointKeyMissing)
+	}
+
+	db, closeDB, err := deploy.OpenDB(config.App.Postgres.DSN)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer closeDB()
+
+	service := services.NewVerifyAuditChainService(
+		dao.NewListAuditChainRepository(db),
+		dao.NewListAuditCheckpointsRepository(db),
+		[]byte(config.App.Audit.CheckpointKey),
+		2*config.App.Audit.CheckpointInterval,
+	)
+
+	report, err := service.Exec(context.Background())
+	if err != nil {
+		log.Fatalf("failed to verify audit chain: %v", err)
+	}
+
+	fmt.Printf("checked %d events and %d checkpoints\n", report.EventsChecked, report.CheckpointsChecked)
+
+	if report.FirstBrokenLink != nil {
+		fmt.Printf("audit chain broken at event %s: %s\n", report.FirstBrokenLink.EventID, report.FirstBrokenLink.Reason)
+		closeDB()
+		os.Exit(1)
+	}
+
+	fmt.Println("audit chain intact")
+}
@@ -3,6 +3,7 @@ package config
 import (
 	_ "embed"
 	"github.com/in-rich/lib-go/deploy"
+	"time"
 )
 
 //go:embed app.yaml
@@ -11,10 +12,145 @@ var appFile []byte
 type AppType struct {
 	Server struct {
 		Port int `yaml:"port"`
+		// TrustedProxies is the number of proxies in front of the server that append the address of their client to
+		// the x-forwarded-for header. Entries added before the outermost one are sent by the client, and ignored.
+		TrustedProxies int `yaml:"trusted-proxies"`
 	} `yaml:"server"`
 	Postgres struct {
 		DSN string `yaml:"dsn"`
 	} `yaml:"postgres"`
+	Provisioning struct {
+		// Enabled creates the row of a user on their first authentication, so they get a public identifier without
+		// calling UpdateUser.
+		Enabled bool `yaml:"enabled"`
+	} `yaml:"provisioning"`
+	Activity struct {
+		// FlushInterval is how often the buffered user activity is written to the database.
+		FlushInterval time.Duration `yaml:"flush-interval"`
+	} `yaml:"activity"`
+	Risk struct {
+		// GeoIPDatabase is the path of a MaxMind City database. When empty, sign-ins are not located.
+		GeoIPDatabase    string        `yaml:"geoip-database"`
+		StepUp           bool          `yaml:"step-up"`
+		StepUpMaxAuthAge time.Duration `yaml:"step-up-max-auth-age"`
+		// MaxTravelSpeed is in km/h.
+		MaxTravelSpeed float64       `yaml:"max-travel-speed"`
+		CacheTTL       time.Duration `yaml:"cache-ttl"`
+		// ContextRetention is how long a sign-in context is kept once unused.
+		ContextRetention time.Duration `yaml:"context-retention"`
+		CleanupInterval  time.Duration `yaml:"cleanup-interval"`
+	} `yaml:"risk"`
+	MFA struct {
+		// Required requires every user to sign in with a second factor.
+		Required bool `yaml:"required"`
+		// Roles requires a second factor from the members acting for an organization with one of these roles.
+		Roles []string `yaml:"roles"`
+	} `yaml:"mfa"`
+	Reauthentication struct {
+		// MaxAuthAge is how recently users must have signed in to perform each sensitive operation. Zero disables
+		// the check.
+		MaxAuthAge struct {
+			// UpdateUser only applies when an existing public identifier changes, so users can finish signing up.
+			UpdateUser                time.Duration `yaml:"update-user"`
+			DeleteUser                time.Duration `yaml:"delete-user"`
+			CreatePersonalAccessToken time.Duration `yaml:"create-personal-access-token"`
+		} `yaml:"max-auth-age"`
+	} `yaml:"reauthentication"`
+	ServiceIdentity struct {
+		// Audience is the audience of the identity tokens backend services authenticate with, usually the URL of this
+		// service. When empty, calling services are not identified.
+		Audience string `yaml:"audience"`
+	} `yaml:"service-identity"`
+	CustomTokens struct {
+		// Grants allow backend services, identified by the email of their service account, to mint custom tokens for
+		// some users of a tenant. A "*" UID allows every user of the tenant.
+		Grants []struct {
+			Service      string   `yaml:"service"`
+			TenantID     string   `yaml:"tenant-id"`
+			FirebaseUIDs []string `yaml:"firebase-uids"`
+			// Claims lists the developer claims the service may set.
+			Claims []string `yaml:"claims"`
+		} `yaml:"grants"`
+	} `yaml:"custom-tokens"`
+	RateLimit struct {
+		// Backend is "memory", counting calls per instance, or "postgres", sharing the buckets between instances.
+		Backend string    `yaml:"backend"`
+		PeerIP  RateLimit `yaml:"peer-ip"`
+		UID     RateLimit `yaml:"uid"`
+		Service RateLimit `yaml:"service"`
+		// IdleTTL is how long the buckets of the postgres backend are kept once unused.
+		IdleTTL         time.Duration `yaml:"idle-ttl"`
+		CleanupInterval time.Duration `yaml:"cleanup-interval"`
+	} `yaml:"rate-limit"`
+	// IdentityProviders are OIDC providers used in place of Firebase for some tenants or email domains.
+	IdentityProviders []struct {
+		// Name prefixes the UID of the users of the provider.
+		Name     string `yaml:"name"`
+		Issuer   string `yaml:"issuer"`
+		ClientID string `yaml:"client-id"`
+		// TenantID and EmailDomains select the users that must sign in with the provider.
+		TenantID     string   `yaml:"tenant-id"`
+		EmailDomains []string `yaml:"email-domains"`
+	} `yaml:"identity-providers"`
+	EmailDomains struct {
+		CacheTTL time.Duration `yaml:"cache-ttl"`
+	} `yaml:"email-domains"`
+	EmailVerification struct {
+		// Policy is one of "required", "grace-period" or "exempt".
+		Policy      string            `yaml:"policy"`
+		GracePeriod time.Duration     `yaml:"grace-period"`
+		Providers   map[string]string `yaml:"providers"`
+	} `yaml:"email-verification"`
+	Invitations struct {
+		TTL time.Duration `yaml:"ttl"`
+		// AcceptURL is the frontend page where invitees accept an invitation. The code is added as a query parameter.
+		AcceptURL       string        `yaml:"accept-url"`
+		CleanupInterval time.Duration `yaml:"cleanup-interval"`
+	} `yaml:"invitations"`
+	Audit struct {
+		// CheckpointKey signs audit checkpoints. It must be kept out of the database, so whoever can edit the audit
+		// trail cannot forge checkpoints.
+		CheckpointKey      string        `yaml:"checkpoint-key"`
+		CheckpointInterval time.Duration `yaml:"checkpoint-interval"`
+		// FlushInterval is how often the audit events of authentications are written to the audit trail.
+		FlushInterval time.Duration `yaml:"flush-interval"`
+	} `yaml:"audit"`
+	Outbox struct {
+		RelayInterval time.Duration `yaml:"relay-interval"`
+		BatchSize     int           `yaml:"batch-size"`
+		Lease         time.Duration `yaml:"lease"`
+		MinBackoff    time.Duration `yaml:"min-backoff"`
+		MaxBackoff    time.Duration `yaml:"max-backoff"`
+		MaxAttempts   int           `yaml:"max-attempts"`
+		// Retention is how long published and dead events are kept, to investigate missed events.
+		Retention       time.Duration `yaml:"retention"`
+		CleanupInterval time.Duration `yaml:"cleanup-interval"`
+	} `yaml:"outbox"`
+	PubSub struct {
+		// ProjectID is the project hosting the topics. When empty, events are only logged.
+		ProjectID       string `yaml:"project-id"`
+		UserEventsTopic string `yaml:"user-events-topic"`
+	} `yaml:"pubsub"`
+	Webhooks struct {
+		DeliveryInterval time.Duration `yaml:"delivery-interval"`
+		BatchSize        int           `yaml:"batch-size"`
+		Lease            time.Duration `yaml:"lease"`
+		Timeout          time.Duration `yaml:"timeout"`
+		MinBackoff       time.Duration `yaml:"min-backoff"`
+		MaxBackoff       time.Duration `yaml:"max-backoff"`
+		MaxAttempts      int           `yaml:"max-attempts"`
+	} `yaml:"webhooks"`
+	Reconciliation struct {
+		Interval time.Duration `yaml:"interval"`
+		// DryRun only logs the differences between Firebase and the users table, without fixing them.
+		DryRun bool `yaml:"dry-run"`
+	} `yaml:"reconciliation"`
+}
+
+// RateLimit is a token bucket, refilling at Rate tokens per second up to Burst. A zero Rate disables the limit.
+type RateLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
 }
 
 var App = deploy.LoadConfig[AppType](
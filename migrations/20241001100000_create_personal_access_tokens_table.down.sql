@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS personal_access_tokens_firebase_uid;
+
+--bun:split
+
+DROP TABLE IF EXISTS personal_access_tokens;
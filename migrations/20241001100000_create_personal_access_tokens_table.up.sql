@@ -0,0 +1,16 @@
+CREATE TABLE personal_access_tokens (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    firebase_uid VARCHAR(255) NOT NULL,
+    name         VARCHAR(255) NOT NULL,
+    token_hash   VARCHAR(64)  NOT NULL UNIQUE,
+    scopes       VARCHAR(255)[] NOT NULL DEFAULT '{}',
+
+    expires_at   TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX personal_access_tokens_firebase_uid ON personal_access_tokens(firebase_uid);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS email_domain_rules;
+
+--bun:split
+
+DROP TYPE IF EXISTS email_domain_rule_kind;
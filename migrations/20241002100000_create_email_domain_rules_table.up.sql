@@ -0,0 +1,17 @@
+CREATE TYPE email_domain_rule_kind AS ENUM ('allow', 'block');
+
+--bun:split
+
+CREATE TABLE email_domain_rules (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    -- An empty tenant targets users that do not belong to any tenant. Block rules with an empty tenant apply globally.
+    tenant_id  VARCHAR(255)           NOT NULL DEFAULT '',
+    -- Either an exact domain (example.com), or a wildcard matching any of its subdomains (*.example.com).
+    domain     VARCHAR(255)           NOT NULL,
+    kind       email_domain_rule_kind NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    UNIQUE (tenant_id, domain, kind)
+);
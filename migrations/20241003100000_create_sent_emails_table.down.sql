@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS sent_emails_recipient_kind_created_at;
+
+--bun:split
+
+DROP TABLE IF EXISTS sent_emails;
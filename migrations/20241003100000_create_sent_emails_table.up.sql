@@ -0,0 +1,12 @@
+CREATE TABLE sent_emails (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    recipient  VARCHAR(255) NOT NULL,
+    kind       VARCHAR(64)  NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX sent_emails_recipient_kind_created_at ON sent_emails(recipient, kind, created_at);
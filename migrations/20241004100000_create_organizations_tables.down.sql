@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS memberships_firebase_uid;
+
+--bun:split
+
+DROP TABLE IF EXISTS memberships;
+
+--bun:split
+
+DROP TABLE IF EXISTS organizations;
+
+--bun:split
+
+DROP TYPE IF EXISTS membership_role;
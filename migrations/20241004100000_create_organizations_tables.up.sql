@@ -0,0 +1,29 @@
+CREATE TYPE membership_role AS ENUM ('owner', 'admin', 'member');
+
+--bun:split
+
+CREATE TABLE organizations (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    name       VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE TABLE memberships (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    organization_id UUID            NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    firebase_uid    VARCHAR(255)    NOT NULL,
+    role            membership_role NOT NULL,
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    UNIQUE (organization_id, firebase_uid)
+);
+
+--bun:split
+
+CREATE INDEX memberships_firebase_uid ON memberships(firebase_uid);
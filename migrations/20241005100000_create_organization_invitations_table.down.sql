@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS organization_invitations_pending_email;
+
+--bun:split
+
+DROP TABLE IF EXISTS organization_invitations;
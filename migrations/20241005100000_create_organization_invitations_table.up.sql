@@ -0,0 +1,21 @@
+CREATE TABLE organization_invitations (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    organization_id UUID            NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    email           VARCHAR(255)    NOT NULL,
+    role            membership_role NOT NULL,
+    code_hash       VARCHAR(64)     NOT NULL UNIQUE,
+    invited_by      VARCHAR(255)    NOT NULL,
+
+    expires_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_at     TIMESTAMP WITH TIME ZONE,
+    accepted_by     VARCHAR(255),
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+-- Only one pending invitation can exist for a given email. Accepted invitations are kept as history.
+CREATE UNIQUE INDEX organization_invitations_pending_email
+    ON organization_invitations(organization_id, email)
+    WHERE accepted_at IS NULL;
@@ -0,0 +1,17 @@
+ALTER TABLE personal_access_tokens DROP COLUMN IF EXISTS tenant_id;
+
+--bun:split
+
+CREATE INDEX users_firebase_uid ON users(firebase_uid);
+
+--bun:split
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_tenant_id_firebase_uid_key;
+
+--bun:split
+
+ALTER TABLE users ADD CONSTRAINT users_firebase_uid_key UNIQUE (firebase_uid);
+
+--bun:split
+
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,19 @@
+-- An empty tenant targets users that do not belong to any Identity Platform tenant.
+ALTER TABLE users ADD COLUMN tenant_id VARCHAR(255) NOT NULL DEFAULT '';
+
+--bun:split
+
+-- Firebase UIDs are only unique within a tenant.
+ALTER TABLE users DROP CONSTRAINT users_firebase_uid_key;
+
+--bun:split
+
+ALTER TABLE users ADD CONSTRAINT users_tenant_id_firebase_uid_key UNIQUE (tenant_id, firebase_uid);
+
+--bun:split
+
+DROP INDEX IF EXISTS users_firebase_uid;
+
+--bun:split
+
+ALTER TABLE personal_access_tokens ADD COLUMN tenant_id VARCHAR(255) NOT NULL DEFAULT '';
@@ -0,0 +1,21 @@
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS audit_events_append_only;
+
+--bun:split
+
+DROP INDEX IF EXISTS audit_events_subject_uid;
+
+--bun:split
+
+DROP INDEX IF EXISTS audit_events_actor_uid;
+
+--bun:split
+
+DROP TABLE IF EXISTS audit_events;
+
+--bun:split
+
+DROP TYPE IF EXISTS audit_outcome;
@@ -0,0 +1,44 @@
+CREATE TYPE audit_outcome AS ENUM ('success', 'failure');
+
+--bun:split
+
+-- Events are ordered by their sequential ID, which also serves as a pagination cursor.
+CREATE TABLE audit_events (
+    id          BIGSERIAL PRIMARY KEY,
+
+    actor_uid   VARCHAR(255),
+    subject_uid VARCHAR(255),
+    target      VARCHAR(255),
+    action      VARCHAR(255)  NOT NULL,
+    outcome     audit_outcome NOT NULL,
+    reason      TEXT,
+
+    peer_ip     VARCHAR(64),
+    user_agent  TEXT,
+
+    diff        JSONB,
+
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX audit_events_actor_uid ON audit_events(actor_uid, id);
+
+--bun:split
+
+CREATE INDEX audit_events_subject_uid ON audit_events(subject_uid, id);
+
+--bun:split
+
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+--bun:split
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
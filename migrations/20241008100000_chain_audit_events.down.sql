@@ -0,0 +1,23 @@
+DROP TRIGGER IF EXISTS audit_events_no_truncate ON audit_events;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS audit_checkpoints_no_truncate ON audit_checkpoints;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS audit_checkpoints_append_only ON audit_checkpoints;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS audit_append_only;
+
+--bun:split
+
+DROP TABLE IF EXISTS audit_checkpoints;
+
+--bun:split
+
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS hash,
+    DROP COLUMN IF EXISTS previous_hash;
@@ -0,0 +1,45 @@
+-- Events recorded before chaining was introduced have no hash, and are not part of the chain.
+ALTER TABLE audit_events
+    ADD COLUMN previous_hash VARCHAR(64),
+    ADD COLUMN hash          VARCHAR(64);
+
+--bun:split
+
+-- Checkpoints are numbered without gaps, so a deleted checkpoint shows up as a missing sequence.
+CREATE TABLE audit_checkpoints (
+    id         BIGSERIAL PRIMARY KEY,
+
+    sequence   BIGINT      NOT NULL UNIQUE,
+    event_id   BIGINT      NOT NULL REFERENCES audit_events (id),
+    event_hash VARCHAR(64) NOT NULL,
+    signature  VARCHAR(64) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE FUNCTION audit_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+--bun:split
+
+CREATE TRIGGER audit_checkpoints_append_only
+    BEFORE UPDATE OR DELETE ON audit_checkpoints
+    FOR EACH ROW EXECUTE FUNCTION audit_append_only();
+
+--bun:split
+
+-- Row triggers do not fire on TRUNCATE.
+CREATE TRIGGER audit_checkpoints_no_truncate
+    BEFORE TRUNCATE ON audit_checkpoints
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_append_only();
+
+--bun:split
+
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_append_only();
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS outbox_events_pending_aggregate;
+
+--bun:split
+
+DROP INDEX IF EXISTS outbox_events_pending;
+
+--bun:split
+
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,23 @@
+-- Events are published in ID order for a given aggregate.
+CREATE TABLE outbox_events (
+    id              BIGSERIAL PRIMARY KEY,
+
+    aggregate_id    VARCHAR(255) NOT NULL,
+    type            VARCHAR(255) NOT NULL,
+    payload         JSONB        NOT NULL,
+
+    attempts        INTEGER      NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    published_at    TIMESTAMP WITH TIME ZONE,
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX outbox_events_pending ON outbox_events(next_attempt_at) WHERE published_at IS NULL;
+
+--bun:split
+
+CREATE INDEX outbox_events_pending_aggregate ON outbox_events(aggregate_id, id) WHERE published_at IS NULL;
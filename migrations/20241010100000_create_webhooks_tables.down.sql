@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS webhook_delivery_attempts_delivery;
+
+--bun:split
+
+DROP TABLE IF EXISTS webhook_delivery_attempts;
+
+--bun:split
+
+DROP INDEX IF EXISTS webhook_deliveries_pending;
+
+--bun:split
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+--bun:split
+
+DROP TYPE IF EXISTS webhook_delivery_status;
+
+--bun:split
+
+DROP TABLE IF EXISTS webhook_subscriptions;
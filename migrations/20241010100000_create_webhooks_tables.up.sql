@@ -0,0 +1,57 @@
+CREATE TABLE webhook_subscriptions (
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    url         TEXT           NOT NULL,
+    -- The secret must be readable to sign deliveries, so unlike other secrets it cannot be stored as a hash.
+    secret      VARCHAR(255)   NOT NULL,
+    event_types VARCHAR(255)[] NOT NULL,
+
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'delivered', 'dead');
+
+--bun:split
+
+CREATE TABLE webhook_deliveries (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    subscription_id UUID                    NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        VARCHAR(255)            NOT NULL,
+    event_type      VARCHAR(255)            NOT NULL,
+    payload         JSONB                   NOT NULL,
+
+    status          webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts        INTEGER                 NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Events are relayed at least once, but must only be delivered once to each subscription.
+    UNIQUE (subscription_id, event_id)
+);
+
+--bun:split
+
+CREATE INDEX webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+
+--bun:split
+
+CREATE TABLE webhook_delivery_attempts (
+    id          BIGSERIAL PRIMARY KEY,
+
+    delivery_id UUID    NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    -- NULL when the receiver could not be reached.
+    status_code INTEGER,
+    error       TEXT,
+    duration_ms INTEGER NOT NULL,
+
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX webhook_delivery_attempts_delivery ON webhook_delivery_attempts(delivery_id, id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS user_activities_last_seen_at;
+
+--bun:split
+
+DROP TABLE IF EXISTS user_activities;
@@ -0,0 +1,16 @@
+-- Activity is kept apart from the users table, since users are not required to have a row there.
+CREATE TABLE user_activities (
+    tenant_id            VARCHAR(255) NOT NULL,
+    firebase_uid         VARCHAR(255) NOT NULL,
+
+    last_seen_at         TIMESTAMP WITH TIME ZONE NOT NULL,
+    authentication_count BIGINT       NOT NULL DEFAULT 0,
+    last_peer_ip         VARCHAR(255),
+    last_user_agent      TEXT,
+
+    PRIMARY KEY (tenant_id, firebase_uid)
+);
+
+--bun:split
+
+CREATE INDEX user_activities_last_seen_at ON user_activities(tenant_id, last_seen_at);
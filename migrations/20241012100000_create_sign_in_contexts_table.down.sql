@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS sign_in_contexts_last_seen_at;
+
+--bun:split
+
+DROP TABLE IF EXISTS sign_in_contexts;
@@ -0,0 +1,23 @@
+-- A sign-in context is a device and network a user authenticated from. Devices are identified by a hash of their
+-- user agent, and networks are the /24 (IPv4) or /48 (IPv6) range of the address.
+CREATE TABLE sign_in_contexts (
+    id            BIGSERIAL PRIMARY KEY,
+
+    tenant_id     VARCHAR(255) NOT NULL,
+    firebase_uid  VARCHAR(255) NOT NULL,
+    device        VARCHAR(255) NOT NULL,
+    network       VARCHAR(255) NOT NULL,
+
+    country       VARCHAR(2),
+    latitude      DOUBLE PRECISION,
+    longitude     DOUBLE PRECISION,
+
+    first_seen_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_seen_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    UNIQUE (tenant_id, firebase_uid, device, network)
+);
+
+--bun:split
+
+CREATE INDEX sign_in_contexts_last_seen_at ON sign_in_contexts(tenant_id, firebase_uid, last_seen_at DESC);
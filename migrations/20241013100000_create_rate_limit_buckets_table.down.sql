@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS rate_limit_buckets_updated_at;
+
+--bun:split
+
+DROP TABLE IF EXISTS rate_limit_buckets;
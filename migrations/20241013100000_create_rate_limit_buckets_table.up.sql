@@ -0,0 +1,14 @@
+-- A rate limit bucket holds the tokens left for a key, as of updated_at. Buckets refill over time, so rows idle for
+-- long enough are full again and can be removed.
+CREATE TABLE rate_limit_buckets (
+    key        VARCHAR(512) PRIMARY KEY,
+
+    tokens     DOUBLE PRECISION NOT NULL,
+    allowed    BOOLEAN NOT NULL,
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+--bun:split
+
+CREATE INDEX rate_limit_buckets_updated_at ON rate_limit_buckets(updated_at);
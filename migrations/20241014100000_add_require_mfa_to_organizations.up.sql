@@ -0,0 +1,2 @@
+-- Members of an organization requiring MFA must sign in with a second factor to act for it.
+ALTER TABLE organizations ADD COLUMN require_mfa BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS impersonation_sessions_target_uid;
+
+--bun:split
+
+DROP INDEX IF EXISTS impersonation_sessions_impersonator_uid;
+
+--bun:split
+
+DROP TABLE IF EXISTS impersonation_sessions;
@@ -0,0 +1,23 @@
+-- An impersonation session lets a staff member authenticate as another user, with read-only scopes, until it expires
+-- or is ended.
+CREATE TABLE impersonation_sessions (
+    id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    tenant_id        VARCHAR(255) NOT NULL,
+    impersonator_uid VARCHAR(255) NOT NULL,
+    target_uid       VARCHAR(255) NOT NULL,
+    reason           TEXT NOT NULL,
+    scopes           VARCHAR(255)[] NOT NULL DEFAULT '{}',
+
+    expires_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at         TIMESTAMP WITH TIME ZONE,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX impersonation_sessions_impersonator_uid ON impersonation_sessions(impersonator_uid);
+
+--bun:split
+
+CREATE INDEX impersonation_sessions_target_uid ON impersonation_sessions(tenant_id, target_uid);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS user_aliases_canonical_uid;
+
+--bun:split
+
+DROP TABLE IF EXISTS user_aliases;
@@ -0,0 +1,15 @@
+-- A user alias points the UID of a linked account to the UID of the user it was linked to, whose row holds the data
+-- of both accounts.
+CREATE TABLE user_aliases (
+    tenant_id     VARCHAR(255) NOT NULL,
+    alias_uid     VARCHAR(255) NOT NULL,
+    canonical_uid VARCHAR(255) NOT NULL,
+
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (tenant_id, alias_uid)
+);
+
+--bun:split
+
+CREATE INDEX user_aliases_canonical_uid ON user_aliases(tenant_id, canonical_uid);
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS outbox_events_pending_aggregate;
+
+--bun:split
+
+CREATE INDEX outbox_events_pending_aggregate ON outbox_events(aggregate_id, id) WHERE published_at IS NULL;
+
+--bun:split
+
+DROP INDEX IF EXISTS outbox_events_pending;
+
+--bun:split
+
+CREATE INDEX outbox_events_pending ON outbox_events(next_attempt_at) WHERE published_at IS NULL;
+
+--bun:split
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS dead_at;
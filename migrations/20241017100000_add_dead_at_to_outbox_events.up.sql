@@ -0,0 +1,19 @@
+-- Events that failed too many times are set aside, so they no longer block the later events of their aggregate.
+ALTER TABLE outbox_events ADD COLUMN dead_at TIMESTAMP WITH TIME ZONE;
+
+--bun:split
+
+DROP INDEX IF EXISTS outbox_events_pending;
+
+--bun:split
+
+CREATE INDEX outbox_events_pending ON outbox_events(next_attempt_at) WHERE published_at IS NULL AND dead_at IS NULL;
+
+--bun:split
+
+DROP INDEX IF EXISTS outbox_events_pending_aggregate;
+
+--bun:split
+
+CREATE INDEX outbox_events_pending_aggregate ON outbox_events(aggregate_id, id)
+    WHERE published_at IS NULL AND dead_at IS NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS sign_in_contexts_stale;
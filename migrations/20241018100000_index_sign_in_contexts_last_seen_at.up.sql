@@ -0,0 +1,2 @@
+-- Sign-in contexts unused for too long are deleted periodically.
+CREATE INDEX sign_in_contexts_stale ON sign_in_contexts(last_seen_at);
@@ -0,0 +1,100 @@
+package clients
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+var ErrEmailTemplateNotFound = errors.New("email template not found")
+
+// EmailRenderer renders the subject and HTML body of an email, in the requested locale.
+type EmailRenderer interface {
+	Render(name string, locale string, data interface{}) (subject string, html string, err error)
+}
+
+// emailTemplate holds the same file parsed twice. The subject is a mail header, not HTML, so it must not be escaped.
+type emailTemplate struct {
+	subject *texttemplate.Template
+	body    *template.Template
+}
+
+type htmlEmailRendererImpl struct {
+	templates     map[string]*emailTemplate
+	defaultLocale string
+}
+
+func (r *htmlEmailRendererImpl) lookup(name string, locale string) (*emailTemplate, bool) {
+	candidates := []string{locale}
+
+	// Fallback from regional variants to the base language (fr-CA -> fr).
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, base)
+	}
+
+	candidates = append(candidates, r.defaultLocale)
+
+	for _, candidate := range candidates {
+		if tpl, ok := r.templates[name+"."+strings.ToLower(candidate)]; ok {
+			return tpl, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *htmlEmailRendererImpl) Render(name string, locale string, data interface{}) (string, string, error) {
+	tpl, ok := r.lookup(name, locale)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s (%s)", ErrEmailTemplateNotFound, name, locale)
+	}
+
+	subject := new(bytes.Buffer)
+	if err := tpl.subject.ExecuteTemplate(subject, "subject", data); err != nil {
+		return "", "", err
+	}
+
+	body := new(bytes.Buffer)
+	if err := tpl.body.ExecuteTemplate(body, "body", data); err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(subject.String()), body.String(), nil
+}
+
+// NewHTMLEmailRenderer loads every template matching "<name>.<locale>.html" at the root of fsys. Each template must
+// define a "subject" and a "body" block.
+func NewHTMLEmailRenderer(fsys fs.FS, defaultLocale string) (EmailRenderer, error) {
+	files, err := fs.Glob(fsys, "*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]*emailTemplate, len(files))
+	for _, file := range files {
+		subject, err := texttemplate.ParseFS(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := template.ParseFS(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		templates[strings.ToLower(strings.TrimSuffix(path.Base(file), ".html"))] = &emailTemplate{
+			subject: subject,
+			body:    body,
+		}
+	}
+
+	return &htmlEmailRendererImpl{
+		templates:     templates,
+		defaultLocale: defaultLocale,
+	}, nil
+}
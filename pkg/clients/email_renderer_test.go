@@ -0,0 +1,116 @@
+package clients_test
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/templates"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var emailRendererFS = fstest.MapFS{
+	"greeting.en.html": &fstest.MapFile{
+		Data: []byte(`{{define "subject"}}Hello {{.}}{{end}}{{define "body"}}<p>Hello {{.}}</p>{{end}}`),
+	},
+	"greeting.fr.html": &fstest.MapFile{
+		Data: []byte(`{{define "subject"}}Bonjour {{.}}{{end}}{{define "body"}}<p>Bonjour {{.}}</p>{{end}}`),
+	},
+}
+
+func TestHTMLEmailRenderer(t *testing.T) {
+	testData := []struct {
+		name string
+
+		template string
+		locale   string
+		data     interface{}
+
+		expectSubject string
+		expectHTML    string
+		expectErr     error
+	}{
+		{
+			name:          "Render",
+			template:      "greeting",
+			locale:        "fr",
+			data:          "Jean",
+			expectSubject: "Bonjour Jean",
+			expectHTML:    "<p>Bonjour Jean</p>",
+		},
+		{
+			name:          "RegionalLocale",
+			template:      "greeting",
+			locale:        "fr-CA",
+			data:          "Jean",
+			expectSubject: "Bonjour Jean",
+			expectHTML:    "<p>Bonjour Jean</p>",
+		},
+		{
+			name:          "DefaultLocale",
+			template:      "greeting",
+			locale:        "de",
+			data:          "Hans",
+			expectSubject: "Hello Hans",
+			expectHTML:    "<p>Hello Hans</p>",
+		},
+		{
+			name:          "EscapeHTML",
+			template:      "greeting",
+			locale:        "en",
+			data:          "<script>",
+			expectSubject: "Hello <script>",
+			expectHTML:    "<p>Hello &lt;script&gt;</p>",
+		},
+		{
+			name:          "Apostrophe",
+			template:      "greeting",
+			locale:        "en",
+			data:          "O'Brien",
+			expectSubject: "Hello O'Brien",
+			expectHTML:    "<p>Hello O&#39;Brien</p>",
+		},
+		{
+			name:      "TemplateNotFound",
+			template:  "farewell",
+			locale:    "en",
+			expectErr: clients.ErrEmailTemplateNotFound,
+		},
+	}
+
+	renderer, err := clients.NewHTMLEmailRenderer(emailRendererFS, "en")
+	require.NoError(t, err)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			subject, html, err := renderer.Render(tt.template, tt.locale, tt.data)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expectSubject, subject)
+			require.Equal(t, tt.expectHTML, html)
+		})
+	}
+}
+
+// Make sure the bundled templates are valid, and can be rendered in every supported locale.
+func TestHTMLEmailRendererBundledTemplates(t *testing.T) {
+	renderer, err := clients.NewHTMLEmailRenderer(templates.Emails, "en")
+	require.NoError(t, err)
+
+	data := map[string]string{
+		"Email":            "user@gmail.com",
+		"DisplayName":      "User",
+		"Link":             "https://example.com/action?code=foo",
+		"OrganizationName": "Organization",
+	}
+
+	for _, name := range []string{"email_verification", "password_reset", "organization_invitation"} {
+		for _, locale := range []string{"en", "fr"} {
+			subject, html, err := renderer.Render(name, locale, data)
+
+			require.NoError(t, err)
+			require.NotEmpty(t, subject)
+			require.True(t, strings.Contains(html, "https://example.com/action?code=foo"))
+		}
+	}
+}
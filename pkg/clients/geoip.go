@@ -0,0 +1,18 @@
+package clients
+
+import "net"
+
+type GeoLocation struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country.
+	Country string
+	// HasCoordinates is false when the database only knows the country of the address.
+	HasCoordinates bool
+	Latitude       float64
+	Longitude      float64
+}
+
+// GeoIPLocator resolves IP addresses against an offline database, so no address leaves the service.
+type GeoIPLocator interface {
+	// Locate returns nil when the address is not in the database, like private addresses.
+	Locate(ip net.IP) (*GeoLocation, error)
+}
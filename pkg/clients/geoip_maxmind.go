@@ -0,0 +1,39 @@
+package clients
+
+import (
+	"github.com/oschwald/geoip2-golang"
+	"net"
+)
+
+// maxMindGeoIPLocator reads a MaxMind City database, like GeoLite2-City.
+type maxMindGeoIPLocator struct {
+	db *geoip2.Reader
+}
+
+func (l *maxMindGeoIPLocator) Locate(ip net.IP) (*GeoLocation, error) {
+	record, err := l.db.City(ip)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Country.IsoCode == "" {
+		return nil, nil
+	}
+
+	return &GeoLocation{
+		Country:        record.Country.IsoCode,
+		HasCoordinates: record.Location.AccuracyRadius > 0,
+		Latitude:       record.Location.Latitude,
+		Longitude:      record.Location.Longitude,
+	}, nil
+}
+
+// NewMaxMindGeoIPLocator opens the database at path. The returned function closes it.
+func NewMaxMindGeoIPLocator(path string) (GeoIPLocator, func(), error) {
+	db, err := geoip2.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &maxMindGeoIPLocator{db: db}, func() { _ = db.Close() }, nil
+}
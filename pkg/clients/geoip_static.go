@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"net"
+	"sort"
+)
+
+type staticGeoIPNetwork struct {
+	network  *net.IPNet
+	location *GeoLocation
+}
+
+// StaticGeoIPLocator locates addresses from a fixed list of networks. It is meant for tests and local development,
+// and locates nothing when the list is empty.
+type StaticGeoIPLocator struct {
+	networks []*staticGeoIPNetwork
+}
+
+func (l *StaticGeoIPLocator) Locate(ip net.IP) (*GeoLocation, error) {
+	for _, network := range l.networks {
+		if network.network.Contains(ip) {
+			return network.location, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// NewStaticGeoIPLocator maps networks, in CIDR notation, to their location. The most specific network wins.
+func NewStaticGeoIPLocator(networks map[string]*GeoLocation) (*StaticGeoIPLocator, error) {
+	locator := new(StaticGeoIPLocator)
+
+	for cidr, location := range networks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		locator.networks = append(locator.networks, &staticGeoIPNetwork{network: network, location: location})
+	}
+
+	sort.Slice(locator.networks, func(i, j int) bool {
+		sizeI, _ := locator.networks[i].network.Mask.Size()
+		sizeJ, _ := locator.networks[j].network.Mask.Size()
+		return sizeI > sizeJ
+	})
+
+	return locator, nil
+}
@@ -0,0 +1,52 @@
+package clients_test
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+func TestStaticGeoIPLocator(t *testing.T) {
+	locator, err := clients.NewStaticGeoIPLocator(map[string]*clients.GeoLocation{
+		"203.0.113.0/24":   {Country: "FR", HasCoordinates: true, Latitude: 48.8566, Longitude: 2.3522},
+		"203.0.113.128/25": {Country: "DE"},
+		"2001:db8::/32":    {Country: "US"},
+	})
+	require.NoError(t, err)
+
+	testData := []struct {
+		name   string
+		ip     string
+		expect *clients.GeoLocation
+	}{
+		{
+			name:   "IPv4",
+			ip:     "203.0.113.7",
+			expect: &clients.GeoLocation{Country: "FR", HasCoordinates: true, Latitude: 48.8566, Longitude: 2.3522},
+		},
+		{
+			name:   "MostSpecificNetwork",
+			ip:     "203.0.113.200",
+			expect: &clients.GeoLocation{Country: "DE"},
+		},
+		{
+			name:   "IPv6",
+			ip:     "2001:db8::1",
+			expect: &clients.GeoLocation{Country: "US"},
+		},
+		{
+			name: "Unknown",
+			ip:   "10.0.0.1",
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			location, err := locator.Locate(net.ParseIP(data.ip))
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, location)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package clients
+
+import (
+	"context"
+	"time"
+)
+
+// IdentityClaims describe the user an external identity provider signed in.
+type IdentityClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	AuthTime      time.Time
+	// AuthMethods are the authentication methods used to sign in, like "pwd" or "mfa" (RFC 8176).
+	AuthMethods []string
+}
+
+// IdentityProvider verifies the ID tokens of an identity provider other than Firebase.
+type IdentityProvider interface {
+	// Issuer identifies the tokens issued by the provider.
+	Issuer() string
+	VerifyIDToken(ctx context.Context, token string) (*IdentityClaims, error)
+}
@@ -0,0 +1,325 @@
+package clients
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oidcMinKeysRefreshInterval bounds how often keys are fetched again when a token is signed with an unknown key, so
+// forged tokens cannot be used to flood the provider. Discovery is retried at the same pace after a failure, so a
+// provider that is down is not called for every token.
+const oidcMinKeysRefreshInterval = time.Minute
+
+// oidcHTTPClient bounds the calls to providers, which would otherwise hold up the verifications waiting on them.
+var oidcHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+var (
+	ErrOIDCDiscovery      = errors.New("oidc discovery failed")
+	ErrOIDCUnknownKey     = errors.New("oidc token signed with an unknown key")
+	ErrOIDCInvalidToken   = errors.New("invalid oidc token")
+	ErrOIDCUnsupportedKey = errors.New("unsupported oidc key")
+)
+
+type OIDCConfig struct {
+	// Issuer is the URL of the provider, as found in the iss claim of its tokens.
+	Issuer string
+	// ClientID is the audience tokens must be issued for.
+	ClientID string
+	// HTTPClient defaults to a client with a 10 seconds timeout.
+	HTTPClient *http.Client
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type oidcJSONWebKey struct {
+	KeyID   string `json:"kid"`
+	KeyType string `json:"kty"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// oidcIdentityProvider discovers the keys of an OpenID Connect provider, and verifies its ID tokens against them.
+// Discovery happens on the first verification, so the service starts even when the provider is unreachable.
+type oidcIdentityProvider struct {
+	config OIDCConfig
+
+	// fetchMu serializes the calls to the provider, and guards the fields below it. Verifications with a known key
+	// only wait on mu.
+	fetchMu           sync.Mutex
+	jwksURI           string
+	discoveryFailedAt time.Time
+	keysFetchedAt     time.Time
+
+	mu   sync.RWMutex
+	keys map[string]crypto.PublicKey
+}
+
+func (p *oidcIdentityProvider) Issuer() string {
+	return p.config.Issuer
+}
+
+func (p *oidcIdentityProvider) getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	client := p.config.HTTPClient
+	if client == nil {
+		client = oidcHTTPClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %s", url, res.Status)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (p *oidcIdentityProvider) discover(ctx context.Context) error {
+	if p.jwksURI != "" {
+		return nil
+	}
+
+	if time.Since(p.discoveryFailedAt) < oidcMinKeysRefreshInterval {
+		return errors.Join(ErrOIDCDiscovery, errors.New("failed recently"))
+	}
+
+	if err := p.fetchDiscoveryDocument(ctx); err != nil {
+		p.discoveryFailedAt = time.Now()
+		return err
+	}
+
+	return nil
+}
+
+func (p *oidcIdentityProvider) fetchDiscoveryDocument(ctx context.Context) error {
+	var document oidcDiscoveryDocument
+
+	err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+"/.well-known/openid-configuration", &document)
+	if err != nil {
+		return errors.Join(ErrOIDCDiscovery, err)
+	}
+
+	// Prevents a provider from vouching for another one (OpenID Connect Discovery 1.0, section 4.3).
+	if document.Issuer != p.config.Issuer {
+		return errors.Join(ErrOIDCDiscovery, fmt.Errorf("issuer mismatch: %q", document.Issuer))
+	}
+	if document.JWKSURI == "" {
+		return errors.Join(ErrOIDCDiscovery, errors.New("missing jwks_uri"))
+	}
+
+	p.jwksURI = document.JWKSURI
+
+	return nil
+}
+
+func decodeBase64URLInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(raw), nil
+}
+
+func parseJSONWebKey(key *oidcJSONWebKey) (crypto.PublicKey, error) {
+	switch key.KeyType {
+	case "RSA":
+		n, err := decodeBase64URLInt(key.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBase64URLInt(key.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()}
+
+		curve, ok := curves[key.Curve]
+		if !ok {
+			return nil, fmt.Errorf("%w: curve %q", ErrOIDCUnsupportedKey, key.Curve)
+		}
+
+		x, err := decodeBase64URLInt(key.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBase64URLInt(key.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("%w: key type %q", ErrOIDCUnsupportedKey, key.KeyType)
+	}
+}
+
+func (p *oidcIdentityProvider) fetchKeys(ctx context.Context) error {
+	var set struct {
+		Keys []*oidcJSONWebKey `json:"keys"`
+	}
+
+	// Failed fetches count too, so a provider that is down is not called for every token.
+	p.keysFetchedAt = time.Now()
+
+	if err := p.getJSON(ctx, p.jwksURI, &set); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		// Encryption keys and keys of unknown types are of no use to verify signatures.
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		publicKey, err := parseJSONWebKey(key)
+		if err != nil {
+			continue
+		}
+
+		keys[key.KeyID] = publicKey
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *oidcIdentityProvider) cachedKey(keyID string) (crypto.PublicKey, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	key, ok := p.keys[keyID]
+
+	return key, ok
+}
+
+// key returns the public key with the given ID. Keys are fetched again when the ID is unknown, since providers rotate
+// their keys.
+func (p *oidcIdentityProvider) key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	if key, ok := p.cachedKey(keyID); ok {
+		return key, nil
+	}
+
+	p.fetchMu.Lock()
+	defer p.fetchMu.Unlock()
+
+	// The keys may have been fetched while waiting for the lock.
+	if key, ok := p.cachedKey(keyID); ok {
+		return key, nil
+	}
+
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+
+	if time.Since(p.keysFetchedAt) < oidcMinKeysRefreshInterval {
+		return nil, ErrOIDCUnknownKey
+	}
+
+	if err := p.fetchKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := p.cachedKey(keyID); ok {
+		return key, nil
+	}
+
+	return nil, ErrOIDCUnknownKey
+}
+
+func (p *oidcIdentityProvider) VerifyIDToken(ctx context.Context, token string) (*IdentityClaims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+
+	claims := jwt.MapClaims{}
+
+	_, err := parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return p.key(ctx, keyID)
+	})
+	if err != nil {
+		return nil, errors.Join(ErrOIDCInvalidToken, err)
+	}
+
+	if !claims.VerifyIssuer(p.config.Issuer, true) {
+		return nil, errors.Join(ErrOIDCInvalidToken, errors.New("invalid issuer"))
+	}
+	if !claims.VerifyAudience(p.config.ClientID, true) {
+		return nil, errors.Join(ErrOIDCInvalidToken, errors.New("invalid audience"))
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.Join(ErrOIDCInvalidToken, errors.New("missing expiration"))
+	}
+
+	identity := new(IdentityClaims)
+	identity.Subject, _ = claims["sub"].(string)
+	identity.Email, _ = claims["email"].(string)
+	identity.EmailVerified, _ = claims["email_verified"].(bool)
+	identity.Name, _ = claims["name"].(string)
+
+	if identity.Subject == "" {
+		return nil, errors.Join(ErrOIDCInvalidToken, errors.New("missing subject"))
+	}
+
+	// The sign-in time is optional, and defaults to the issuance of the token.
+	if authTime, ok := claims["auth_time"].(float64); ok {
+		identity.AuthTime = time.Unix(int64(authTime), 0)
+	} else if issuedAt, ok := claims["iat"].(float64); ok {
+		identity.AuthTime = time.Unix(int64(issuedAt), 0)
+	}
+
+	if methods, ok := claims["amr"].([]interface{}); ok {
+		for _, method := range methods {
+			if method, ok := method.(string); ok {
+				identity.AuthMethods = append(identity.AuthMethods, method)
+			}
+		}
+	}
+
+	return identity, nil
+}
+
+func NewOIDCIdentityProvider(config OIDCConfig) IdentityProvider {
+	return &oidcIdentityProvider{
+		config: config,
+	}
+}
@@ -0,0 +1,315 @@
+package clients_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeOIDCServer serves the discovery document and keys of an OpenID Connect provider.
+type fakeOIDCServer struct {
+	*httptest.Server
+
+	// issuer overrides the issuer of the discovery document.
+	issuer     string
+	keys       []map[string]string
+	keyFetches atomic.Int32
+	// down makes discovery fail.
+	down        atomic.Bool
+	discoveries atomic.Int32
+}
+
+func newFakeOIDCServer(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) *fakeOIDCServer {
+	encode := func(value *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(value.Bytes())
+	}
+
+	server := &fakeOIDCServer{
+		keys: []map[string]string{
+			{"kid": "rsa-1", "kty": "RSA", "use": "sig", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+			{"kid": "ec-1", "kty": "EC", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+			{"kid": "enc-1", "kty": "RSA", "use": "enc", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		server.discoveries.Add(1)
+		if server.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		issuer := server.URL
+		if server.issuer != "" {
+			issuer = server.issuer
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		server.keyFetches.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": server.keys})
+	})
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestOIDCIdentityProvider(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	testData := []struct {
+		name string
+
+		discoveryIssuer string
+		method          jwt.SigningMethod
+		keyID           string
+		key             interface{}
+		claims          func(issuer string) jwt.MapClaims
+
+		expect           *clients.IdentityClaims
+		expectKeyFetches int32
+		expectErr        error
+	}{
+		{
+			name:   "RSA",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-1",
+			key:    rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss":            issuer,
+					"aud":            "client-1",
+					"sub":            "subject-1",
+					"email":          "user@acme.com",
+					"email_verified": true,
+					"name":           "User One",
+					"iat":            now.Unix(),
+					"exp":            now.Add(time.Hour).Unix(),
+					"auth_time":      now.Add(-time.Minute).Unix(),
+					"amr":            []string{"pwd", "mfa"},
+				}
+			},
+			expect: &clients.IdentityClaims{
+				Subject:       "subject-1",
+				Email:         "user@acme.com",
+				EmailVerified: true,
+				Name:          "User One",
+				AuthTime:      time.Unix(now.Add(-time.Minute).Unix(), 0),
+				AuthMethods:   []string{"pwd", "mfa"},
+			},
+			expectKeyFetches: 1,
+		},
+		{
+			name:   "EC",
+			method: jwt.SigningMethodES256,
+			keyID:  "ec-1",
+			key:    ecKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss": issuer,
+					"aud": []string{"client-2", "client-1"},
+					"sub": "subject-1",
+					"iat": now.Unix(),
+					"exp": now.Add(time.Hour).Unix(),
+				}
+			},
+			expect: &clients.IdentityClaims{
+				Subject:  "subject-1",
+				AuthTime: time.Unix(now.Unix(), 0),
+			},
+			expectKeyFetches: 1,
+		},
+		{
+			name:   "WrongAudience",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-1",
+			key:    rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-2", "sub": "subject-1", "exp": now.Add(time.Hour).Unix()}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "WrongIssuer",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-1",
+			key:    rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss": "https://evil.example.com",
+					"aud": "client-1",
+					"sub": "subject-1",
+					"exp": now.Add(time.Hour).Unix(),
+				}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "Expired",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-1",
+			key:    rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1", "exp": now.Add(-time.Hour).Unix()}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "NoExpiration",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-1",
+			key:    rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1"}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "InvalidSignature",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-1",
+			key:    otherRSAKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1", "exp": now.Add(time.Hour).Unix()}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCInvalidToken,
+		},
+		{
+			name:   "UnknownKey",
+			method: jwt.SigningMethodRS256,
+			keyID:  "rsa-2",
+			key:    otherRSAKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1", "exp": now.Add(time.Hour).Unix()}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCUnknownKey,
+		},
+		{
+			// Keys meant for encryption are ignored.
+			name:   "EncryptionKey",
+			method: jwt.SigningMethodRS256,
+			keyID:  "enc-1",
+			key:    rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1", "exp": now.Add(time.Hour).Unix()}
+			},
+			expectKeyFetches: 1,
+			expectErr:        clients.ErrOIDCUnknownKey,
+		},
+		{
+			// Symmetric algorithms would let anyone knowing the public key sign tokens.
+			name:   "SymmetricAlgorithm",
+			method: jwt.SigningMethodHS256,
+			keyID:  "rsa-1",
+			key:    []byte("secret"),
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1", "exp": now.Add(time.Hour).Unix()}
+			},
+			expectErr: clients.ErrOIDCInvalidToken,
+		},
+		{
+			name:            "DiscoveryIssuerMismatch",
+			discoveryIssuer: "https://evil.example.com",
+			method:          jwt.SigningMethodRS256,
+			keyID:           "rsa-1",
+			key:             rsaKey,
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{"iss": issuer, "aud": "client-1", "sub": "subject-1", "exp": now.Add(time.Hour).Unix()}
+			},
+			expectErr: clients.ErrOIDCDiscovery,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			server := newFakeOIDCServer(t, rsaKey, ecKey)
+			server.issuer = data.discoveryIssuer
+
+			token := jwt.NewWithClaims(data.method, data.claims(server.URL))
+			token.Header["kid"] = data.keyID
+
+			signed, err := token.SignedString(data.key)
+			require.NoError(t, err)
+
+			provider := clients.NewOIDCIdentityProvider(clients.OIDCConfig{Issuer: server.URL, ClientID: "client-1"})
+
+			require.Equal(t, server.URL, provider.Issuer())
+
+			identity, err := provider.VerifyIDToken(context.TODO(), signed)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, identity)
+
+			// Unknown keys are not fetched again right away.
+			_, _ = provider.VerifyIDToken(context.TODO(), signed)
+			require.Equal(t, data.expectKeyFetches, server.keyFetches.Load())
+		})
+	}
+}
+
+func TestOIDCIdentityProviderDiscoveryFailure(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	server := newFakeOIDCServer(t, rsaKey, ecKey)
+	server.down.Store(true)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": server.URL,
+		"aud": "client-1",
+		"sub": "subject-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "rsa-1"
+
+	signed, err := token.SignedString(rsaKey)
+	require.NoError(t, err)
+
+	provider := clients.NewOIDCIdentityProvider(clients.OIDCConfig{Issuer: server.URL, ClientID: "client-1"})
+
+	_, err = provider.VerifyIDToken(context.TODO(), signed)
+	require.ErrorIs(t, err, clients.ErrOIDCDiscovery)
+
+	// A provider that is down is not called again for every token.
+	server.down.Store(false)
+
+	_, err = provider.VerifyIDToken(context.TODO(), signed)
+	require.ErrorIs(t, err, clients.ErrOIDCDiscovery)
+	require.Equal(t, int32(1), server.discoveries.Load())
+	require.Zero(t, server.keyFetches.Load())
+}
@@ -0,0 +1,13 @@
+package clients
+
+import "context"
+
+type Email struct {
+	To      string
+	Subject string
+	HTML    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, email *Email) error
+}
@@ -0,0 +1,32 @@
+package clients
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryMailer keeps sent emails in memory instead of delivering them. It is meant for tests and local development.
+type MemoryMailer struct {
+	mu     sync.Mutex
+	emails []*Email
+}
+
+func (m *MemoryMailer) Send(_ context.Context, email *Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.emails = append(m.emails, email)
+	return nil
+}
+
+// Emails returns a copy of every email sent so far, in order.
+func (m *MemoryMailer) Emails() []*Email {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Email(nil), m.emails...)
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return new(MemoryMailer)
+}
@@ -0,0 +1,109 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type smtpMailerImpl struct {
+	config SMTPConfig
+}
+
+func (m *smtpMailerImpl) buildMessage(email *Email) []byte {
+	msg := new(bytes.Buffer)
+
+	_, _ = fmt.Fprintf(msg, "From: %s\r\n", m.config.From)
+	_, _ = fmt.Fprintf(msg, "To: %s\r\n", email.To)
+	_, _ = fmt.Fprintf(msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	_, _ = fmt.Fprintf(msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	_, _ = fmt.Fprint(msg, "MIME-Version: 1.0\r\n")
+	_, _ = fmt.Fprint(msg, "Content-Type: text/html; charset=\"utf-8\"\r\n")
+	_, _ = fmt.Fprint(msg, "\r\n")
+	_, _ = fmt.Fprint(msg, email.HTML)
+
+	return msg.Bytes()
+}
+
+// deliver runs the same exchange as smtp.SendMail, on a connection that is already open.
+func (m *smtpMailerImpl) deliver(conn net.Conn, email *Email) error {
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return err
+		}
+	}
+
+	if m.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(m.config.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(email.To); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(m.buildMessage(email)); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (m *smtpMailerImpl) Send(ctx context.Context, email *Email) error {
+	dialer := new(net.Dialer)
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// net/smtp ignores contexts. Expiring the connection makes any pending read or write fail as soon as ctx is done.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := m.deliver(conn, email); err != nil {
+		return errors.Join(ctx.Err(), err)
+	}
+
+	return nil
+}
+
+func NewSMTPMailer(config SMTPConfig) Mailer {
+	return &smtpMailerImpl{
+		config: config,
+	}
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockEmailRenderer is an autogenerated mock type for the EmailRenderer type
+type MockEmailRenderer struct {
+	mock.Mock
+}
+
+type MockEmailRenderer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmailRenderer) EXPECT() *MockEmailRenderer_Expecter {
+	return &MockEmailRenderer_Expecter{mock: &_m.Mock}
+}
+
+// Render provides a mock function with given fields: name, locale, data
+func (_m *MockEmailRenderer) Render(name string, locale string, data interface{}) (string, string, error) {
+	ret := _m.Called(name, locale, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Render")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, interface{}) (string, string, error)); ok {
+		return rf(name, locale, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, interface{}) string); ok {
+		r0 = rf(name, locale, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, interface{}) string); ok {
+		r1 = rf(name, locale, data)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, interface{}) error); ok {
+		r2 = rf(name, locale, data)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockEmailRenderer_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type MockEmailRenderer_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - name string
+//   - locale string
+//   - data interface{}
+func (_e *MockEmailRenderer_Expecter) Render(name interface{}, locale interface{}, data interface{}) *MockEmailRenderer_Render_Call {
+	return &MockEmailRenderer_Render_Call{Call: _e.mock.On("Render", name, locale, data)}
+}
+
+func (_c *MockEmailRenderer_Render_Call) Run(run func(name string, locale string, data interface{})) *MockEmailRenderer_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockEmailRenderer_Render_Call) Return(subject string, html string, err error) *MockEmailRenderer_Render_Call {
+	_c.Call.Return(subject, html, err)
+	return _c
+}
+
+func (_c *MockEmailRenderer_Render_Call) RunAndReturn(run func(string, string, interface{}) (string, string, error)) *MockEmailRenderer_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEmailRenderer creates a new instance of MockEmailRenderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmailRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmailRenderer {
+	mock := &MockEmailRenderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import (
+	clients "github.com/in-rich/uservice-authentication/pkg/clients"
+	mock "github.com/stretchr/testify/mock"
+
+	net "net"
+)
+
+// MockGeoIPLocator is an autogenerated mock type for the GeoIPLocator type
+type MockGeoIPLocator struct {
+	mock.Mock
+}
+
+type MockGeoIPLocator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGeoIPLocator) EXPECT() *MockGeoIPLocator_Expecter {
+	return &MockGeoIPLocator_Expecter{mock: &_m.Mock}
+}
+
+// Locate provides a mock function with given fields: ip
+func (_m *MockGeoIPLocator) Locate(ip net.IP) (*clients.GeoLocation, error) {
+	ret := _m.Called(ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Locate")
+	}
+
+	var r0 *clients.GeoLocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(net.IP) (*clients.GeoLocation, error)); ok {
+		return rf(ip)
+	}
+	if rf, ok := ret.Get(0).(func(net.IP) *clients.GeoLocation); ok {
+		r0 = rf(ip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clients.GeoLocation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(net.IP) error); ok {
+		r1 = rf(ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGeoIPLocator_Locate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Locate'
+type MockGeoIPLocator_Locate_Call struct {
+	*mock.Call
+}
+
+// Locate is a helper method to define mock.On call
+//   - ip net.IP
+func (_e *MockGeoIPLocator_Expecter) Locate(ip interface{}) *MockGeoIPLocator_Locate_Call {
+	return &MockGeoIPLocator_Locate_Call{Call: _e.mock.On("Locate", ip)}
+}
+
+func (_c *MockGeoIPLocator_Locate_Call) Run(run func(ip net.IP)) *MockGeoIPLocator_Locate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(net.IP))
+	})
+	return _c
+}
+
+func (_c *MockGeoIPLocator_Locate_Call) Return(_a0 *clients.GeoLocation, _a1 error) *MockGeoIPLocator_Locate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGeoIPLocator_Locate_Call) RunAndReturn(run func(net.IP) (*clients.GeoLocation, error)) *MockGeoIPLocator_Locate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGeoIPLocator creates a new instance of MockGeoIPLocator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGeoIPLocator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGeoIPLocator {
+	mock := &MockGeoIPLocator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import (
+	context "context"
+
+	clients "github.com/in-rich/uservice-authentication/pkg/clients"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIdentityProvider is an autogenerated mock type for the IdentityProvider type
+type MockIdentityProvider struct {
+	mock.Mock
+}
+
+type MockIdentityProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdentityProvider) EXPECT() *MockIdentityProvider_Expecter {
+	return &MockIdentityProvider_Expecter{mock: &_m.Mock}
+}
+
+// Issuer provides a mock function with no fields
+func (_m *MockIdentityProvider) Issuer() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issuer")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockIdentityProvider_Issuer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issuer'
+type MockIdentityProvider_Issuer_Call struct {
+	*mock.Call
+}
+
+// Issuer is a helper method to define mock.On call
+func (_e *MockIdentityProvider_Expecter) Issuer() *MockIdentityProvider_Issuer_Call {
+	return &MockIdentityProvider_Issuer_Call{Call: _e.mock.On("Issuer")}
+}
+
+func (_c *MockIdentityProvider_Issuer_Call) Run(run func()) *MockIdentityProvider_Issuer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIdentityProvider_Issuer_Call) Return(_a0 string) *MockIdentityProvider_Issuer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIdentityProvider_Issuer_Call) RunAndReturn(run func() string) *MockIdentityProvider_Issuer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyIDToken provides a mock function with given fields: ctx, token
+func (_m *MockIdentityProvider) VerifyIDToken(ctx context.Context, token string) (*clients.IdentityClaims, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyIDToken")
+	}
+
+	var r0 *clients.IdentityClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*clients.IdentityClaims, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *clients.IdentityClaims); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clients.IdentityClaims)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIdentityProvider_VerifyIDToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyIDToken'
+type MockIdentityProvider_VerifyIDToken_Call struct {
+	*mock.Call
+}
+
+// VerifyIDToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockIdentityProvider_Expecter) VerifyIDToken(ctx interface{}, token interface{}) *MockIdentityProvider_VerifyIDToken_Call {
+	return &MockIdentityProvider_VerifyIDToken_Call{Call: _e.mock.On("VerifyIDToken", ctx, token)}
+}
+
+func (_c *MockIdentityProvider_VerifyIDToken_Call) Run(run func(ctx context.Context, token string)) *MockIdentityProvider_VerifyIDToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIdentityProvider_VerifyIDToken_Call) Return(_a0 *clients.IdentityClaims, _a1 error) *MockIdentityProvider_VerifyIDToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIdentityProvider_VerifyIDToken_Call) RunAndReturn(run func(context.Context, string) (*clients.IdentityClaims, error)) *MockIdentityProvider_VerifyIDToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdentityProvider creates a new instance of MockIdentityProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdentityProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdentityProvider {
+	mock := &MockIdentityProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import (
+	context "context"
+
+	clients "github.com/in-rich/uservice-authentication/pkg/clients"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPublisherFunc is an autogenerated mock type for the PublisherFunc type
+type MockPublisherFunc struct {
+	mock.Mock
+}
+
+type MockPublisherFunc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPublisherFunc) EXPECT() *MockPublisherFunc_Expecter {
+	return &MockPublisherFunc_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, event
+func (_m *MockPublisherFunc) Execute(ctx context.Context, event *clients.UserEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.UserEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPublisherFunc_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockPublisherFunc_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *clients.UserEvent
+func (_e *MockPublisherFunc_Expecter) Execute(ctx interface{}, event interface{}) *MockPublisherFunc_Execute_Call {
+	return &MockPublisherFunc_Execute_Call{Call: _e.mock.On("Execute", ctx, event)}
+}
+
+func (_c *MockPublisherFunc_Execute_Call) Run(run func(ctx context.Context, event *clients.UserEvent)) *MockPublisherFunc_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*clients.UserEvent))
+	})
+	return _c
+}
+
+func (_c *MockPublisherFunc_Execute_Call) Return(_a0 error) *MockPublisherFunc_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPublisherFunc_Execute_Call) RunAndReturn(run func(context.Context, *clients.UserEvent) error) *MockPublisherFunc_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPublisherFunc creates a new instance of MockPublisherFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPublisherFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPublisherFunc {
+	mock := &MockPublisherFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
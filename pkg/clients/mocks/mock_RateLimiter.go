@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRateLimiter is an autogenerated mock type for the RateLimiter type
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+type MockRateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimiter) EXPECT() *MockRateLimiter_Expecter {
+	return &MockRateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// TakeRateLimitToken provides a mock function with given fields: ctx, key, rate, burst
+func (_m *MockRateLimiter) TakeRateLimitToken(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	ret := _m.Called(ctx, key, rate, burst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeRateLimitToken")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, int) (time.Duration, error)); ok {
+		return rf(ctx, key, rate, burst)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, int) time.Duration); ok {
+		r0 = rf(ctx, key, rate, burst)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64, int) error); ok {
+		r1 = rf(ctx, key, rate, burst)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRateLimiter_TakeRateLimitToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeRateLimitToken'
+type MockRateLimiter_TakeRateLimitToken_Call struct {
+	*mock.Call
+}
+
+// TakeRateLimitToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - rate float64
+//   - burst int
+func (_e *MockRateLimiter_Expecter) TakeRateLimitToken(ctx interface{}, key interface{}, rate interface{}, burst interface{}) *MockRateLimiter_TakeRateLimitToken_Call {
+	return &MockRateLimiter_TakeRateLimitToken_Call{Call: _e.mock.On("TakeRateLimitToken", ctx, key, rate, burst)}
+}
+
+func (_c *MockRateLimiter_TakeRateLimitToken_Call) Run(run func(ctx context.Context, key string, rate float64, burst int)) *MockRateLimiter_TakeRateLimitToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_TakeRateLimitToken_Call) Return(_a0 time.Duration, _a1 error) *MockRateLimiter_TakeRateLimitToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRateLimiter_TakeRateLimitToken_Call) RunAndReturn(run func(context.Context, string, float64, int) (time.Duration, error)) *MockRateLimiter_TakeRateLimitToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRateLimiter creates a new instance of MockRateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimiter {
+	mock := &MockRateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockServiceIdentityVerifier is an autogenerated mock type for the ServiceIdentityVerifier type
+type MockServiceIdentityVerifier struct {
+	mock.Mock
+}
+
+type MockServiceIdentityVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockServiceIdentityVerifier) EXPECT() *MockServiceIdentityVerifier_Expecter {
+	return &MockServiceIdentityVerifier_Expecter{mock: &_m.Mock}
+}
+
+// VerifyServiceIdentity provides a mock function with given fields: ctx, token
+func (_m *MockServiceIdentityVerifier) VerifyServiceIdentity(ctx context.Context, token string) (string, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyServiceIdentity")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceIdentityVerifier_VerifyServiceIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyServiceIdentity'
+type MockServiceIdentityVerifier_VerifyServiceIdentity_Call struct {
+	*mock.Call
+}
+
+// VerifyServiceIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockServiceIdentityVerifier_Expecter) VerifyServiceIdentity(ctx interface{}, token interface{}) *MockServiceIdentityVerifier_VerifyServiceIdentity_Call {
+	return &MockServiceIdentityVerifier_VerifyServiceIdentity_Call{Call: _e.mock.On("VerifyServiceIdentity", ctx, token)}
+}
+
+func (_c *MockServiceIdentityVerifier_VerifyServiceIdentity_Call) Run(run func(ctx context.Context, token string)) *MockServiceIdentityVerifier_VerifyServiceIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceIdentityVerifier_VerifyServiceIdentity_Call) Return(_a0 string, _a1 error) *MockServiceIdentityVerifier_VerifyServiceIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceIdentityVerifier_VerifyServiceIdentity_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockServiceIdentityVerifier_VerifyServiceIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockServiceIdentityVerifier creates a new instance of MockServiceIdentityVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockServiceIdentityVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockServiceIdentityVerifier {
+	mock := &MockServiceIdentityVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package clientsmocks
+
+import (
+	context "context"
+
+	clients "github.com/in-rich/uservice-authentication/pkg/clients"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWebhookSender is an autogenerated mock type for the WebhookSender type
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+type MockWebhookSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookSender) EXPECT() *MockWebhookSender_Expecter {
+	return &MockWebhookSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, webhook
+func (_m *MockWebhookSender) Send(ctx context.Context, webhook *clients.Webhook) (int, error) {
+	ret := _m.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.Webhook) (int, error)); ok {
+		return rf(ctx, webhook)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.Webhook) int); ok {
+		r0 = rf(ctx, webhook)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clients.Webhook) error); ok {
+		r1 = rf(ctx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockWebhookSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *clients.Webhook
+func (_e *MockWebhookSender_Expecter) Send(ctx interface{}, webhook interface{}) *MockWebhookSender_Send_Call {
+	return &MockWebhookSender_Send_Call{Call: _e.mock.On("Send", ctx, webhook)}
+}
+
+func (_c *MockWebhookSender_Send_Call) Run(run func(ctx context.Context, webhook *clients.Webhook)) *MockWebhookSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*clients.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockWebhookSender_Send_Call) Return(_a0 int, _a1 error) *MockWebhookSender_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookSender_Send_Call) RunAndReturn(run func(context.Context, *clients.Webhook) (int, error)) *MockWebhookSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookSender creates a new instance of MockWebhookSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookSender {
+	mock := &MockWebhookSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,34 @@
+package clients
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	UserEventCreated = "user.created"
+	UserEventUpdated = "user.updated"
+	UserEventDeleted = "user.deleted"
+	UserEventMerged  = "user.merged"
+
+	UserEventSuspiciousSignIn = "user.suspicious_sign_in"
+)
+
+// UserEvent notifies other services of a change to a user. The same event may be published more than once, so
+// consumers must deduplicate on ID.
+type UserEvent struct {
+	ID   string
+	Type string
+
+	TenantID         string
+	FirebaseUID      string
+	PublicIdentifier string
+	// MergedUID is only set on merge events, with the UID of the user that was merged into FirebaseUID.
+	MergedUID string
+
+	OccurredAt time.Time
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event *UserEvent) error
+}
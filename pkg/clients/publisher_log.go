@@ -0,0 +1,26 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/in-rich/lib-go/monitor"
+)
+
+// LogPublisher writes events to the logs instead of sending them to a broker. It is meant for local development.
+type LogPublisher struct {
+	logger monitor.Logger
+}
+
+func (p *LogPublisher) Publish(_ context.Context, event *UserEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("published user event: " + string(payload))
+	return nil
+}
+
+func NewLogPublisher(logger monitor.Logger) *LogPublisher {
+	return &LogPublisher{logger: logger}
+}
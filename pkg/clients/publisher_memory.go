@@ -0,0 +1,32 @@
+package clients
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryPublisher keeps published events in memory. It is meant for tests and local development.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []*UserEvent
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, event *UserEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events returns a copy of every event published so far, in order.
+func (p *MemoryPublisher) Events() []*UserEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*UserEvent(nil), p.events...)
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return new(MemoryPublisher)
+}
@@ -0,0 +1,33 @@
+package clients
+
+import "context"
+
+// PublisherFunc adapts a function to the Publisher interface.
+type PublisherFunc func(ctx context.Context, event *UserEvent) error
+
+func (f PublisherFunc) Publish(ctx context.Context, event *UserEvent) error {
+	return f(ctx, event)
+}
+
+type multiPublisherImpl struct {
+	publishers []Publisher
+}
+
+// Publish stops at the first failure. The event is then published again to every publisher, which is acceptable
+// since events are delivered at least once anyway.
+func (p *multiPublisherImpl) Publish(ctx context.Context, event *UserEvent) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewMultiPublisher publishes each event to every given publisher, in order.
+func NewMultiPublisher(publishers ...Publisher) Publisher {
+	return &multiPublisherImpl{
+		publishers: publishers,
+	}
+}
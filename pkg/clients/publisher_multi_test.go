@@ -0,0 +1,52 @@
+package clients_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var errPublish = errors.New("publish failed")
+
+func TestMultiPublisher(t *testing.T) {
+	testData := []struct {
+		name string
+
+		firstErr error
+
+		expectSecond bool
+		expectErr    error
+	}{
+		{
+			name:         "Publish",
+			expectSecond: true,
+		},
+		{
+			name:      "StopOnFailure",
+			firstErr:  errPublish,
+			expectErr: errPublish,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			event := &clients.UserEvent{ID: "1", Type: clients.UserEventCreated}
+
+			first := clients.PublisherFunc(func(_ context.Context, _ *clients.UserEvent) error {
+				return data.firstErr
+			})
+			second := clients.NewMemoryPublisher()
+
+			err := clients.NewMultiPublisher(first, second).Publish(context.TODO(), event)
+
+			require.ErrorIs(t, err, data.expectErr)
+			if data.expectSecond {
+				require.Equal(t, []*clients.UserEvent{event}, second.Events())
+			} else {
+				require.Empty(t, second.Events())
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"cloud.google.com/go/pubsub"
+	"context"
+	events_pb "github.com/in-rich/uservice-authentication/pkg/events"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	PubSubAttributeEventType     = "event-type"
+	PubSubAttributeSchemaVersion = "schema-version"
+
+	// UserEventSchemaVersion must be increased with any breaking change to events_pb.UserEvent.
+	UserEventSchemaVersion = "1"
+)
+
+type PubSubConfig struct {
+	ProjectID string
+	TopicID   string
+}
+
+type pubSubPublisherImpl struct {
+	topic *pubsub.Topic
+}
+
+// userEventOrderingKey groups the events of a single user, so subscribers receive them in order. UIDs are only
+// unique within a tenant.
+func userEventOrderingKey(event *UserEvent) string {
+	if event.TenantID == "" {
+		return event.FirebaseUID
+	}
+
+	return event.TenantID + "/" + event.FirebaseUID
+}
+
+func (p *pubSubPublisherImpl) Publish(ctx context.Context, event *UserEvent) error {
+	data, err := proto.Marshal(&events_pb.UserEvent{
+		Id:               event.ID,
+		Type:             event.Type,
+		TenantId:         event.TenantID,
+		FirebaseUid:      event.FirebaseUID,
+		PublicIdentifier: event.PublicIdentifier,
+		OccurredAt:       timestamppb.New(event.OccurredAt),
+		MergedUid:        event.MergedUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	orderingKey := userEventOrderingKey(event)
+
+	_, err = p.topic.Publish(ctx, &pubsub.Message{
+		Data:        data,
+		OrderingKey: orderingKey,
+		Attributes: map[string]string{
+			PubSubAttributeEventType:     event.Type,
+			PubSubAttributeSchemaVersion: UserEventSchemaVersion,
+		},
+	}).Get(ctx)
+	if err != nil {
+		// Pub/Sub pauses an ordering key after a failure, so later messages are not published out of order. The
+		// relay retries the failed event before any later one, so the key can be resumed right away.
+		p.topic.ResumePublish(orderingKey)
+		return err
+	}
+
+	return nil
+}
+
+// NewPubSubPublisher connects to Pub/Sub, or to the emulator when PUBSUB_EMULATOR_HOST is set. The topic must
+// already exist. The returned function flushes pending messages and closes the connection.
+func NewPubSubPublisher(ctx context.Context, config PubSubConfig) (Publisher, func(), error) {
+	client, err := pubsub.NewClient(ctx, config.ProjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	topic := client.Topic(config.TopicID)
+	topic.EnableMessageOrdering = true
+
+	closePublisher := func() {
+		topic.Stop()
+		_ = client.Close()
+	}
+
+	return &pubSubPublisherImpl{topic: topic}, closePublisher, nil
+}
@@ -0,0 +1,200 @@
+package clients_test
+
+import (
+	"cloud.google.com/go/pubsub"
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	events_pb "github.com/in-rich/uservice-authentication/pkg/events"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"os"
+	"testing"
+	"time"
+)
+
+// The Pub/Sub emulator accepts any project id.
+const pubSubTestProjectID = "demo-uservice-authentication"
+
+type receivedMessage struct {
+	orderingKey string
+	attributes  map[string]string
+	event       *events_pb.UserEvent
+}
+
+// createPubSubFixtures creates a fresh topic, and an ordered subscription to it, on the emulator.
+func createPubSubFixtures(t *testing.T, client *pubsub.Client, id string) *pubsub.Subscription {
+	topic, err := client.CreateTopic(context.TODO(), id)
+	require.NoError(t, err)
+
+	subscription, err := client.CreateSubscription(context.TODO(), id, pubsub.SubscriptionConfig{
+		Topic:                 topic,
+		EnableMessageOrdering: true,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = subscription.Delete(context.TODO())
+		_ = topic.Delete(context.TODO())
+	})
+
+	return subscription
+}
+
+func receiveMessages(t *testing.T, subscription *pubsub.Subscription, count int) []*receivedMessage {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	received := make(chan *receivedMessage, count)
+	err := subscription.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		msg.Ack()
+
+		event := new(events_pb.UserEvent)
+		if err := proto.Unmarshal(msg.Data, event); err != nil {
+			t.Error(err)
+			return
+		}
+
+		received <- &receivedMessage{orderingKey: msg.OrderingKey, attributes: msg.Attributes, event: event}
+		if len(received) == count {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+	close(received)
+
+	messages := make([]*receivedMessage, 0, count)
+	for msg := range received {
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
+
+func TestPubSubPublisher(t *testing.T) {
+	if os.Getenv("PUBSUB_EMULATOR_HOST") == "" {
+		t.Skip("PUBSUB_EMULATOR_HOST is not set")
+	}
+
+	occurredAt := time.Date(2024, 10, 9, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		events []*clients.UserEvent
+
+		expect []*receivedMessage
+	}{
+		{
+			name: "Publish",
+			events: []*clients.UserEvent{
+				{
+					ID:               "1",
+					Type:             clients.UserEventCreated,
+					FirebaseUID:      "user-one-uid",
+					PublicIdentifier: "public-identifier-1",
+					OccurredAt:       occurredAt,
+				},
+			},
+			expect: []*receivedMessage{
+				{
+					orderingKey: "user-one-uid",
+					attributes: map[string]string{
+						clients.PubSubAttributeEventType:     clients.UserEventCreated,
+						clients.PubSubAttributeSchemaVersion: clients.UserEventSchemaVersion,
+					},
+					event: &events_pb.UserEvent{
+						Id:               "1",
+						Type:             clients.UserEventCreated,
+						FirebaseUid:      "user-one-uid",
+						PublicIdentifier: "public-identifier-1",
+						OccurredAt:       timestamppb.New(occurredAt),
+					},
+				},
+			},
+		},
+		{
+			name: "PublishInOrder",
+			events: []*clients.UserEvent{
+				{
+					ID:               "1",
+					Type:             clients.UserEventUpdated,
+					TenantID:         "tenant-1",
+					FirebaseUID:      "user-one-uid",
+					PublicIdentifier: "public-identifier-1",
+					OccurredAt:       occurredAt,
+				},
+				{
+					ID:               "2",
+					Type:             clients.UserEventDeleted,
+					TenantID:         "tenant-1",
+					FirebaseUID:      "user-one-uid",
+					PublicIdentifier: "public-identifier-1",
+					OccurredAt:       occurredAt.Add(time.Minute),
+				},
+			},
+			expect: []*receivedMessage{
+				{
+					orderingKey: "tenant-1/user-one-uid",
+					attributes: map[string]string{
+						clients.PubSubAttributeEventType:     clients.UserEventUpdated,
+						clients.PubSubAttributeSchemaVersion: clients.UserEventSchemaVersion,
+					},
+					event: &events_pb.UserEvent{
+						Id:               "1",
+						Type:             clients.UserEventUpdated,
+						TenantId:         "tenant-1",
+						FirebaseUid:      "user-one-uid",
+						PublicIdentifier: "public-identifier-1",
+						OccurredAt:       timestamppb.New(occurredAt),
+					},
+				},
+				{
+					orderingKey: "tenant-1/user-one-uid",
+					attributes: map[string]string{
+						clients.PubSubAttributeEventType:     clients.UserEventDeleted,
+						clients.PubSubAttributeSchemaVersion: clients.UserEventSchemaVersion,
+					},
+					event: &events_pb.UserEvent{
+						Id:               "2",
+						Type:             clients.UserEventDeleted,
+						TenantId:         "tenant-1",
+						FirebaseUid:      "user-one-uid",
+						PublicIdentifier: "public-identifier-1",
+						OccurredAt:       timestamppb.New(occurredAt.Add(time.Minute)),
+					},
+				},
+			},
+		},
+	}
+
+	client, err := pubsub.NewClient(context.TODO(), pubSubTestProjectID)
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			subscription := createPubSubFixtures(t, client, "user-events-"+data.name)
+
+			publisher, closePublisher, err := clients.NewPubSubPublisher(context.TODO(), clients.PubSubConfig{
+				ProjectID: pubSubTestProjectID,
+				TopicID:   "user-events-" + data.name,
+			})
+			require.NoError(t, err)
+			defer closePublisher()
+
+			for _, event := range data.events {
+				require.NoError(t, publisher.Publish(context.TODO(), event))
+			}
+
+			messages := receiveMessages(t, subscription, len(data.expect))
+
+			require.Len(t, messages, len(data.expect))
+			for i, expect := range data.expect {
+				require.Equal(t, expect.orderingKey, messages[i].orderingKey)
+				require.Equal(t, expect.attributes, messages[i].attributes)
+				require.True(t, proto.Equal(expect.event, messages[i].event), "%v != %v", expect.event, messages[i].event)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package clients
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter holds token buckets. Buckets are shared by every instance of the service when backed by a shared store,
+// such as dao.TakeRateLimitTokenRepository, which implements this interface.
+type RateLimiter interface {
+	// TakeRateLimitToken takes a token from the bucket of key, which refills at rate tokens per second up to burst.
+	// It returns 0 if a token was available, or how long to wait until one is.
+	TakeRateLimitToken(ctx context.Context, key string, rate float64, burst int) (time.Duration, error)
+}
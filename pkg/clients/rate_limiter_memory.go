@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxMemoryRateLimitBuckets bounds the memory used by the buckets, since a key is created for every peer address.
+const maxMemoryRateLimitBuckets = 100000
+
+type memoryRateLimitBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt is when the bucket is full again, and can be forgotten.
+	fullAt time.Time
+}
+
+// MemoryRateLimiter keeps buckets in memory. Each instance of the service counts requests on its own, so the
+// effective limit grows with the number of instances.
+type MemoryRateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryRateLimitBucket
+}
+
+func (l *MemoryRateLimiter) prune(now time.Time) {
+	for key, bucket := range l.buckets {
+		if !now.Before(bucket.fullAt) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// evict forgets the buckets closest to being full, which are the least likely to limit anyone, until a quarter of the
+// buckets is free again. It bounds the memory when keys keep changing, such as with rotating IPv6 addresses.
+func (l *MemoryRateLimiter) evict() {
+	keys := make([]string, 0, len(l.buckets))
+	for key := range l.buckets {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return l.buckets[keys[i]].fullAt.Before(l.buckets[keys[j]].fullAt)
+	})
+
+	for _, key := range keys[:len(keys)-maxMemoryRateLimitBuckets*3/4] {
+		delete(l.buckets, key)
+	}
+}
+
+func (l *MemoryRateLimiter) TakeRateLimitToken(_ context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxMemoryRateLimitBuckets {
+			l.prune(now)
+		}
+		if len(l.buckets) >= maxMemoryRateLimitBuckets {
+			l.evict()
+		}
+
+		bucket = &memoryRateLimitBucket{tokens: float64(burst), updatedAt: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(float64(burst), bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*rate)
+	bucket.updatedAt = now
+
+	if bucket.tokens < 1 {
+		return time.Duration(math.Ceil((1 - bucket.tokens) / rate * float64(time.Second))), nil
+	}
+
+	bucket.tokens--
+	bucket.fullAt = now.Add(time.Duration((float64(burst) - bucket.tokens) / rate * float64(time.Second)))
+
+	return 0, nil
+}
+
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		buckets: make(map[string]*memoryRateLimitBucket),
+	}
+}
@@ -0,0 +1,100 @@
+package clients_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestMemoryRateLimiter(t *testing.T) {
+	testData := []struct {
+		name  string
+		rate  float64
+		burst int
+		// wait is slept between takes.
+		wait time.Duration
+
+		takes             int
+		expectAllowed     int
+		expectRetryAfters bool
+	}{
+		{
+			name:          "WithinBurst",
+			rate:          0.001,
+			burst:         3,
+			takes:         3,
+			expectAllowed: 3,
+		},
+		{
+			name:              "BurstExceeded",
+			rate:              0.001,
+			burst:             3,
+			takes:             5,
+			expectAllowed:     3,
+			expectRetryAfters: true,
+		},
+		{
+			name:          "Refill",
+			rate:          1000,
+			burst:         1,
+			wait:          10 * time.Millisecond,
+			takes:         3,
+			expectAllowed: 3,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			limiter := clients.NewMemoryRateLimiter()
+
+			allowed := 0
+			for range data.takes {
+				retryAfter, err := limiter.TakeRateLimitToken(context.TODO(), "key-1", data.rate, data.burst)
+				require.NoError(t, err)
+
+				if retryAfter == 0 {
+					allowed++
+				} else {
+					require.True(t, data.expectRetryAfters)
+					// A token refills every 1000 seconds.
+					require.LessOrEqual(t, retryAfter, 1000*time.Second)
+				}
+
+				time.Sleep(data.wait)
+			}
+
+			require.Equal(t, data.expectAllowed, allowed)
+
+			// Buckets are independent.
+			retryAfter, err := limiter.TakeRateLimitToken(context.TODO(), "key-2", data.rate, data.burst)
+			require.NoError(t, err)
+			require.Zero(t, retryAfter)
+		})
+	}
+}
+
+func TestMemoryRateLimiterEviction(t *testing.T) {
+	limiter := clients.NewMemoryRateLimiter()
+
+	take := func(key string) time.Duration {
+		retryAfter, err := limiter.TakeRateLimitToken(context.TODO(), key, 0.001, 1)
+		require.NoError(t, err)
+
+		return retryAfter
+	}
+
+	require.Zero(t, take("key-1"))
+	require.NotZero(t, take("key-1"))
+
+	// None of the buckets is full again, so the oldest ones are evicted once the limit of 100000 buckets is reached.
+	for i := range 100000 {
+		require.Zero(t, take("rotating-key-"+strconv.Itoa(i)))
+	}
+
+	require.Zero(t, take("key-1"))
+	// Recent buckets are kept.
+	require.NotZero(t, take("rotating-key-99999"))
+}
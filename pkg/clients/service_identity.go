@@ -0,0 +1,9 @@
+package clients
+
+import "context"
+
+// ServiceIdentityVerifier authenticates the backend services calling this one.
+type ServiceIdentityVerifier interface {
+	// VerifyServiceIdentity returns the service account a token was issued to, or an error if the token is invalid.
+	VerifyServiceIdentity(ctx context.Context, token string) (string, error)
+}
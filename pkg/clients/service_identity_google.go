@@ -0,0 +1,37 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/api/idtoken"
+)
+
+var ErrServiceIdentityUnverified = errors.New("service account email is not verified")
+
+// googleServiceIdentityVerifier checks the Google-signed identity tokens that service accounts obtain from the
+// metadata server, like Cloud Run services calling each other.
+type googleServiceIdentityVerifier struct {
+	audience string
+}
+
+func (v *googleServiceIdentityVerifier) VerifyServiceIdentity(ctx context.Context, token string) (string, error) {
+	payload, err := idtoken.Validate(ctx, token, v.audience)
+	if err != nil {
+		return "", err
+	}
+
+	email, _ := payload.Claims["email"].(string)
+	verified, _ := payload.Claims["email_verified"].(bool)
+	if email == "" || !verified {
+		return "", ErrServiceIdentityUnverified
+	}
+
+	return email, nil
+}
+
+// NewGoogleServiceIdentityVerifier only accepts tokens issued for audience, usually the URL of this service.
+func NewGoogleServiceIdentityVerifier(audience string) ServiceIdentityVerifier {
+	return &googleServiceIdentityVerifier{
+		audience: audience,
+	}
+}
@@ -0,0 +1,137 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderWebhookID        = "Webhook-Id"
+	HeaderWebhookTimestamp = "Webhook-Timestamp"
+	HeaderWebhookSignature = "Webhook-Signature"
+
+	// webhookSignatureVersion prefixes signatures, so the scheme can evolve without breaking receivers.
+	webhookSignatureVersion = "v1="
+)
+
+var (
+	ErrWebhookRejected          = errors.New("receiver rejected the webhook")
+	ErrWebhookAddressNotAllowed = errors.New("webhook address not allowed")
+)
+
+// sharedAddressSpace is used by carrier-grade NATs, and is not reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+type Webhook struct {
+	// ID is the same for every attempt of a delivery, so receivers can deduplicate them.
+	ID      string
+	URL     string
+	Secret  string
+	Payload []byte
+}
+
+type WebhookSender interface {
+	// Send posts the webhook, and returns the status code of the response, or 0 if the receiver could not be reached.
+	// Any status outside the 2xx range is reported as ErrWebhookRejected.
+	Send(ctx context.Context, webhook *Webhook) (int, error)
+}
+
+// SignWebhook computes the signature receivers must check. Signing the timestamp along with the payload lets
+// receivers reject replayed requests, by refusing timestamps that are too old.
+func SignWebhook(secret string, id string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%s.%d.", id, timestamp.Unix())
+	_, _ = mac.Write(payload)
+
+	return webhookSignatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookAddressAllowed reports whether webhooks may be sent to addr. Only public addresses are allowed, so
+// subscriptions cannot reach internal services, or the metadata server of the host.
+func WebhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// NewWebhookHTTPClient returns a client for NewHTTPWebhookSender. The address is checked once resolved, so a public
+// hostname cannot point to an internal address. Redirects are not followed, and are reported as rejections.
+func NewWebhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !WebhookAddressAllowed(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrWebhookAddressNotAllowed, addrPort.Addr())
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the receiver, and hide its address.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type httpWebhookSenderImpl struct {
+	client *http.Client
+}
+
+func (s *httpWebhookSenderImpl) Send(ctx context.Context, webhook *Webhook) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(webhook.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, webhook.ID)
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(webhook.Secret, webhook.ID, timestamp, webhook.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	// Drain the body, so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("%w with status %d", ErrWebhookRejected, res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+func NewHTTPWebhookSender(client *http.Client) WebhookSender {
+	return &httpWebhookSenderImpl{
+		client: client,
+	}
+}
@@ -0,0 +1,176 @@
+package clients_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func TestHTTPWebhookSender(t *testing.T) {
+	testData := []struct {
+		name string
+
+		webhook        *clients.Webhook
+		responseStatus int
+		unreachable    bool
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name: "Send",
+			webhook: &clients.Webhook{
+				ID:      "delivery-1",
+				Secret:  "secret-1",
+				Payload: []byte(`{"id":"1"}`),
+			},
+			responseStatus: http.StatusNoContent,
+			expect:         http.StatusNoContent,
+		},
+		{
+			name: "Rejected",
+			webhook: &clients.Webhook{
+				ID:      "delivery-1",
+				Secret:  "secret-1",
+				Payload: []byte(`{"id":"1"}`),
+			},
+			responseStatus: http.StatusServiceUnavailable,
+			expect:         http.StatusServiceUnavailable,
+			expectErr:      clients.ErrWebhookRejected,
+		},
+		{
+			name: "Unreachable",
+			webhook: &clients.Webhook{
+				ID:      "delivery-1",
+				Secret:  "secret-1",
+				Payload: []byte(`{"id":"1"}`),
+			},
+			unreachable: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			received := make(chan *receivedWebhook, 1)
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received <- &receivedWebhook{header: r.Header, body: body}
+				w.WriteHeader(data.responseStatus)
+			}))
+			defer receiver.Close()
+
+			if data.unreachable {
+				receiver.Close()
+			}
+
+			data.webhook.URL = receiver.URL
+			sender := clients.NewHTTPWebhookSender(receiver.Client())
+
+			status, err := sender.Send(context.TODO(), data.webhook)
+
+			require.Equal(t, data.expect, status)
+			if data.unreachable {
+				require.Error(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			webhook := <-received
+			require.Equal(t, data.webhook.Payload, webhook.body)
+			require.Equal(t, data.webhook.ID, webhook.header.Get(clients.HeaderWebhookID))
+
+			// Receivers verify the signature from the timestamp they are given, and check the timestamp is recent.
+			timestamp, err := strconv.ParseInt(webhook.header.Get(clients.HeaderWebhookTimestamp), 10, 64)
+			require.NoError(t, err)
+			require.WithinDuration(t, time.Now(), time.Unix(timestamp, 0), time.Minute)
+			require.Equal(
+				t,
+				clients.SignWebhook(data.webhook.Secret, data.webhook.ID, time.Unix(timestamp, 0), data.webhook.Payload),
+				webhook.header.Get(clients.HeaderWebhookSignature),
+			)
+		})
+	}
+}
+
+func TestWebhookAddressAllowed(t *testing.T) {
+	testData := []struct {
+		name   string
+		addr   string
+		expect bool
+	}{
+		{name: "Public", addr: "8.8.8.8", expect: true},
+		{name: "PublicIPv6", addr: "2001:4860:4860::8888", expect: true},
+		{name: "Loopback", addr: "127.0.0.1"},
+		{name: "LoopbackIPv6", addr: "::1"},
+		{name: "Private", addr: "192.168.1.1"},
+		{name: "PrivateIPv6", addr: "fd00::1"},
+		{name: "LinkLocal", addr: "169.254.169.254"},
+		{name: "SharedAddressSpace", addr: "100.64.0.1"},
+		{name: "Unspecified", addr: "0.0.0.0"},
+		{name: "MappedPrivate", addr: "::ffff:10.0.0.1"},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			require.Equal(t, data.expect, clients.WebhookAddressAllowed(netip.MustParseAddr(data.addr)))
+		})
+	}
+}
+
+func TestWebhookHTTPClient(t *testing.T) {
+	webhook := &clients.Webhook{
+		ID:      "delivery-1",
+		Secret:  "secret-1",
+		Payload: []byte(`{"id":"1"}`),
+	}
+
+	redirected := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			redirected = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	t.Run("InternalAddress", func(t *testing.T) {
+		webhook.URL = receiver.URL
+		sender := clients.NewHTTPWebhookSender(clients.NewWebhookHTTPClient())
+
+		status, err := sender.Send(context.TODO(), webhook)
+
+		require.Equal(t, 0, status)
+		require.ErrorIs(t, err, clients.ErrWebhookAddressNotAllowed)
+	})
+
+	t.Run("Redirect", func(t *testing.T) {
+		// The test receiver is local, so only the redirect policy of the client is kept.
+		client := clients.NewWebhookHTTPClient()
+		client.Transport = receiver.Client().Transport
+
+		webhook.URL = receiver.URL
+		sender := clients.NewHTTPWebhookSender(client)
+
+		status, err := sender.Send(context.TODO(), webhook)
+
+		require.Equal(t, http.StatusTemporaryRedirect, status)
+		require.ErrorIs(t, err, clients.ErrWebhookRejected)
+		require.False(t, redirected)
+	})
+}
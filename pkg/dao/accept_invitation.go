@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+type AcceptInvitationRepository interface {
+	AcceptInvitation(ctx context.Context, id uuid.UUID, firebaseUID string) (*entities.Membership, error)
+}
+
+type acceptInvitationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *acceptInvitationRepositoryImpl) AcceptInvitation(
+	ctx context.Context, id uuid.UUID, firebaseUID string,
+) (*entities.Membership, error) {
+	var membership *entities.Membership
+
+	// Marking the invitation as accepted and creating the membership must happen together, so a code can never be
+	// used twice, even by concurrent requests.
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		invitation := new(entities.OrganizationInvitation)
+
+		res, err := tx.NewUpdate().
+			Model(invitation).
+			Set("accepted_at = NOW()").
+			Set("accepted_by = ?", firebaseUID).
+			Where("id = ?", id).
+			Where("accepted_at IS NULL").
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrInvitationNotFound
+		}
+
+		membership = &entities.Membership{
+			OrganizationID: invitation.OrganizationID,
+			FirebaseUID:    firebaseUID,
+			Role:           invitation.Role,
+		}
+
+		if _, err := tx.NewInsert().Model(membership).Returning("*").Exec(ctx); err != nil {
+			var pgErr pgdriver.Error
+			if errors.As(err, &pgErr) && pgErr.IntegrityViolation() {
+				return ErrMembershipAlreadyExists
+			}
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+func NewAcceptInvitationRepository(db bun.IDB) AcceptInvitationRepository {
+	return &acceptInvitationRepositoryImpl{
+		db: db,
+	}
+}
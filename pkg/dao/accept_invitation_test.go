@@ -0,0 +1,68 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAcceptInvitation(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		id          uuid.UUID
+		firebaseUID string
+		expect      *entities.Membership
+		expectErr   error
+	}{
+		{
+			name:        "AcceptInvitation",
+			id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			firebaseUID: "firebase-uid-3",
+			expect: &entities.Membership{
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				FirebaseUID:    "firebase-uid-3",
+				Role:           entities.MembershipRoleMember,
+			},
+		},
+		{
+			name:        "AlreadyAccepted",
+			id:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			firebaseUID: "firebase-uid-3",
+			expectErr:   dao.ErrInvitationNotFound,
+		},
+		{
+			name:        "AlreadyMember",
+			id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			firebaseUID: "firebase-uid-2",
+			expectErr:   dao.ErrMembershipAlreadyExists,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewAcceptInvitationRepository(tx)
+			membership, err := repo.AcceptInvitation(context.TODO(), data.id, data.firebaseUID)
+
+			if membership != nil {
+				// Since ID and creation date are random, nullify them for comparison.
+				membership.ID = nil
+				membership.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, membership)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package dao
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// auditChainLockID identifies the advisory lock that serializes writes to the audit chain.
+const auditChainLockID = 7_266_127_734
+
+// auditEventHashInput lists the fields covered by the hash of an audit event. Its JSON encoding is stable, since
+// struct fields and map keys are always encoded in the same order.
+type auditEventHashInput struct {
+	PreviousHash string                           `json:"previousHash"`
+	ActorUID     *string                          `json:"actorUID"`
+	SubjectUID   *string                          `json:"subjectUID"`
+	Target       *string                          `json:"target"`
+	Action       string                           `json:"action"`
+	Outcome      entities.AuditOutcome            `json:"outcome"`
+	Reason       *string                          `json:"reason"`
+	PeerIP       *string                          `json:"peerIP"`
+	UserAgent    *string                          `json:"userAgent"`
+	Diff         map[string]*entities.AuditChange `json:"diff"`
+	CreatedAt    string                           `json:"createdAt"`
+}
+
+// HashAuditEvent computes the hash of an audit event, including the hash of its predecessor.
+func HashAuditEvent(event *entities.AuditEvent) string {
+	input := auditEventHashInput{
+		ActorUID:   event.ActorUID,
+		SubjectUID: event.SubjectUID,
+		Target:     event.Target,
+		Action:     event.Action,
+		Outcome:    event.Outcome,
+		Reason:     event.Reason,
+		PeerIP:     event.PeerIP,
+		UserAgent:  event.UserAgent,
+		Diff:       event.Diff,
+	}
+
+	if event.PreviousHash != nil {
+		input.PreviousHash = *event.PreviousHash
+	}
+	if event.CreatedAt != nil {
+		input.CreatedAt = event.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	// Marshalling cannot fail, as events are only hashed once their diff went through JSON.
+	encoded, _ := json.Marshal(input)
+	hash := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// decodeAuditDiff returns the diff as it is read back from the database. JSON numbers are decoded as float64, so
+// integers above 2^53 lose precision, and must be hashed that way for the chain to be verified later.
+func decodeAuditDiff(diff map[string]*entities.AuditChange) (map[string]*entities.AuditChange, error) {
+	if diff == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(diff)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := make(map[string]*entities.AuditChange)
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+func newAuditEvent(data *CreateAuditEventData) *entities.AuditEvent {
+	occurredAt := data.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	return &entities.AuditEvent{
+		ActorUID:   data.ActorUID,
+		SubjectUID: data.SubjectUID,
+		Target:     data.Target,
+		Action:     data.Action,
+		Outcome:    data.Outcome,
+		Reason:     data.Reason,
+		PeerIP:     data.PeerIP,
+		UserAgent:  data.UserAgent,
+		Diff:       data.Diff,
+		// The creation date is part of the hash, so it cannot be left to the database. Postgres only stores
+		// microseconds.
+		CreatedAt: lo.ToPtr(occurredAt.UTC().Truncate(time.Microsecond)),
+	}
+}
+
+// insertAuditChain chains the events to the end of the audit trail, in order, and inserts them.
+func insertAuditChain(ctx context.Context, db bun.IDB, events []*entities.AuditEvent) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Concurrent events would otherwise be chained to the same predecessor.
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(?)", auditChainLockID); err != nil {
+			return err
+		}
+
+		previous := new(entities.AuditEvent)
+		err := tx.NewSelect().
+			Model(previous).
+			Column("hash").
+			Where("hash IS NOT NULL").
+			Order("id DESC").
+			Limit(1).
+			Scan(ctx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		// The first chained event has an empty previous hash.
+		previousHash := lo.FromPtr(previous.Hash)
+		for _, event := range events {
+			if event.Diff, err = decodeAuditDiff(event.Diff); err != nil {
+				return err
+			}
+
+			event.PreviousHash = lo.ToPtr(previousHash)
+			event.Hash = lo.ToPtr(HashAuditEvent(event))
+			previousHash = *event.Hash
+		}
+
+		// IDs are assigned in the order of the rows, which keeps the chain in order.
+		_, err = tx.NewInsert().Model(&events).Returning("*").Exec(ctx)
+		return err
+	})
+}
@@ -0,0 +1,49 @@
+package dao_test
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"time"
+)
+
+// auditChainFixtures starts with an event recorded before chaining was introduced.
+var auditChainFixtures = []interface{}{
+	&entities.AuditEvent{
+		ID:        1,
+		Action:    "authenticate",
+		Outcome:   entities.AuditOutcomeFailure,
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.AuditEvent{
+		ID:           2,
+		Action:       "user.update",
+		Outcome:      entities.AuditOutcomeSuccess,
+		PreviousHash: lo.ToPtr(""),
+		Hash:         lo.ToPtr("hash-2"),
+		CreatedAt:    lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.AuditEvent{
+		ID:           3,
+		Action:       "user.update",
+		Outcome:      entities.AuditOutcomeSuccess,
+		PreviousHash: lo.ToPtr("hash-2"),
+		Hash:         lo.ToPtr("hash-3"),
+		CreatedAt:    lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.AuditCheckpoint{
+		ID:        1,
+		Sequence:  2,
+		EventID:   3,
+		EventHash: "hash-3",
+		Signature: "signature-3",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.AuditCheckpoint{
+		ID:        2,
+		Sequence:  1,
+		EventID:   2,
+		EventHash: "hash-2",
+		Signature: "signature-2",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+}
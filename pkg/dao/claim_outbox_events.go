@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"sort"
+	"time"
+)
+
+type ClaimOutboxEventsRepository interface {
+	// ClaimOutboxEvents returns due events, and hides them from other relays until leaseUntil. An event is never
+	// returned while an older event of the same aggregate is still pending, so events are published in order. Dead
+	// events are not pending.
+	ClaimOutboxEvents(ctx context.Context, leaseUntil time.Time, limit int) ([]*entities.OutboxEvent, error)
+}
+
+type claimOutboxEventsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *claimOutboxEventsRepositoryImpl) ClaimOutboxEvents(
+	ctx context.Context, leaseUntil time.Time, limit int,
+) ([]*entities.OutboxEvent, error) {
+	events := make([]*entities.OutboxEvent, 0)
+
+	pendingPredecessors := r.db.NewSelect().
+		TableExpr("outbox_events AS previous").
+		ColumnExpr("1").
+		Where("previous.aggregate_id = outbox_event.aggregate_id").
+		Where("previous.published_at IS NULL").
+		Where("previous.dead_at IS NULL").
+		Where("previous.id < outbox_event.id")
+
+	due := r.db.NewSelect().
+		Model((*entities.OutboxEvent)(nil)).
+		Column("id").
+		Where("published_at IS NULL").
+		Where("dead_at IS NULL").
+		Where("next_attempt_at <= NOW()").
+		Where("NOT EXISTS (?)", pendingPredecessors).
+		Order("id ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	_, err := r.db.NewUpdate().
+		Model((*entities.OutboxEvent)(nil)).
+		Set("next_attempt_at = ?", leaseUntil).
+		Where("id IN (?)", due).
+		Returning("*").
+		Exec(ctx, &events)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+func NewClaimOutboxEventsRepository(db bun.IDB) ClaimOutboxEventsRepository {
+	return &claimOutboxEventsRepositoryImpl{
+		db: db,
+	}
+}
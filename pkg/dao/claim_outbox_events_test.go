@@ -0,0 +1,59 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClaimOutboxEvents(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		limit  int
+		expect []int64
+	}{
+		{
+			name:   "ClaimOutboxEvents",
+			limit:  10,
+			expect: []int64{2, 4, 7},
+		},
+		{
+			name:   "Limit",
+			limit:  1,
+			expect: []int64{2},
+		},
+	}
+
+	stx := BeginTX(db, outboxEventsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewClaimOutboxEventsRepository(tx)
+			leaseUntil := time.Now().Add(time.Minute)
+
+			events, err := repo.ClaimOutboxEvents(context.TODO(), leaseUntil, data.limit)
+			require.NoError(t, err)
+			require.Equal(t, data.expect, lo.Map(events, func(item *entities.OutboxEvent, _ int) int64 {
+				return item.ID
+			}))
+
+			// Claimed events are leased, and not returned again until the lease expires.
+			events, err = repo.ClaimOutboxEvents(context.TODO(), leaseUntil, data.limit)
+			require.NoError(t, err)
+			require.Empty(t, lo.Intersect(data.expect, lo.Map(events, func(item *entities.OutboxEvent, _ int) int64 {
+				return item.ID
+			})))
+		})
+	}
+}
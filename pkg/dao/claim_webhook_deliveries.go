@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type ClaimWebhookDeliveriesRepository interface {
+	// ClaimWebhookDeliveries returns due pending deliveries, along with their subscription, and hides them from
+	// other workers until leaseUntil.
+	ClaimWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int) ([]*entities.WebhookDelivery, error)
+}
+
+type claimWebhookDeliveriesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *claimWebhookDeliveriesRepositoryImpl) ClaimWebhookDeliveries(
+	ctx context.Context, leaseUntil time.Time, limit int,
+) ([]*entities.WebhookDelivery, error) {
+	ids := make([]uuid.UUID, 0)
+
+	due := r.db.NewSelect().
+		Model((*entities.WebhookDelivery)(nil)).
+		Column("id").
+		Where("status = ?", entities.WebhookDeliveryStatusPending).
+		Where("next_attempt_at <= NOW()").
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	_, err := r.db.NewUpdate().
+		Model((*entities.WebhookDelivery)(nil)).
+		Set("next_attempt_at = ?", leaseUntil).
+		Where("id IN (?)", due).
+		Returning("id").
+		Exec(ctx, &ids)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*entities.WebhookDelivery, 0, len(ids))
+	if len(ids) == 0 {
+		return deliveries, nil
+	}
+
+	err = r.db.NewSelect().
+		Model(&deliveries).
+		Relation("Subscription").
+		Where("webhook_delivery.id IN (?)", bun.In(ids)).
+		Order("webhook_delivery.created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func NewClaimWebhookDeliveriesRepository(db bun.IDB) ClaimWebhookDeliveriesRepository {
+	return &claimWebhookDeliveriesRepositoryImpl{
+		db: db,
+	}
+}
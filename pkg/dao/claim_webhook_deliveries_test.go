@@ -0,0 +1,58 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClaimWebhookDeliveries(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		limit  int
+		expect []uuid.UUID
+	}{
+		{
+			name:   "ClaimWebhookDeliveries",
+			limit:  10,
+			expect: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewClaimWebhookDeliveriesRepository(tx)
+			leaseUntil := time.Now().Add(time.Minute)
+
+			deliveries, err := repo.ClaimWebhookDeliveries(context.TODO(), leaseUntil, data.limit)
+			require.NoError(t, err)
+			require.Equal(t, data.expect, lo.Map(deliveries, func(item *entities.WebhookDelivery, _ int) uuid.UUID {
+				return *item.ID
+			}))
+
+			for _, delivery := range deliveries {
+				require.NotNil(t, delivery.Subscription)
+				require.Equal(t, delivery.SubscriptionID, *delivery.Subscription.ID)
+			}
+
+			// Claimed deliveries are leased, and not returned again until the lease expires.
+			deliveries, err = repo.ClaimWebhookDeliveries(context.TODO(), leaseUntil, data.limit)
+			require.NoError(t, err)
+			require.Empty(t, deliveries)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CountActiveUsersRepository interface {
+	// CountActiveUsers returns the number of users of a tenant that authenticated since the given time.
+	CountActiveUsers(ctx context.Context, tenantID string, since time.Time) (int, error)
+}
+
+type countActiveUsersRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *countActiveUsersRepositoryImpl) CountActiveUsers(ctx context.Context, tenantID string, since time.Time) (int, error) {
+	return r.db.NewSelect().
+		Model((*entities.UserActivity)(nil)).
+		Where("tenant_id = ?", tenantID).
+		Where("last_seen_at >= ?", since).
+		Count(ctx)
+}
+
+func NewCountActiveUsersRepository(db bun.IDB) CountActiveUsersRepository {
+	return &countActiveUsersRepositoryImpl{
+		db: db,
+	}
+}
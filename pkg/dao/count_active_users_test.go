@@ -0,0 +1,85 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var countActiveUsersFixtures = []*entities.UserActivity{
+	{
+		FirebaseUID:         "firebase-uid-1",
+		LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC)),
+		AuthenticationCount: 1,
+	},
+	{
+		FirebaseUID:         "firebase-uid-2",
+		LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 10, 12, 0, 0, 0, time.UTC)),
+		AuthenticationCount: 1,
+	},
+	{
+		FirebaseUID:         "firebase-uid-3",
+		LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)),
+		AuthenticationCount: 1,
+	},
+	{
+		TenantID:            "tenant-1",
+		FirebaseUID:         "firebase-uid-1",
+		LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC)),
+		AuthenticationCount: 1,
+	},
+}
+
+func TestCountActiveUsers(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name     string
+		tenantID string
+		since    time.Time
+		expect   int
+	}{
+		{
+			name:   "LastDays",
+			since:  time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC),
+			expect: 2,
+		},
+		{
+			name:   "LastMonth",
+			since:  time.Date(2024, 9, 11, 0, 0, 0, 0, time.UTC),
+			expect: 3,
+		},
+		{
+			name:     "Tenant",
+			tenantID: "tenant-1",
+			since:    time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC),
+			expect:   1,
+		},
+		{
+			name:   "NoActiveUsers",
+			since:  time.Date(2024, 10, 12, 0, 0, 0, 0, time.UTC),
+			expect: 0,
+		},
+	}
+
+	stx := BeginTX(db, countActiveUsersFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCountActiveUsersRepository(tx)
+			count, err := repo.CountActiveUsers(context.TODO(), data.tenantID, data.since)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, count)
+		})
+	}
+}
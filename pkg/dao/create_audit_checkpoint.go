@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateAuditCheckpointData struct {
+	Sequence  int64
+	EventID   int64
+	EventHash string
+	Signature string
+}
+
+type CreateAuditCheckpointRepository interface {
+	CreateAuditCheckpoint(ctx context.Context, data *CreateAuditCheckpointData) (*entities.AuditCheckpoint, error)
+}
+
+type createAuditCheckpointRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createAuditCheckpointRepositoryImpl) CreateAuditCheckpoint(
+	ctx context.Context, data *CreateAuditCheckpointData,
+) (*entities.AuditCheckpoint, error) {
+	checkpoint := &entities.AuditCheckpoint{
+		Sequence:  data.Sequence,
+		EventID:   data.EventID,
+		EventHash: data.EventHash,
+		Signature: data.Signature,
+	}
+
+	if _, err := r.db.NewInsert().Model(checkpoint).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+func NewCreateAuditCheckpointRepository(db bun.IDB) CreateAuditCheckpointRepository {
+	return &createAuditCheckpointRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,111 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateAuditCheckpoint(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		data      *dao.CreateAuditCheckpointData
+		expect    *entities.AuditCheckpoint
+		expectErr bool
+	}{
+		{
+			name: "CreateAuditCheckpoint",
+			data: &dao.CreateAuditCheckpointData{
+				Sequence:  3,
+				EventID:   3,
+				EventHash: "hash-3",
+				Signature: "signature-3-bis",
+			},
+			expect: &entities.AuditCheckpoint{
+				Sequence:  3,
+				EventID:   3,
+				EventHash: "hash-3",
+				Signature: "signature-3-bis",
+			},
+		},
+		{
+			name: "EventNotFound",
+			data: &dao.CreateAuditCheckpointData{
+				Sequence:  3,
+				EventID:   4,
+				EventHash: "hash-4",
+				Signature: "signature-4",
+			},
+			expectErr: true,
+		},
+		{
+			name: "SequenceAlreadyUsed",
+			data: &dao.CreateAuditCheckpointData{
+				Sequence:  2,
+				EventID:   3,
+				EventHash: "hash-3",
+				Signature: "signature-3-bis",
+			},
+			expectErr: true,
+		},
+	}
+
+	stx := BeginTX(db, auditChainFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateAuditCheckpointRepository(tx)
+			checkpoint, err := repo.CreateAuditCheckpoint(context.TODO(), data.data)
+
+			if data.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Since ID and creation date are generated, nullify them for comparison.
+			checkpoint.ID = 0
+			checkpoint.CreatedAt = nil
+
+			require.Equal(t, data.expect, checkpoint)
+		})
+	}
+}
+
+func TestAuditCheckpointsAreAppendOnly(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	stx := BeginTX(db, auditChainFixtures)
+	defer RollbackTX(stx)
+
+	testData := []struct {
+		name  string
+		query string
+	}{
+		{name: "UpdateCheckpoint", query: "UPDATE audit_checkpoints SET event_hash = 'tampered' WHERE sequence = 1"},
+		{name: "DeleteCheckpoint", query: "DELETE FROM audit_checkpoints WHERE sequence = 2"},
+		{name: "TruncateCheckpoints", query: "TRUNCATE audit_checkpoints"},
+		{name: "TruncateEvents", query: "TRUNCATE audit_events CASCADE"},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			_, err := tx.ExecContext(context.TODO(), data.query)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CreateAuditEventData struct {
+	ActorUID   *string
+	SubjectUID *string
+	Target     *string
+	Action     string
+	Outcome    entities.AuditOutcome
+	Reason     *string
+	PeerIP     *string
+	UserAgent  *string
+	Diff       map[string]*entities.AuditChange
+	// OccurredAt defaults to the time the event is written.
+	OccurredAt time.Time
+}
+
+type CreateAuditEventRepository interface {
+	CreateAuditEvent(ctx context.Context, data *CreateAuditEventData) (*entities.AuditEvent, error)
+}
+
+type createAuditEventRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createAuditEventRepositoryImpl) CreateAuditEvent(
+	ctx context.Context, data *CreateAuditEventData,
+) (*entities.AuditEvent, error) {
+	event := newAuditEvent(data)
+
+	if err := insertAuditChain(ctx, r.db, []*entities.AuditEvent{event}); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func NewCreateAuditEventRepository(db bun.IDB) CreateAuditEventRepository {
+	return &createAuditEventRepositoryImpl{
+		db: db,
+	}
+}
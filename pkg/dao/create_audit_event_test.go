@@ -0,0 +1,170 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateAuditEvent(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		data      *dao.CreateAuditEventData
+		expect    *entities.AuditEvent
+		expectErr error
+	}{
+		{
+			name: "CreateAuditEvent",
+			data: &dao.CreateAuditEventData{
+				ActorUID:   lo.ToPtr("firebase-uid-1"),
+				SubjectUID: lo.ToPtr("firebase-uid-1"),
+				Action:     "user.update",
+				Outcome:    entities.AuditOutcomeSuccess,
+				PeerIP:     lo.ToPtr("127.0.0.1"),
+				UserAgent:  lo.ToPtr("grpc-go/1.64.0"),
+				Diff: map[string]*entities.AuditChange{
+					"publicIdentifier": {Before: "public-identifier-1", After: "public-identifier-2"},
+				},
+			},
+			expect: &entities.AuditEvent{
+				ActorUID:   lo.ToPtr("firebase-uid-1"),
+				SubjectUID: lo.ToPtr("firebase-uid-1"),
+				Action:     "user.update",
+				Outcome:    entities.AuditOutcomeSuccess,
+				PeerIP:     lo.ToPtr("127.0.0.1"),
+				UserAgent:  lo.ToPtr("grpc-go/1.64.0"),
+				Diff: map[string]*entities.AuditChange{
+					"publicIdentifier": {Before: "public-identifier-1", After: "public-identifier-2"},
+				},
+				PreviousHash: lo.ToPtr(""),
+			},
+		},
+		{
+			name: "AnonymousFailure",
+			data: &dao.CreateAuditEventData{
+				Action:  "authenticate",
+				Outcome: entities.AuditOutcomeFailure,
+				Reason:  lo.ToPtr("invalid token"),
+			},
+			expect: &entities.AuditEvent{
+				Action:       "authenticate",
+				Outcome:      entities.AuditOutcomeFailure,
+				Reason:       lo.ToPtr("invalid token"),
+				PreviousHash: lo.ToPtr(""),
+			},
+		},
+	}
+
+	stx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateAuditEventRepository(tx)
+			event, err := repo.CreateAuditEvent(context.TODO(), data.data)
+
+			if event != nil {
+				require.Equal(t, dao.HashAuditEvent(event), lo.FromPtr(event.Hash))
+
+				// Since ID, hash and creation date are generated, nullify them for comparison.
+				event.ID = 0
+				event.Hash = nil
+				event.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, event)
+		})
+	}
+}
+
+func TestAuditEventsAreAppendOnly(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(tx)
+
+	event, err := dao.NewCreateAuditEventRepository(tx).CreateAuditEvent(context.TODO(), &dao.CreateAuditEventData{
+		Action:  "authenticate",
+		Outcome: entities.AuditOutcomeFailure,
+	})
+	require.NoError(t, err)
+
+	_, err = tx.NewUpdate().Model(event).Set("action = ?", "tampered").WherePK().Exec(context.TODO())
+	require.Error(t, err)
+}
+
+func TestAuditEventsAreChained(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(tx)
+
+	repo := dao.NewCreateAuditEventRepository(tx)
+
+	events := make([]*entities.AuditEvent, 3)
+	for i := range events {
+		event, err := repo.CreateAuditEvent(context.TODO(), &dao.CreateAuditEventData{
+			SubjectUID: lo.ToPtr("firebase-uid-1"),
+			Action:     "user.update",
+			Outcome:    entities.AuditOutcomeSuccess,
+		})
+		require.NoError(t, err)
+
+		events[i] = event
+	}
+
+	require.Equal(t, "", lo.FromPtr(events[0].PreviousHash))
+	require.Equal(t, events[0].Hash, events[1].PreviousHash)
+	require.Equal(t, events[1].Hash, events[2].PreviousHash)
+
+	// Tamper with the second event, bypassing the append-only trigger like someone with direct access would.
+	_, err := tx.ExecContext(context.TODO(), "ALTER TABLE audit_events DISABLE TRIGGER audit_events_append_only")
+	require.NoError(t, err)
+	_, err = tx.ExecContext(context.TODO(), "UPDATE audit_events SET subject_uid = 'firebase-uid-2' WHERE id = ?", events[1].ID)
+	require.NoError(t, err)
+
+	stored, err := dao.NewListAuditChainRepository(tx).ListAuditChain(context.TODO(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+
+	require.Equal(t, lo.FromPtr(stored[0].Hash), dao.HashAuditEvent(stored[0]))
+	require.NotEqual(t, lo.FromPtr(stored[1].Hash), dao.HashAuditEvent(stored[1]))
+	require.Equal(t, lo.FromPtr(stored[2].Hash), dao.HashAuditEvent(stored[2]))
+}
+
+func TestAuditEventsHashStoredDiff(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(tx)
+
+	// Integers above 2^53 are read back from the database as rounded floats.
+	_, err := dao.NewCreateAuditEventRepository(tx).CreateAuditEvent(context.TODO(), &dao.CreateAuditEventData{
+		SubjectUID: lo.ToPtr("firebase-uid-1"),
+		Action:     "user.update",
+		Outcome:    entities.AuditOutcomeSuccess,
+		Diff: map[string]*entities.AuditChange{
+			"count": {Before: int64(1<<53 + 1), After: int64(1<<53 + 3)},
+		},
+	})
+	require.NoError(t, err)
+
+	stored, err := dao.NewListAuditChainRepository(tx).ListAuditChain(context.TODO(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+
+	require.Equal(t, lo.FromPtr(stored[0].Hash), dao.HashAuditEvent(stored[0]))
+}
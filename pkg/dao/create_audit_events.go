@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+)
+
+type CreateAuditEventsRepository interface {
+	// CreateAuditEvents appends the events to the audit chain in order, under a single lock of the chain.
+	CreateAuditEvents(ctx context.Context, data []*CreateAuditEventData) ([]*entities.AuditEvent, error)
+}
+
+type createAuditEventsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createAuditEventsRepositoryImpl) CreateAuditEvents(
+	ctx context.Context, data []*CreateAuditEventData,
+) ([]*entities.AuditEvent, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	events := lo.Map(data, func(item *CreateAuditEventData, _ int) *entities.AuditEvent {
+		return newAuditEvent(item)
+	})
+
+	if err := insertAuditChain(ctx, r.db, events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func NewCreateAuditEventsRepository(db bun.IDB) CreateAuditEventsRepository {
+	return &createAuditEventsRepositoryImpl{
+		db: db,
+	}
+}
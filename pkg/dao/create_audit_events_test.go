@@ -0,0 +1,77 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateAuditEvents(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name         string
+		data         []*dao.CreateAuditEventData
+		expectReason []string
+	}{
+		{
+			name: "CreateAuditEvents",
+			data: []*dao.CreateAuditEventData{
+				{
+					SubjectUID: lo.ToPtr("firebase-uid-1"),
+					Action:     "authenticate",
+					Outcome:    entities.AuditOutcomeFailure,
+					Reason:     lo.ToPtr("reauthentication required"),
+					OccurredAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					SubjectUID: lo.ToPtr("firebase-uid-2"),
+					Action:     "authenticate",
+					Outcome:    entities.AuditOutcomeFailure,
+					Reason:     lo.ToPtr("second factor required"),
+					OccurredAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			expectReason: []string{"reauthentication required", "second factor required"},
+		},
+		{
+			name:         "Empty",
+			expectReason: []string{},
+		},
+	}
+
+	stx := BeginTX(db, auditChainFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateAuditEventsRepository(tx)
+			_, err := repo.CreateAuditEvents(context.TODO(), data.data)
+			require.NoError(t, err)
+
+			stored, err := dao.NewListAuditChainRepository(tx).ListAuditChain(context.TODO(), 3, 10)
+			require.NoError(t, err)
+
+			require.Equal(t, data.expectReason, lo.Map(stored, func(item *entities.AuditEvent, _ int) string {
+				return lo.FromPtr(item.Reason)
+			}))
+
+			previousHash := "hash-3"
+			for i, event := range stored {
+				require.Equal(t, previousHash, lo.FromPtr(event.PreviousHash))
+				require.Equal(t, dao.HashAuditEvent(event), lo.FromPtr(event.Hash))
+				require.True(t, data.data[i].OccurredAt.Equal(lo.FromPtr(event.CreatedAt)))
+
+				previousHash = lo.FromPtr(event.Hash)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CreateImpersonationSessionData struct {
+	// TenantID is the Identity Platform tenant of the impersonated user.
+	TenantID        string
+	ImpersonatorUID string
+	TargetUID       string
+	Reason          string
+	Scopes          []string
+	ExpiresAt       time.Time
+}
+
+type CreateImpersonationSessionRepository interface {
+	CreateImpersonationSession(
+		ctx context.Context, data *CreateImpersonationSessionData,
+	) (*entities.ImpersonationSession, error)
+}
+
+type createImpersonationSessionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createImpersonationSessionRepositoryImpl) CreateImpersonationSession(
+	ctx context.Context, data *CreateImpersonationSessionData,
+) (*entities.ImpersonationSession, error) {
+	session := &entities.ImpersonationSession{
+		TenantID:        data.TenantID,
+		ImpersonatorUID: data.ImpersonatorUID,
+		TargetUID:       data.TargetUID,
+		Reason:          data.Reason,
+		Scopes:          data.Scopes,
+		ExpiresAt:       &data.ExpiresAt,
+	}
+
+	if _, err := r.db.NewInsert().Model(session).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func NewCreateImpersonationSessionRepository(db bun.IDB) CreateImpersonationSessionRepository {
+	return &createImpersonationSessionRepositoryImpl{
+		db: db,
+	}
+}
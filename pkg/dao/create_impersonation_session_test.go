@@ -0,0 +1,82 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateImpersonationSession(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		data      *dao.CreateImpersonationSessionData
+		expect    *entities.ImpersonationSession
+		expectErr error
+	}{
+		{
+			name: "CreateImpersonationSession",
+			data: &dao.CreateImpersonationSessionData{
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{"user:read"},
+				ExpiresAt:       time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC),
+			},
+			expect: &entities.ImpersonationSession{
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{"user:read"},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "CreateTenantImpersonationSession",
+			data: &dao.CreateImpersonationSessionData{
+				TenantID:        "tenant-1",
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{"user:read"},
+				ExpiresAt:       time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC),
+			},
+			expect: &entities.ImpersonationSession{
+				TenantID:        "tenant-1",
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{"user:read"},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+			},
+		},
+	}
+
+	stx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateImpersonationSessionRepository(tx)
+			session, err := repo.CreateImpersonationSession(context.TODO(), data.data)
+
+			if session != nil {
+				// Since ID and creation date are random, nullify them for comparison.
+				session.ID = nil
+				session.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, session)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"time"
+)
+
+type CreateInvitationData struct {
+	Email     string
+	Role      entities.MembershipRole
+	CodeHash  string
+	InvitedBy string
+	ExpiresAt time.Time
+}
+
+type CreateInvitationRepository interface {
+	CreateInvitation(
+		ctx context.Context, organizationID uuid.UUID, data *CreateInvitationData,
+	) (*entities.OrganizationInvitation, error)
+}
+
+type createInvitationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createInvitationRepositoryImpl) CreateInvitation(
+	ctx context.Context, organizationID uuid.UUID, data *CreateInvitationData,
+) (*entities.OrganizationInvitation, error) {
+	invitation := &entities.OrganizationInvitation{
+		OrganizationID: organizationID,
+		Email:          data.Email,
+		Role:           data.Role,
+		CodeHash:       data.CodeHash,
+		InvitedBy:      data.InvitedBy,
+		ExpiresAt:      &data.ExpiresAt,
+	}
+
+	if _, err := r.db.NewInsert().Model(invitation).Returning("*").Exec(ctx); err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.IntegrityViolation() {
+			return nil, ErrInvitationAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func NewCreateInvitationRepository(db bun.IDB) CreateInvitationRepository {
+	return &createInvitationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,99 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateInvitation(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		data           *dao.CreateInvitationData
+		expect         *entities.OrganizationInvitation
+		expectErr      error
+	}{
+		{
+			name:           "CreateInvitation",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			data: &dao.CreateInvitationData{
+				Email:     "new@gmail.com",
+				Role:      entities.MembershipRoleAdmin,
+				CodeHash:  "code-hash-4",
+				InvitedBy: "firebase-uid-1",
+				ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expect: &entities.OrganizationInvitation{
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "new@gmail.com",
+				Role:           entities.MembershipRoleAdmin,
+				CodeHash:       "code-hash-4",
+				InvitedBy:      "firebase-uid-1",
+				ExpiresAt:      lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			// Accepted invitations do not prevent inviting the same email again.
+			name:           "ReinviteAfterAcceptance",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			data: &dao.CreateInvitationData{
+				Email:     "accepted@gmail.com",
+				Role:      entities.MembershipRoleMember,
+				CodeHash:  "code-hash-4",
+				InvitedBy: "firebase-uid-1",
+				ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expect: &entities.OrganizationInvitation{
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "accepted@gmail.com",
+				Role:           entities.MembershipRoleMember,
+				CodeHash:       "code-hash-4",
+				InvitedBy:      "firebase-uid-1",
+				ExpiresAt:      lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "PendingInvitationAlreadyExists",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			data: &dao.CreateInvitationData{
+				Email:     "invitee@gmail.com",
+				Role:      entities.MembershipRoleMember,
+				CodeHash:  "code-hash-4",
+				InvitedBy: "firebase-uid-1",
+				ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectErr: dao.ErrInvitationAlreadyExists,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateInvitationRepository(tx)
+			invitation, err := repo.CreateInvitation(context.TODO(), data.organizationID, data.data)
+
+			if invitation != nil {
+				// Since ID and creation date are random, nullify them for comparison.
+				invitation.ID = nil
+				invitation.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, invitation)
+		})
+	}
+}
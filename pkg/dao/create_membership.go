@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+type CreateMembershipData struct {
+	Role entities.MembershipRole
+}
+
+type CreateMembershipRepository interface {
+	CreateMembership(
+		ctx context.Context, organizationID uuid.UUID, firebaseUID string, data *CreateMembershipData,
+	) (*entities.Membership, error)
+}
+
+type createMembershipRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createMembershipRepositoryImpl) CreateMembership(
+	ctx context.Context, organizationID uuid.UUID, firebaseUID string, data *CreateMembershipData,
+) (*entities.Membership, error) {
+	membership := &entities.Membership{
+		OrganizationID: organizationID,
+		FirebaseUID:    firebaseUID,
+		Role:           data.Role,
+	}
+
+	if _, err := r.db.NewInsert().Model(membership).Returning("*").Exec(ctx); err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.IntegrityViolation() {
+			return nil, ErrMembershipAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+func NewCreateMembershipRepository(db bun.IDB) CreateMembershipRepository {
+	return &createMembershipRepositoryImpl{
+		db: db,
+	}
+}
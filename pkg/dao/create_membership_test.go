@@ -0,0 +1,69 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateMembership(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		firebaseUID    string
+		data           *dao.CreateMembershipData
+		expect         *entities.Membership
+		expectErr      error
+	}{
+		{
+			name:           "CreateMembership",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			firebaseUID:    "firebase-uid-2",
+			data: &dao.CreateMembershipData{
+				Role: entities.MembershipRoleMember,
+			},
+			expect: &entities.Membership{
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				FirebaseUID:    "firebase-uid-2",
+				Role:           entities.MembershipRoleMember,
+			},
+		},
+		{
+			name:           "MembershipAlreadyExists",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			firebaseUID:    "firebase-uid-2",
+			data: &dao.CreateMembershipData{
+				Role: entities.MembershipRoleAdmin,
+			},
+			expectErr: dao.ErrMembershipAlreadyExists,
+		},
+	}
+
+	stx := BeginTX(db, organizationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateMembershipRepository(tx)
+			membership, err := repo.CreateMembership(context.TODO(), data.organizationID, data.firebaseUID, data.data)
+
+			if membership != nil {
+				// Since ID and creation date are random, nullify them for comparison.
+				membership.ID = nil
+				membership.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, membership)
+		})
+	}
+}
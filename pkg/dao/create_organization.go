@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateOrganizationData struct {
+	Name       string
+	RequireMFA bool
+}
+
+type CreateOrganizationRepository interface {
+	CreateOrganization(ctx context.Context, ownerUID string, data *CreateOrganizationData) (*entities.Organization, error)
+}
+
+type createOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createOrganizationRepositoryImpl) CreateOrganization(
+	ctx context.Context, ownerUID string, data *CreateOrganizationData,
+) (*entities.Organization, error) {
+	organization := &entities.Organization{
+		Name:       data.Name,
+		RequireMFA: data.RequireMFA,
+	}
+
+	// An organization must never exist without an owner, so both rows are created at once.
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(organization).Returning("*").Exec(ctx); err != nil {
+			return err
+		}
+
+		membership := &entities.Membership{
+			OrganizationID: *organization.ID,
+			FirebaseUID:    ownerUID,
+			Role:           entities.MembershipRoleOwner,
+		}
+
+		_, err := tx.NewInsert().Model(membership).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+func NewCreateOrganizationRepository(db bun.IDB) CreateOrganizationRepository {
+	return &createOrganizationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name     string
+		ownerUID string
+		data     *dao.CreateOrganizationData
+		expect   *entities.Organization
+	}{
+		{
+			name:     "CreateOrganization",
+			ownerUID: "firebase-uid-1",
+			data: &dao.CreateOrganizationData{
+				Name: "organization-1",
+			},
+			expect: &entities.Organization{
+				Name: "organization-1",
+			},
+		},
+		{
+			name:     "RequireMFA",
+			ownerUID: "firebase-uid-1",
+			data: &dao.CreateOrganizationData{
+				Name:       "organization-2",
+				RequireMFA: true,
+			},
+			expect: &entities.Organization{
+				Name:       "organization-2",
+				RequireMFA: true,
+			},
+		},
+	}
+
+	stx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateOrganizationRepository(tx)
+			organization, err := repo.CreateOrganization(context.TODO(), data.ownerUID, data.data)
+			require.NoError(t, err)
+
+			// The owner membership must have been created alongside the organization.
+			membership, err := dao.NewGetMembershipRepository(tx).GetMembership(
+				context.TODO(), *organization.ID, data.ownerUID,
+			)
+			require.NoError(t, err)
+			require.Equal(t, entities.MembershipRoleOwner, membership.Role)
+
+			// Since ID and creation date are random, nullify them for comparison.
+			organization.ID = nil
+			organization.CreatedAt = nil
+
+			require.Equal(t, data.expect, organization)
+		})
+	}
+}
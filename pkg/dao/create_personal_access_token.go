@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"time"
+)
+
+type CreatePersonalAccessTokenData struct {
+	// TenantID is the Identity Platform tenant of the token owner, needed to resolve them when authenticating.
+	TenantID  string
+	Name      string
+	TokenHash string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+type CreatePersonalAccessTokenRepository interface {
+	CreatePersonalAccessToken(
+		ctx context.Context, firebaseUID string, data *CreatePersonalAccessTokenData,
+	) (*entities.PersonalAccessToken, error)
+}
+
+type createPersonalAccessTokenRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createPersonalAccessTokenRepositoryImpl) CreatePersonalAccessToken(
+	ctx context.Context, firebaseUID string, data *CreatePersonalAccessTokenData,
+) (*entities.PersonalAccessToken, error) {
+	token := &entities.PersonalAccessToken{
+		TenantID:    data.TenantID,
+		FirebaseUID: firebaseUID,
+		Name:        data.Name,
+		TokenHash:   data.TokenHash,
+		Scopes:      data.Scopes,
+		ExpiresAt:   data.ExpiresAt,
+	}
+
+	if _, err := r.db.NewInsert().Model(token).Returning("*").Exec(ctx); err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.IntegrityViolation() {
+			return nil, ErrPersonalAccessTokenAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func NewCreatePersonalAccessTokenRepository(db bun.IDB) CreatePersonalAccessTokenRepository {
+	return &createPersonalAccessTokenRepositoryImpl{
+		db: db,
+	}
+}
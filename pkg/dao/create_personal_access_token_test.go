@@ -0,0 +1,103 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var createPersonalAccessTokenFixtures = []*entities.PersonalAccessToken{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		FirebaseUID: "firebase-uid-1",
+		Name:        "token-1",
+		TokenHash:   "token-hash-1",
+		Scopes:      []string{"user:read"},
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		firebaseUID string
+		data        *dao.CreatePersonalAccessTokenData
+		expect      *entities.PersonalAccessToken
+		expectErr   error
+	}{
+		{
+			name:        "CreatePersonalAccessToken",
+			firebaseUID: "firebase-uid-1",
+			data: &dao.CreatePersonalAccessTokenData{
+				Name:      "token-2",
+				TokenHash: "token-hash-2",
+				Scopes:    []string{"user:read", "user:write"},
+				ExpiresAt: lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &entities.PersonalAccessToken{
+				FirebaseUID: "firebase-uid-1",
+				Name:        "token-2",
+				TokenHash:   "token-hash-2",
+				Scopes:      []string{"user:read", "user:write"},
+				ExpiresAt:   lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:        "CreateTenantPersonalAccessToken",
+			firebaseUID: "firebase-uid-1",
+			data: &dao.CreatePersonalAccessTokenData{
+				TenantID:  "tenant-1",
+				Name:      "token-2",
+				TokenHash: "token-hash-2",
+				Scopes:    []string{"user:read"},
+			},
+			expect: &entities.PersonalAccessToken{
+				TenantID:    "tenant-1",
+				FirebaseUID: "firebase-uid-1",
+				Name:        "token-2",
+				TokenHash:   "token-hash-2",
+				Scopes:      []string{"user:read"},
+			},
+		},
+		{
+			name:        "PersonalAccessTokenAlreadyExists",
+			firebaseUID: "firebase-uid-2",
+			data: &dao.CreatePersonalAccessTokenData{
+				Name:      "token-2",
+				TokenHash: "token-hash-1",
+				Scopes:    []string{"user:read"},
+			},
+			expectErr: dao.ErrPersonalAccessTokenAlreadyExists,
+		},
+	}
+
+	stx := BeginTX(db, createPersonalAccessTokenFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreatePersonalAccessTokenRepository(tx)
+			token, err := repo.CreatePersonalAccessToken(context.TODO(), data.firebaseUID, data.data)
+
+			if token != nil {
+				// Since ID and creation date are random, nullify them for comparison.
+				token.ID = nil
+				token.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, token)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CreateSentEmailData struct {
+	Recipient string
+	Kind      string
+
+	// The email is only recorded if the recipient received less than Max emails of the same kind since Since.
+	Since time.Time
+	Max   int
+}
+
+type CreateSentEmailRepository interface {
+	CreateSentEmail(ctx context.Context, data *CreateSentEmailData) (*entities.SentEmail, error)
+}
+
+type createSentEmailRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createSentEmailRepositoryImpl) CreateSentEmail(
+	ctx context.Context, data *CreateSentEmailData,
+) (*entities.SentEmail, error) {
+	email := &entities.SentEmail{
+		Recipient: data.Recipient,
+		Kind:      data.Kind,
+	}
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Concurrent requests for the same recipient would otherwise all see the same count.
+		_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", data.Kind+":"+data.Recipient)
+		if err != nil {
+			return err
+		}
+
+		count, err := tx.NewSelect().
+			Model((*entities.SentEmail)(nil)).
+			Where("recipient = ?", data.Recipient).
+			Where("kind = ?", data.Kind).
+			Where("created_at >= ?", data.Since).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		if count >= data.Max {
+			return ErrSentEmailThrottled
+		}
+
+		_, err = tx.NewInsert().Model(email).Returning("*").Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return email, nil
+}
+
+func NewCreateSentEmailRepository(db bun.IDB) CreateSentEmailRepository {
+	return &createSentEmailRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,126 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var sentEmailsFixtures = []*entities.SentEmail{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Recipient: "user@gmail.com",
+		Kind:      "email_verification",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		Recipient: "user@gmail.com",
+		Kind:      "email_verification",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		Recipient: "user@gmail.com",
+		Kind:      "password_reset",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		Recipient: "user2@gmail.com",
+		Kind:      "email_verification",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestCreateSentEmail(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		data      *dao.CreateSentEmailData
+		expect    *entities.SentEmail
+		expectErr error
+	}{
+		{
+			name: "CreateSentEmail",
+			data: &dao.CreateSentEmailData{
+				Recipient: "user3@gmail.com",
+				Kind:      "email_verification",
+				Since:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				Max:       1,
+			},
+			expect: &entities.SentEmail{
+				Recipient: "user3@gmail.com",
+				Kind:      "email_verification",
+			},
+		},
+		{
+			name: "BelowMax",
+			data: &dao.CreateSentEmailData{
+				Recipient: "user@gmail.com",
+				Kind:      "email_verification",
+				Since:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				Max:       3,
+			},
+			expect: &entities.SentEmail{
+				Recipient: "user@gmail.com",
+				Kind:      "email_verification",
+			},
+		},
+		{
+			name: "OlderEmailsIgnored",
+			data: &dao.CreateSentEmailData{
+				Recipient: "user@gmail.com",
+				Kind:      "email_verification",
+				Since:     time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+				Max:       2,
+			},
+			expect: &entities.SentEmail{
+				Recipient: "user@gmail.com",
+				Kind:      "email_verification",
+			},
+		},
+		{
+			name: "Throttled",
+			data: &dao.CreateSentEmailData{
+				Recipient: "user@gmail.com",
+				Kind:      "email_verification",
+				Since:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				Max:       2,
+			},
+			expectErr: dao.ErrSentEmailThrottled,
+		},
+	}
+
+	stx := BeginTX(db, sentEmailsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateSentEmailRepository(tx)
+			email, err := repo.CreateSentEmail(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if email != nil {
+				require.NotNil(t, email.CreatedAt)
+
+				// Since ID and creation date are random, nullify them for comparison.
+				email.ID = nil
+				email.CreatedAt = nil
+			}
+
+			require.Equal(t, data.expect, email)
+		})
+	}
+}
@@ -13,20 +13,30 @@ type CreateUserData struct {
 }
 
 type CreateUserRepository interface {
-	CreateUser(ctx context.Context, firebaseUID string, data *CreateUserData) (*entities.User, error)
+	CreateUser(ctx context.Context, tenantID string, firebaseUID string, data *CreateUserData) (*entities.User, error)
 }
 
 type createUserRepositoryImpl struct {
 	db bun.IDB
 }
 
-func (r *createUserRepositoryImpl) CreateUser(ctx context.Context, firebaseUID string, data *CreateUserData) (*entities.User, error) {
+func (r *createUserRepositoryImpl) CreateUser(
+	ctx context.Context, tenantID string, firebaseUID string, data *CreateUserData,
+) (*entities.User, error) {
 	user := &entities.User{
 		PublicIdentifier: data.PublicIdentifier,
+		TenantID:         tenantID,
 		FirebaseUID:      firebaseUID,
 	}
 
-	if _, err := r.db.NewInsert().Model(user).Returning("*").Exec(ctx); err != nil {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(user).Returning("*").Exec(ctx); err != nil {
+			return err
+		}
+
+		return insertUserEvent(ctx, tx, entities.OutboxEventUserCreated, user)
+	})
+	if err != nil {
 		var pgErr pgdriver.Error
 		if errors.As(err, &pgErr) && pgErr.IntegrityViolation() {
 			return nil, ErrUserAlreadyExists
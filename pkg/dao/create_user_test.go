@@ -24,9 +24,11 @@ func TestCreateUser(t *testing.T) {
 
 	testData := []struct {
 		name        string
+		tenantID    string
 		firebaseUID string
 		data        *dao.CreateUserData
 		expect      *entities.User
+		expectEvent *entities.OutboxEvent
 		expectErr   error
 	}{
 		{
@@ -39,6 +41,36 @@ func TestCreateUser(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "firebase-uid-2",
 			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-2",
+				Type:        entities.OutboxEventUserCreated,
+				Payload: &entities.UserEventPayload{
+					FirebaseUID:      "firebase-uid-2",
+					PublicIdentifier: "public-identifier-1",
+				},
+			},
+		},
+		{
+			name:        "SameUIDInAnotherTenant",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-1",
+			data: &dao.CreateUserData{
+				PublicIdentifier: "public-identifier-2",
+			},
+			expect: &entities.User{
+				PublicIdentifier: "public-identifier-2",
+				TenantID:         "tenant-1",
+				FirebaseUID:      "firebase-uid-1",
+			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-1",
+				Type:        entities.OutboxEventUserCreated,
+				Payload: &entities.UserEventPayload{
+					TenantID:         "tenant-1",
+					FirebaseUID:      "firebase-uid-1",
+					PublicIdentifier: "public-identifier-2",
+				},
+			},
 		},
 		{
 			name:        "UserAlreadyExists",
@@ -59,7 +91,7 @@ func TestCreateUser(t *testing.T) {
 			defer RollbackTX(tx)
 
 			repo := dao.NewCreateUserRepository(tx)
-			user, err := repo.CreateUser(context.TODO(), data.firebaseUID, data.data)
+			user, err := repo.CreateUser(context.TODO(), data.tenantID, data.firebaseUID, data.data)
 
 			if user != nil {
 				// Since ID is random, nullify it for comparison.
@@ -68,6 +100,14 @@ func TestCreateUser(t *testing.T) {
 
 			require.ErrorIs(t, err, data.expectErr)
 			require.Equal(t, data.expect, user)
+
+			// The event is recorded in the same transaction, and rolled back along with a failed insert.
+			expectEvents := make([]*entities.OutboxEvent, 0)
+			if data.expectEvent != nil {
+				expectEvents = append(expectEvents, data.expectEvent)
+			}
+
+			require.Equal(t, expectEvents, listOutboxEvents(tx))
 		})
 	}
 }
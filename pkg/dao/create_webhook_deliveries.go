@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/uptrace/bun"
+)
+
+type CreateWebhookDeliveriesData struct {
+	EventID   string
+	EventType string
+	Payload   json.RawMessage
+}
+
+type CreateWebhookDeliveriesRepository interface {
+	// CreateWebhookDeliveries schedules a delivery of the event to every subscription to its type, and returns how
+	// many were scheduled. Subscriptions that already have a delivery for the event are skipped.
+	CreateWebhookDeliveries(ctx context.Context, data *CreateWebhookDeliveriesData) (int, error)
+}
+
+type createWebhookDeliveriesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createWebhookDeliveriesRepositoryImpl) CreateWebhookDeliveries(
+	ctx context.Context, data *CreateWebhookDeliveriesData,
+) (int, error) {
+	res, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+		SELECT id, ?, ?, ?::jsonb FROM webhook_subscriptions WHERE ? = ANY(event_types)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING`,
+		data.EventID, data.EventType, string(data.Payload), data.EventType,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func NewCreateWebhookDeliveriesRepository(db bun.IDB) CreateWebhookDeliveriesRepository {
+	return &createWebhookDeliveriesRepositoryImpl{
+		db: db,
+	}
+}
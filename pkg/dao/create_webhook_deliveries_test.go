@@ -0,0 +1,83 @@
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateWebhookDeliveries(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name string
+		data *dao.CreateWebhookDeliveriesData
+
+		expect              int
+		expectSubscriptions []uuid.UUID
+	}{
+		{
+			name: "CreateWebhookDeliveries",
+			data: &dao.CreateWebhookDeliveriesData{
+				EventID:   "5",
+				EventType: entities.OutboxEventUserUpdated,
+				Payload:   json.RawMessage(`{"id": "5"}`),
+			},
+			expect:              1,
+			expectSubscriptions: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+		{
+			name: "NoSubscription",
+			data: &dao.CreateWebhookDeliveriesData{
+				EventID:   "5",
+				EventType: "user.unknown",
+				Payload:   json.RawMessage(`{"id": "5"}`),
+			},
+			expectSubscriptions: []uuid.UUID{},
+		},
+		{
+			// The relay published the same event twice.
+			name: "AlreadyScheduled",
+			data: &dao.CreateWebhookDeliveriesData{
+				EventID:   "1",
+				EventType: entities.OutboxEventUserCreated,
+				Payload:   json.RawMessage(`{"id": "1"}`),
+			},
+			expectSubscriptions: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateWebhookDeliveriesRepository(tx)
+			count, err := repo.CreateWebhookDeliveries(context.TODO(), data.data)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, count)
+
+			deliveries := make([]*entities.WebhookDelivery, 0)
+			err = tx.NewSelect().Model(&deliveries).Where("event_id = ?", data.data.EventID).Scan(context.TODO())
+			require.NoError(t, err)
+			require.Equal(t, data.expectSubscriptions, lo.Map(deliveries, func(item *entities.WebhookDelivery, _ int) uuid.UUID {
+				return item.SubscriptionID
+			}))
+
+			for _, delivery := range deliveries {
+				require.JSONEq(t, string(data.data.Payload), string(delivery.Payload))
+				require.Equal(t, entities.WebhookDeliveryStatusPending, delivery.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateWebhookSubscriptionData struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+type CreateWebhookSubscriptionRepository interface {
+	CreateWebhookSubscription(
+		ctx context.Context, data *CreateWebhookSubscriptionData,
+	) (*entities.WebhookSubscription, error)
+}
+
+type createWebhookSubscriptionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createWebhookSubscriptionRepositoryImpl) CreateWebhookSubscription(
+	ctx context.Context, data *CreateWebhookSubscriptionData,
+) (*entities.WebhookSubscription, error) {
+	subscription := &entities.WebhookSubscription{
+		URL:        data.URL,
+		Secret:     data.Secret,
+		EventTypes: data.EventTypes,
+	}
+
+	if _, err := r.db.NewInsert().Model(subscription).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewCreateWebhookSubscriptionRepository(db bun.IDB) CreateWebhookSubscriptionRepository {
+	return &createWebhookSubscriptionRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateWebhookSubscription(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		data      *dao.CreateWebhookSubscriptionData
+		expect    *entities.WebhookSubscription
+		expectErr error
+	}{
+		{
+			name: "CreateWebhookSubscription",
+			data: &dao.CreateWebhookSubscriptionData{
+				URL:        "https://example.com/webhooks/3",
+				Secret:     "secret-3",
+				EventTypes: []string{entities.OutboxEventUserDeleted},
+			},
+			expect: &entities.WebhookSubscription{
+				URL:        "https://example.com/webhooks/3",
+				Secret:     "secret-3",
+				EventTypes: []string{entities.OutboxEventUserDeleted},
+			},
+		},
+		{
+			// Subscriptions are independent, even for the same receiver.
+			name: "SameURL",
+			data: &dao.CreateWebhookSubscriptionData{
+				URL:        "https://example.com/webhooks/1",
+				Secret:     "secret-3",
+				EventTypes: []string{entities.OutboxEventUserCreated},
+			},
+			expect: &entities.WebhookSubscription{
+				URL:        "https://example.com/webhooks/1",
+				Secret:     "secret-3",
+				EventTypes: []string{entities.OutboxEventUserCreated},
+			},
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateWebhookSubscriptionRepository(tx)
+			subscription, err := repo.CreateWebhookSubscription(context.TODO(), data.data)
+
+			if subscription != nil {
+				// Since ID and creation date are random, nullify them for comparison.
+				subscription.ID = nil
+				subscription.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, subscription)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type DeleteExpiredInvitationsRepository interface {
+	DeleteExpiredInvitations(ctx context.Context, before time.Time) (int, error)
+}
+
+type deleteExpiredInvitationsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteExpiredInvitationsRepositoryImpl) DeleteExpiredInvitations(
+	ctx context.Context, before time.Time,
+) (int, error) {
+	res, err := r.db.NewDelete().
+		Model((*entities.OrganizationInvitation)(nil)).
+		Where("expires_at <= ?", before).
+		Where("accepted_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func NewDeleteExpiredInvitationsRepository(db bun.IDB) DeleteExpiredInvitationsRepository {
+	return &deleteExpiredInvitationsRepositoryImpl{
+		db: db,
+	}
+}
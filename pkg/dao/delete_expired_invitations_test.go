@@ -0,0 +1,53 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDeleteExpiredInvitations(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		before time.Time
+		expect int
+	}{
+		{
+			name:   "DeleteExpiredInvitations",
+			before: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			expect: 1,
+		},
+		{
+			name:   "NothingExpired",
+			before: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expect: 0,
+		},
+		{
+			// Accepted invitations are kept as history, even past their expiration date.
+			name:   "KeepAccepted",
+			before: time.Date(2032, 1, 1, 0, 0, 0, 0, time.UTC),
+			expect: 2,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteExpiredInvitationsRepository(tx)
+			count, err := repo.DeleteExpiredInvitations(context.TODO(), data.before)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, count)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type DeleteIdleRateLimitBucketsRepository interface {
+	DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) (int, error)
+}
+
+type deleteIdleRateLimitBucketsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteIdleRateLimitBucketsRepositoryImpl) DeleteIdleRateLimitBuckets(
+	ctx context.Context, before time.Time,
+) (int, error) {
+	res, err := r.db.NewDelete().
+		Model((*entities.RateLimitBucket)(nil)).
+		Where("updated_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func NewDeleteIdleRateLimitBucketsRepository(db bun.IDB) DeleteIdleRateLimitBucketsRepository {
+	return &deleteIdleRateLimitBucketsRepositoryImpl{
+		db: db,
+	}
+}
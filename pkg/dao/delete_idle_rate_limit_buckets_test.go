@@ -0,0 +1,64 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var deleteIdleRateLimitBucketsFixtures = []*entities.RateLimitBucket{
+	{
+		Key:       "peer_ip:203.0.113.1",
+		Tokens:    4,
+		Allowed:   true,
+		UpdatedAt: lo.ToPtr(time.Date(2024, 10, 13, 11, 0, 0, 0, time.UTC)),
+	},
+	{
+		Key:       "peer_ip:203.0.113.2",
+		Tokens:    0,
+		Allowed:   false,
+		UpdatedAt: lo.ToPtr(time.Date(2024, 10, 13, 12, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestDeleteIdleRateLimitBuckets(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		before time.Time
+		expect int
+	}{
+		{
+			name:   "DeleteIdleRateLimitBuckets",
+			before: time.Date(2024, 10, 13, 11, 30, 0, 0, time.UTC),
+			expect: 1,
+		},
+		{
+			name:   "NothingIdle",
+			before: time.Date(2024, 10, 13, 10, 0, 0, 0, time.UTC),
+			expect: 0,
+		},
+	}
+
+	stx := BeginTX(db, deleteIdleRateLimitBucketsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteIdleRateLimitBucketsRepository(tx)
+			count, err := repo.DeleteIdleRateLimitBuckets(context.TODO(), data.before)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, count)
+		})
+	}
+}
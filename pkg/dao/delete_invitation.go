@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteInvitationRepository interface {
+	DeleteInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error
+}
+
+type deleteInvitationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteInvitationRepositoryImpl) DeleteInvitation(
+	ctx context.Context, organizationID uuid.UUID, id uuid.UUID,
+) error {
+	// Accepted invitations are history, and cannot be revoked.
+	res, err := r.db.NewDelete().
+		Model((*entities.OrganizationInvitation)(nil)).
+		Where("id = ?", id).
+		Where("organization_id = ?", organizationID).
+		Where("accepted_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+func NewDeleteInvitationRepository(db bun.IDB) DeleteInvitationRepository {
+	return &deleteInvitationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,54 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteInvitation(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		id             uuid.UUID
+		expectErr      error
+	}{
+		{
+			name:           "DeleteInvitation",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:           "AcceptedInvitation",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			expectErr:      dao.ErrInvitationNotFound,
+		},
+		{
+			name:           "WrongOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expectErr:      dao.ErrInvitationNotFound,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteInvitationRepository(tx)
+			err := repo.DeleteInvitation(context.TODO(), data.organizationID, data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteMembershipRepository interface {
+	DeleteMembership(
+		ctx context.Context, organizationID uuid.UUID, firebaseUID string, audit *CreateAuditEventData,
+	) (*entities.Membership, error)
+}
+
+type deleteMembershipRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteMembershipRepositoryImpl) DeleteMembership(
+	ctx context.Context, organizationID uuid.UUID, firebaseUID string, audit *CreateAuditEventData,
+) (*entities.Membership, error) {
+	membership := new(entities.Membership)
+
+	// The owners of the organization are locked, so concurrent removals cannot leave it without any. The audit event
+	// is written in the same transaction, so the trail never misses a removal.
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var owners []string
+
+		err := tx.NewSelect().
+			Model((*entities.Membership)(nil)).
+			Column("firebase_uid").
+			Where("organization_id = ?", organizationID).
+			Where("role = ?", entities.MembershipRoleOwner).
+			For("UPDATE").
+			Scan(ctx, &owners)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.NewDelete().
+			Model(membership).
+			Where("organization_id = ?", organizationID).
+			Where("firebase_uid = ?", firebaseUID).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrMembershipNotFound
+		}
+
+		if membership.Role == entities.MembershipRoleOwner && len(owners) <= 1 {
+			return ErrLastOrganizationOwner
+		}
+
+		return insertAuditChain(ctx, tx, []*entities.AuditEvent{newAuditEvent(audit)})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+func NewDeleteMembershipRepository(db bun.IDB) DeleteMembershipRepository {
+	return &deleteMembershipRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDeleteMembership(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		firebaseUID    string
+		expect         *entities.Membership
+		expectErr      error
+	}{
+		{
+			name:           "DeleteMembership",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			firebaseUID:    "firebase-uid-2",
+			expect: &entities.Membership{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				FirebaseUID:    "firebase-uid-2",
+				Role:           entities.MembershipRoleMember,
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "LastOwner",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			firebaseUID:    "firebase-uid-1",
+			expectErr:      dao.ErrLastOrganizationOwner,
+		},
+		{
+			name:           "MembershipNotFound",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			firebaseUID:    "firebase-uid-2",
+			expectErr:      dao.ErrMembershipNotFound,
+		},
+	}
+
+	stx := BeginTX(db, organizationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteMembershipRepository(tx)
+			membership, err := repo.DeleteMembership(
+				context.TODO(), data.organizationID, data.firebaseUID, &dao.CreateAuditEventData{
+					ActorUID:   lo.ToPtr("firebase-uid-1"),
+					SubjectUID: lo.ToPtr(data.firebaseUID),
+					Target:     lo.ToPtr("organizations/" + data.organizationID.String()),
+					Action:     "organization.member.remove",
+					Outcome:    entities.AuditOutcomeSuccess,
+				},
+			)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, membership)
+
+			var events []*entities.AuditEvent
+			require.NoError(t, tx.NewSelect().Model(&events).Scan(context.TODO()))
+			require.Len(t, events, lo.Ternary(data.expectErr == nil, 1, 0))
+		})
+	}
+}
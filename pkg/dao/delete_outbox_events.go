@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type DeleteOutboxEventsRepository interface {
+	// DeleteOutboxEvents removes the events published, or marked dead, before the given date. Pending events are
+	// kept, whatever their age.
+	DeleteOutboxEvents(ctx context.Context, before time.Time) (int, error)
+}
+
+type deleteOutboxEventsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteOutboxEventsRepositoryImpl) DeleteOutboxEvents(ctx context.Context, before time.Time) (int, error) {
+	res, err := r.db.NewDelete().
+		Model((*entities.OutboxEvent)(nil)).
+		// Pending events have neither date, and are never matched.
+		Where("COALESCE(published_at, dead_at) < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func NewDeleteOutboxEventsRepository(db bun.IDB) DeleteOutboxEventsRepository {
+	return &deleteOutboxEventsRepositoryImpl{
+		db: db,
+	}
+}
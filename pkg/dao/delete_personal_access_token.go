@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeletePersonalAccessTokenRepository interface {
+	DeletePersonalAccessToken(ctx context.Context, firebaseUID string, id uuid.UUID) error
+}
+
+type deletePersonalAccessTokenRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deletePersonalAccessTokenRepositoryImpl) DeletePersonalAccessToken(
+	ctx context.Context, firebaseUID string, id uuid.UUID,
+) error {
+	// Filtering on the owner prevents a user from revoking someone else's token.
+	res, err := r.db.NewDelete().
+		Model((*entities.PersonalAccessToken)(nil)).
+		Where("id = ?", id).
+		Where("firebase_uid = ?", firebaseUID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrPersonalAccessTokenNotFound
+	}
+
+	return nil
+}
+
+func NewDeletePersonalAccessTokenRepository(db bun.IDB) DeletePersonalAccessTokenRepository {
+	return &deletePersonalAccessTokenRepositoryImpl{
+		db: db,
+	}
+}
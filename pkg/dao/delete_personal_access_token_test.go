@@ -0,0 +1,68 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var deletePersonalAccessTokenFixtures = []*entities.PersonalAccessToken{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		FirebaseUID: "firebase-uid-1",
+		Name:        "token-1",
+		TokenHash:   "token-hash-1",
+		Scopes:      []string{"user:read"},
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestDeletePersonalAccessToken(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		firebaseUID string
+		id          uuid.UUID
+		expectErr   error
+	}{
+		{
+			name:        "DeletePersonalAccessToken",
+			firebaseUID: "firebase-uid-1",
+			id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:        "PersonalAccessTokenNotFound",
+			firebaseUID: "firebase-uid-1",
+			id:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			expectErr:   dao.ErrPersonalAccessTokenNotFound,
+		},
+		{
+			name:        "PersonalAccessTokenOwnedByAnotherUser",
+			firebaseUID: "firebase-uid-2",
+			id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expectErr:   dao.ErrPersonalAccessTokenNotFound,
+		},
+	}
+
+	stx := BeginTX(db, deletePersonalAccessTokenFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeletePersonalAccessTokenRepository(tx)
+			err := repo.DeletePersonalAccessToken(context.TODO(), data.firebaseUID, data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteSentEmailRepository interface {
+	DeleteSentEmail(ctx context.Context, id uuid.UUID) error
+}
+
+type deleteSentEmailRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteSentEmailRepositoryImpl) DeleteSentEmail(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.NewDelete().
+		Model((*entities.SentEmail)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrSentEmailNotFound
+	}
+
+	return nil
+}
+
+func NewDeleteSentEmailRepository(db bun.IDB) DeleteSentEmailRepository {
+	return &deleteSentEmailRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,45 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteSentEmail(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		expectErr error
+	}{
+		{
+			name: "DeleteSentEmail",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:      "SentEmailNotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			expectErr: dao.ErrSentEmailNotFound,
+		},
+	}
+
+	stx := BeginTX(db, sentEmailsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteSentEmailRepository(tx)
+			err := repo.DeleteSentEmail(context.TODO(), data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type DeleteStaleSignInContextsRepository interface {
+	DeleteStaleSignInContexts(ctx context.Context, before time.Time) (int, error)
+}
+
+type deleteStaleSignInContextsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteStaleSignInContextsRepositoryImpl) DeleteStaleSignInContexts(
+	ctx context.Context, before time.Time,
+) (int, error) {
+	res, err := r.db.NewDelete().
+		Model((*entities.SignInContext)(nil)).
+		Where("last_seen_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func NewDeleteStaleSignInContextsRepository(db bun.IDB) DeleteStaleSignInContextsRepository {
+	return &deleteStaleSignInContextsRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,57 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDeleteStaleSignInContexts(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name         string
+		before       time.Time
+		expect       int
+		expectRemain []int64
+	}{
+		{
+			name:         "DeleteStaleSignInContexts",
+			before:       time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC),
+			expect:       1,
+			expectRemain: []int64{2, 3, 4},
+		},
+		{
+			name:         "NothingToDelete",
+			before:       time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+			expectRemain: []int64{1, 2, 3, 4},
+		},
+	}
+
+	stx := BeginTX(db, listSignInContextsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteStaleSignInContextsRepository(tx)
+			count, err := repo.DeleteStaleSignInContexts(context.TODO(), data.before)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, count)
+
+			var remaining []*entities.SignInContext
+			require.NoError(t, tx.NewSelect().Model(&remaining).Order("id ASC").Scan(context.TODO()))
+			require.Equal(t, data.expectRemain, lo.Map(remaining, func(item *entities.SignInContext, _ int) int64 {
+				return item.ID
+			}))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteUserRepository interface {
+	DeleteUser(ctx context.Context, tenantID string, firebaseUID string) error
+}
+
+type deleteUserRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteUserRepositoryImpl) DeleteUser(ctx context.Context, tenantID string, firebaseUID string) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		user := new(entities.User)
+
+		res, err := tx.NewDelete().
+			Model(user).
+			Where("tenant_id = ?", tenantID).
+			Where("firebase_uid = ?", firebaseUID).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrUserNotFound
+		}
+
+		return insertUserEvent(ctx, tx, entities.OutboxEventUserDeleted, user)
+	})
+}
+
+func NewDeleteUserRepository(db bun.IDB) DeleteUserRepository {
+	return &deleteUserRepositoryImpl{
+		db: db,
+	}
+}
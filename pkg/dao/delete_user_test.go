@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var deleteUserFixtures = []*entities.User{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		PublicIdentifier: "public-identifier-1",
+		FirebaseUID:      "firebase-uid-1",
+	},
+}
+
+func TestDeleteUser(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		tenantID    string
+		firebaseUID string
+		expectEvent *entities.OutboxEvent
+		expectErr   error
+	}{
+		{
+			name:        "DeleteUser",
+			firebaseUID: "firebase-uid-1",
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-1",
+				Type:        entities.OutboxEventUserDeleted,
+				Payload: &entities.UserEventPayload{
+					FirebaseUID:      "firebase-uid-1",
+					PublicIdentifier: "public-identifier-1",
+				},
+			},
+		},
+		{
+			name:        "UserNotFoundInTenant",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-1",
+			expectErr:   dao.ErrUserNotFound,
+		},
+		{
+			name:        "UserNotFound",
+			firebaseUID: "firebase-uid-2",
+			expectErr:   dao.ErrUserNotFound,
+		},
+	}
+
+	stx := BeginTX(db, deleteUserFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteUserRepository(tx)
+			err := repo.DeleteUser(context.TODO(), data.tenantID, data.firebaseUID)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			expectEvents := make([]*entities.OutboxEvent, 0)
+			if data.expectEvent != nil {
+				expectEvents = append(expectEvents, data.expectEvent)
+			}
+
+			require.Equal(t, expectEvents, listOutboxEvents(tx))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteWebhookSubscriptionRepository interface {
+	DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error
+}
+
+type deleteWebhookSubscriptionRepositoryImpl struct {
+	db bun.IDB
+}
+
+// DeleteWebhookSubscription also deletes the deliveries of the subscription, including pending ones.
+func (r *deleteWebhookSubscriptionRepositoryImpl) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.NewDelete().
+		Model((*entities.WebhookSubscription)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func NewDeleteWebhookSubscriptionRepository(db bun.IDB) DeleteWebhookSubscriptionRepository {
+	return &deleteWebhookSubscriptionRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,56 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteWebhookSubscription(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		expectErr error
+	}{
+		{
+			name: "DeleteWebhookSubscription",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:      "SubscriptionNotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			expectErr: dao.ErrWebhookSubscriptionNotFound,
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteWebhookSubscriptionRepository(tx)
+			err := repo.DeleteWebhookSubscription(context.TODO(), data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expectErr == nil {
+				// Deliveries go along with their subscription.
+				count, err := tx.NewSelect().
+					Model((*entities.WebhookDelivery)(nil)).
+					Where("subscription_id = ?", data.id).
+					Count(context.TODO())
+				require.NoError(t, err)
+				require.Zero(t, count)
+			}
+		})
+	}
+}
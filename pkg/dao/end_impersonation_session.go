@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type EndImpersonationSessionRepository interface {
+	// EndImpersonationSession ends a session of the impersonator, that has not ended yet.
+	EndImpersonationSession(
+		ctx context.Context, impersonatorUID string, id uuid.UUID, endedAt time.Time,
+	) (*entities.ImpersonationSession, error)
+}
+
+type endImpersonationSessionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *endImpersonationSessionRepositoryImpl) EndImpersonationSession(
+	ctx context.Context, impersonatorUID string, id uuid.UUID, endedAt time.Time,
+) (*entities.ImpersonationSession, error) {
+	session := new(entities.ImpersonationSession)
+
+	// Filtering on the impersonator prevents a staff member from ending someone else's session.
+	res, err := r.db.NewUpdate().
+		Model(session).
+		Set("ended_at = ?", endedAt).
+		Where("id = ?", id).
+		Where("impersonator_uid = ?", impersonatorUID).
+		Where("ended_at IS NULL").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrImpersonationSessionNotFound
+	}
+
+	return session, nil
+}
+
+func NewEndImpersonationSessionRepository(db bun.IDB) EndImpersonationSessionRepository {
+	return &endImpersonationSessionRepositoryImpl{
+		db: db,
+	}
+}
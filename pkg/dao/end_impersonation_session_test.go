@@ -0,0 +1,99 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var endImpersonationSessionFixtures = []*entities.ImpersonationSession{
+	{
+		ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		ImpersonatorUID: "staff-uid-1",
+		TargetUID:       "firebase-uid-1",
+		Reason:          "ticket 1234",
+		Scopes:          []string{"user:read"},
+		ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+		CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		ImpersonatorUID: "staff-uid-1",
+		TargetUID:       "firebase-uid-1",
+		Reason:          "ticket 1234",
+		Scopes:          []string{"user:read"},
+		ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+		EndedAt:         lo.ToPtr(time.Date(2024, 10, 15, 12, 5, 0, 0, time.UTC)),
+		CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestEndImpersonationSession(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name            string
+		impersonatorUID string
+		id              uuid.UUID
+		expect          *entities.ImpersonationSession
+		expectErr       error
+	}{
+		{
+			name:            "EndImpersonationSession",
+			impersonatorUID: "staff-uid-1",
+			id:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expect: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{"user:read"},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+				EndedAt:         lo.ToPtr(time.Date(2024, 10, 15, 12, 10, 0, 0, time.UTC)),
+				CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:            "AlreadyEnded",
+			impersonatorUID: "staff-uid-1",
+			id:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			expectErr:       dao.ErrImpersonationSessionNotFound,
+		},
+		{
+			name:            "OtherImpersonator",
+			impersonatorUID: "staff-uid-2",
+			id:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expectErr:       dao.ErrImpersonationSessionNotFound,
+		},
+		{
+			name:            "ImpersonationSessionNotFound",
+			impersonatorUID: "staff-uid-1",
+			id:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			expectErr:       dao.ErrImpersonationSessionNotFound,
+		},
+	}
+
+	stx := BeginTX(db, endImpersonationSessionFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewEndImpersonationSessionRepository(tx)
+			session, err := repo.EndImpersonationSession(
+				context.TODO(), data.impersonatorUID, data.id, time.Date(2024, 10, 15, 12, 10, 0, 0, time.UTC),
+			)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, session)
+		})
+	}
+}
@@ -5,4 +5,34 @@ import "errors"
 var (
 	ErrUserAlreadyExists = errors.New("user already exists")
 	ErrUserNotFound      = errors.New("user not found")
+
+	ErrPublicIdentifierUnavailable = errors.New("public identifier unavailable")
+
+	ErrUserActivityNotFound = errors.New("user activity not found")
+
+	ErrPersonalAccessTokenAlreadyExists = errors.New("personal access token already exists")
+	ErrPersonalAccessTokenNotFound      = errors.New("personal access token not found")
+
+	ErrOrganizationNotFound = errors.New("organization not found")
+
+	ErrMembershipAlreadyExists = errors.New("membership already exists")
+	ErrMembershipNotFound      = errors.New("membership not found")
+	ErrLastOrganizationOwner   = errors.New("last organization owner")
+
+	ErrInvitationAlreadyExists = errors.New("invitation already exists")
+	ErrInvitationNotFound      = errors.New("invitation not found")
+
+	ErrAuditEventNotFound      = errors.New("audit event not found")
+	ErrAuditCheckpointNotFound = errors.New("audit checkpoint not found")
+
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+
+	ErrImpersonationSessionNotFound = errors.New("impersonation session not found")
+
+	ErrSentEmailNotFound  = errors.New("sent email not found")
+	ErrSentEmailThrottled = errors.New("too many emails sent recently")
+
+	ErrUserAliasNotFound      = errors.New("user alias not found")
+	ErrUserAliasAlreadyExists = errors.New("user alias already exists")
 )
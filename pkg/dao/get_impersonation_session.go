@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetImpersonationSessionRepository interface {
+	GetImpersonationSession(ctx context.Context, id uuid.UUID) (*entities.ImpersonationSession, error)
+}
+
+type getImpersonationSessionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getImpersonationSessionRepositoryImpl) GetImpersonationSession(
+	ctx context.Context, id uuid.UUID,
+) (*entities.ImpersonationSession, error) {
+	session := new(entities.ImpersonationSession)
+
+	err := r.db.NewSelect().Model(session).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrImpersonationSessionNotFound
+		}
+
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func NewGetImpersonationSessionRepository(db bun.IDB) GetImpersonationSessionRepository {
+	return &getImpersonationSessionRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getImpersonationSessionFixtures = []*entities.ImpersonationSession{
+	{
+		ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		ImpersonatorUID: "staff-uid-1",
+		TargetUID:       "firebase-uid-1",
+		Reason:          "ticket 1234",
+		Scopes:          []string{"user:read"},
+		ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+		CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetImpersonationSession(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		expect    *entities.ImpersonationSession
+		expectErr error
+	}{
+		{
+			name: "GetImpersonationSession",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expect: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{"user:read"},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+				CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "ImpersonationSessionNotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			expectErr: dao.ErrImpersonationSessionNotFound,
+		},
+	}
+
+	stx := BeginTX(db, getImpersonationSessionFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetImpersonationSessionRepository(tx)
+			session, err := repo.GetImpersonationSession(context.TODO(), data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, session)
+		})
+	}
+}
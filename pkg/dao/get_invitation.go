@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetInvitationRepository interface {
+	GetInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*entities.OrganizationInvitation, error)
+}
+
+type getInvitationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getInvitationRepositoryImpl) GetInvitation(
+	ctx context.Context, organizationID uuid.UUID, id uuid.UUID,
+) (*entities.OrganizationInvitation, error) {
+	invitation := new(entities.OrganizationInvitation)
+
+	err := r.db.NewSelect().
+		Model(invitation).
+		Relation("Organization").
+		Where("organization_invitation.id = ?", id).
+		Where("organization_invitation.organization_id = ?", organizationID).
+		Where("organization_invitation.accepted_at IS NULL").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func NewGetInvitationRepository(db bun.IDB) GetInvitationRepository {
+	return &getInvitationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetInvitationByCodeRepository interface {
+	GetInvitationByCode(ctx context.Context, codeHash string) (*entities.OrganizationInvitation, error)
+}
+
+type getInvitationByCodeRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getInvitationByCodeRepositoryImpl) GetInvitationByCode(
+	ctx context.Context, codeHash string,
+) (*entities.OrganizationInvitation, error) {
+	invitation := new(entities.OrganizationInvitation)
+
+	// Accepted invitations are still returned, so callers can tell a used code apart from an unknown one.
+	err := r.db.NewSelect().
+		Model(invitation).
+		Relation("Organization").
+		Where("organization_invitation.code_hash = ?", codeHash).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func NewGetInvitationByCodeRepository(db bun.IDB) GetInvitationByCodeRepository {
+	return &getInvitationByCodeRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,84 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetInvitationByCode(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	organization := &entities.Organization{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name:      "organization-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	testData := []struct {
+		name      string
+		codeHash  string
+		expect    *entities.OrganizationInvitation
+		expectErr error
+	}{
+		{
+			name:     "GetInvitationByCode",
+			codeHash: "code-hash-1",
+			expect: &entities.OrganizationInvitation{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "invitee@gmail.com",
+				Role:           entities.MembershipRoleMember,
+				CodeHash:       "code-hash-1",
+				InvitedBy:      "firebase-uid-1",
+				Organization:   organization,
+				ExpiresAt:      lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "AcceptedInvitation",
+			codeHash: "code-hash-3",
+			expect: &entities.OrganizationInvitation{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "accepted@gmail.com",
+				Role:           entities.MembershipRoleMember,
+				CodeHash:       "code-hash-3",
+				InvitedBy:      "firebase-uid-1",
+				Organization:   organization,
+				ExpiresAt:      lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+				AcceptedAt:     lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				AcceptedBy:     lo.ToPtr("firebase-uid-3"),
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "InvitationNotFound",
+			codeHash:  "code-hash-4",
+			expectErr: dao.ErrInvitationNotFound,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetInvitationByCodeRepository(tx)
+			invitation, err := repo.GetInvitationByCode(context.TODO(), data.codeHash)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, invitation)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetInvitation(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		id             uuid.UUID
+		expect         *entities.OrganizationInvitation
+		expectErr      error
+	}{
+		{
+			name:           "GetInvitation",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expect: &entities.OrganizationInvitation{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "invitee@gmail.com",
+				Role:           entities.MembershipRoleMember,
+				CodeHash:       "code-hash-1",
+				InvitedBy:      "firebase-uid-1",
+				Organization: &entities.Organization{
+					ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Name:      "organization-1",
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				ExpiresAt: lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "WrongOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expectErr:      dao.ErrInvitationNotFound,
+		},
+		{
+			name:           "AcceptedInvitation",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			expectErr:      dao.ErrInvitationNotFound,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetInvitationRepository(tx)
+			invitation, err := repo.GetInvitation(context.TODO(), data.organizationID, data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, invitation)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetLastAuditCheckpointRepository interface {
+	// GetLastAuditCheckpoint returns the checkpoint with the highest sequence.
+	GetLastAuditCheckpoint(ctx context.Context) (*entities.AuditCheckpoint, error)
+}
+
+type getLastAuditCheckpointRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getLastAuditCheckpointRepositoryImpl) GetLastAuditCheckpoint(
+	ctx context.Context,
+) (*entities.AuditCheckpoint, error) {
+	checkpoint := new(entities.AuditCheckpoint)
+
+	err := r.db.NewSelect().
+		Model(checkpoint).
+		Order("sequence DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAuditCheckpointNotFound
+		}
+
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+func NewGetLastAuditCheckpointRepository(db bun.IDB) GetLastAuditCheckpointRepository {
+	return &getLastAuditCheckpointRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,46 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetLastAuditCheckpoint(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		fixtures  []interface{}
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:     "GetLastAuditCheckpoint",
+			fixtures: auditChainFixtures,
+			expect:   2,
+		},
+		{
+			name:      "NoCheckpoint",
+			fixtures:  auditChainFixtures[:3],
+			expectErr: dao.ErrAuditCheckpointNotFound,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX(db, data.fixtures)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetLastAuditCheckpointRepository(tx)
+			checkpoint, err := repo.GetLastAuditCheckpoint(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			if data.expectErr == nil {
+				require.Equal(t, data.expect, checkpoint.Sequence)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetLastAuditEventRepository interface {
+	// GetLastAuditEvent returns the most recent event of the audit chain.
+	GetLastAuditEvent(ctx context.Context) (*entities.AuditEvent, error)
+}
+
+type getLastAuditEventRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getLastAuditEventRepositoryImpl) GetLastAuditEvent(ctx context.Context) (*entities.AuditEvent, error) {
+	event := new(entities.AuditEvent)
+
+	err := r.db.NewSelect().
+		Model(event).
+		Where("hash IS NOT NULL").
+		Order("id DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAuditEventNotFound
+		}
+
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func NewGetLastAuditEventRepository(db bun.IDB) GetLastAuditEventRepository {
+	return &getLastAuditEventRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,47 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetLastAuditEvent(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		fixtures  []interface{}
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:     "GetLastAuditEvent",
+			fixtures: auditChainFixtures,
+			expect:   3,
+		},
+		{
+			// Events recorded before chaining was introduced are not part of the chain.
+			name:      "NoChainedEvent",
+			fixtures:  auditChainFixtures[:1],
+			expectErr: dao.ErrAuditEventNotFound,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX(db, data.fixtures)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetLastAuditEventRepository(tx)
+			event, err := repo.GetLastAuditEvent(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			if data.expectErr == nil {
+				require.Equal(t, data.expect, event.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetMembershipRepository interface {
+	GetMembership(ctx context.Context, organizationID uuid.UUID, firebaseUID string) (*entities.Membership, error)
+}
+
+type getMembershipRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getMembershipRepositoryImpl) GetMembership(
+	ctx context.Context, organizationID uuid.UUID, firebaseUID string,
+) (*entities.Membership, error) {
+	membership := new(entities.Membership)
+
+	err := r.db.NewSelect().
+		Model(membership).
+		Relation("Organization").
+		Where("membership.organization_id = ?", organizationID).
+		Where("membership.firebase_uid = ?", firebaseUID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMembershipNotFound
+		}
+
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+func NewGetMembershipRepository(db bun.IDB) GetMembershipRepository {
+	return &getMembershipRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,65 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetMembership(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		firebaseUID    string
+		expect         *entities.Membership
+		expectErr      error
+	}{
+		{
+			name:           "GetMembership",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			firebaseUID:    "firebase-uid-2",
+			expect: &entities.Membership{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				FirebaseUID:    "firebase-uid-2",
+				Role:           entities.MembershipRoleMember,
+				Organization: &entities.Organization{
+					ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Name:      "organization-1",
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "MembershipNotFound",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			firebaseUID:    "firebase-uid-2",
+			expectErr:      dao.ErrMembershipNotFound,
+		},
+	}
+
+	stx := BeginTX(db, organizationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetMembershipRepository(tx)
+			membership, err := repo.GetMembership(context.TODO(), data.organizationID, data.firebaseUID)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, membership)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetPersonalAccessTokenRepository interface {
+	GetPersonalAccessToken(ctx context.Context, tokenHash string) (*entities.PersonalAccessToken, error)
+}
+
+type getPersonalAccessTokenRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getPersonalAccessTokenRepositoryImpl) GetPersonalAccessToken(
+	ctx context.Context, tokenHash string,
+) (*entities.PersonalAccessToken, error) {
+	token := new(entities.PersonalAccessToken)
+
+	err := r.db.NewSelect().Model(token).Where("token_hash = ?", tokenHash).Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPersonalAccessTokenNotFound
+		}
+
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func NewGetPersonalAccessTokenRepository(db bun.IDB) GetPersonalAccessTokenRepository {
+	return &getPersonalAccessTokenRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getPersonalAccessTokenFixtures = []*entities.PersonalAccessToken{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		FirebaseUID: "firebase-uid-1",
+		Name:        "token-1",
+		TokenHash:   "token-hash-1",
+		Scopes:      []string{"user:read"},
+		ExpiresAt:   lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetPersonalAccessToken(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		tokenHash string
+		expect    *entities.PersonalAccessToken
+		expectErr error
+	}{
+		{
+			name:      "GetPersonalAccessToken",
+			tokenHash: "token-hash-1",
+			expect: &entities.PersonalAccessToken{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				FirebaseUID: "firebase-uid-1",
+				Name:        "token-1",
+				TokenHash:   "token-hash-1",
+				Scopes:      []string{"user:read"},
+				ExpiresAt:   lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "PersonalAccessTokenNotFound",
+			tokenHash: "token-hash-2",
+			expectErr: dao.ErrPersonalAccessTokenNotFound,
+		},
+	}
+
+	stx := BeginTX(db, getPersonalAccessTokenFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetPersonalAccessTokenRepository(tx)
+			token, err := repo.GetPersonalAccessToken(context.TODO(), data.tokenHash)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, token)
+		})
+	}
+}
@@ -9,17 +9,21 @@ import (
 )
 
 type GetUserRepository interface {
-	GetUser(ctx context.Context, uid string) (*entities.User, error)
+	GetUser(ctx context.Context, tenantID string, uid string) (*entities.User, error)
 }
 
 type getUserRepositoryImpl struct {
 	db bun.IDB
 }
 
-func (r *getUserRepositoryImpl) GetUser(ctx context.Context, uid string) (*entities.User, error) {
+func (r *getUserRepositoryImpl) GetUser(ctx context.Context, tenantID string, uid string) (*entities.User, error) {
 	user := new(entities.User)
 
-	err := r.db.NewSelect().Model(user).Where("firebase_uid = ?", uid).Scan(ctx)
+	err := r.db.NewSelect().
+		Model(user).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid = ?", uid).
+		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrUserNotFound
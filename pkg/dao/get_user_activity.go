@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetUserActivityRepository interface {
+	GetUserActivity(ctx context.Context, tenantID string, firebaseUID string) (*entities.UserActivity, error)
+}
+
+type getUserActivityRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getUserActivityRepositoryImpl) GetUserActivity(
+	ctx context.Context, tenantID string, firebaseUID string,
+) (*entities.UserActivity, error) {
+	activity := new(entities.UserActivity)
+
+	err := r.db.NewSelect().
+		Model(activity).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid = ?", firebaseUID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserActivityNotFound
+		}
+
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func NewGetUserActivityRepository(db bun.IDB) GetUserActivityRepository {
+	return &getUserActivityRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getUserActivityFixtures = []*entities.UserActivity{
+	{
+		FirebaseUID:         "firebase-uid-1",
+		LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC)),
+		AuthenticationCount: 10,
+		LastPeerIP:          lo.ToPtr("203.0.113.1"),
+	},
+}
+
+func TestGetUserActivity(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		tenantID    string
+		firebaseUID string
+		expect      *entities.UserActivity
+		expectErr   error
+	}{
+		{
+			name:        "GetUserActivity",
+			firebaseUID: "firebase-uid-1",
+			expect: &entities.UserActivity{
+				FirebaseUID:         "firebase-uid-1",
+				LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC)),
+				AuthenticationCount: 10,
+				LastPeerIP:          lo.ToPtr("203.0.113.1"),
+			},
+		},
+		{
+			name:        "AnotherTenant",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-1",
+			expectErr:   dao.ErrUserActivityNotFound,
+		},
+		{
+			name:        "UserActivityNotFound",
+			firebaseUID: "firebase-uid-2",
+			expectErr:   dao.ErrUserActivityNotFound,
+		},
+	}
+
+	stx := BeginTX(db, getUserActivityFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetUserActivityRepository(tx)
+			activity, err := repo.GetUserActivity(context.TODO(), data.tenantID, data.firebaseUID)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, activity)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetUserAliasRepository interface {
+	GetUserAlias(ctx context.Context, tenantID string, aliasUID string) (*entities.UserAlias, error)
+}
+
+type getUserAliasRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getUserAliasRepositoryImpl) GetUserAlias(
+	ctx context.Context, tenantID string, aliasUID string,
+) (*entities.UserAlias, error) {
+	alias := new(entities.UserAlias)
+
+	err := r.db.NewSelect().
+		Model(alias).
+		Where("tenant_id = ?", tenantID).
+		Where("alias_uid = ?", aliasUID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserAliasNotFound
+		}
+
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+func NewGetUserAliasRepository(db bun.IDB) GetUserAliasRepository {
+	return &getUserAliasRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetUserAlias(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		tenantID  string
+		aliasUID  string
+		expect    *entities.UserAlias
+		expectErr error
+	}{
+		{
+			name:     "GetUserAlias",
+			aliasUID: "firebase-uid-4",
+			expect: &entities.UserAlias{
+				AliasUID:     "firebase-uid-4",
+				CanonicalUID: "firebase-uid-3",
+				CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetTenantUserAlias",
+			tenantID: "tenant-1",
+			aliasUID: "firebase-uid-5",
+			expect: &entities.UserAlias{
+				TenantID:     "tenant-1",
+				AliasUID:     "firebase-uid-5",
+				CanonicalUID: "firebase-uid-1",
+				CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "UserAliasNotFoundInTenant",
+			aliasUID:  "firebase-uid-5",
+			expectErr: dao.ErrUserAliasNotFound,
+		},
+		{
+			name:      "CanonicalUID",
+			aliasUID:  "firebase-uid-3",
+			expectErr: dao.ErrUserAliasNotFound,
+		},
+	}
+
+	stx := BeginTX(db, userAliasesFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetUserAliasRepository(tx)
+			alias, err := repo.GetUserAlias(context.TODO(), data.tenantID, data.aliasUID)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, alias)
+		})
+	}
+}
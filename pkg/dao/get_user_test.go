@@ -16,6 +16,12 @@ var getUserFixtures = []*entities.User{
 		PublicIdentifier: "public-identifier-1",
 		FirebaseUID:      "firebase-uid-1",
 	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		PublicIdentifier: "public-identifier-2",
+		TenantID:         "tenant-1",
+		FirebaseUID:      "firebase-uid-1",
+	},
 }
 
 func TestGetUser(t *testing.T) {
@@ -24,6 +30,7 @@ func TestGetUser(t *testing.T) {
 
 	testData := []struct {
 		name        string
+		tenantID    string
 		firebaseUID string
 		expect      *entities.User
 		expectErr   error
@@ -37,6 +44,23 @@ func TestGetUser(t *testing.T) {
 				FirebaseUID:      "firebase-uid-1",
 			},
 		},
+		{
+			name:        "GetTenantUser",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-1",
+			expect: &entities.User{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				PublicIdentifier: "public-identifier-2",
+				TenantID:         "tenant-1",
+				FirebaseUID:      "firebase-uid-1",
+			},
+		},
+		{
+			name:        "UserNotFoundInTenant",
+			tenantID:    "tenant-2",
+			firebaseUID: "firebase-uid-1",
+			expectErr:   dao.ErrUserNotFound,
+		},
 		{
 			name:        "UserNotFound",
 			firebaseUID: "firebase-uid-2",
@@ -53,7 +77,7 @@ func TestGetUser(t *testing.T) {
 			defer RollbackTX(tx)
 
 			repo := dao.NewGetUserRepository(tx)
-			user, err := repo.GetUser(context.TODO(), data.firebaseUID)
+			user, err := repo.GetUser(context.TODO(), data.tenantID, data.firebaseUID)
 
 			require.ErrorIs(t, err, data.expectErr)
 			require.Equal(t, data.expect, user)
@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type LinkUsersRepository interface {
+	// LinkUsers makes aliasUID an alias of canonicalUID, along with the aliases of aliasUID. The row of the alias is
+	// dropped if the canonical user has one, and takes the canonical UID otherwise, so the user keeps their public
+	// identifier. The canonical UID must not be an alias itself.
+	LinkUsers(ctx context.Context, tenantID string, canonicalUID string, aliasUID string) (*entities.UserAlias, error)
+}
+
+type linkUsersRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *linkUsersRepositoryImpl) LinkUsers(
+	ctx context.Context, tenantID string, canonicalUID string, aliasUID string,
+) (*entities.UserAlias, error) {
+	alias := &entities.UserAlias{
+		TenantID:     tenantID,
+		AliasUID:     aliasUID,
+		CanonicalUID: canonicalUID,
+	}
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := insertUserAlias(ctx, tx, alias); err != nil {
+			return err
+		}
+
+		return mergeUserRows(ctx, tx, tenantID, canonicalUID, aliasUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+// mergeUserRows moves the row of aliasUID to canonicalUID, unless canonicalUID already has one.
+func mergeUserRows(ctx context.Context, tx bun.Tx, tenantID string, canonicalUID string, aliasUID string) error {
+	aliasUser := new(entities.User)
+
+	res, err := tx.NewDelete().
+		Model(aliasUser).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid = ?", aliasUID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return nil
+	}
+
+	if err := insertUserEvent(ctx, tx, entities.OutboxEventUserDeleted, aliasUser); err != nil {
+		return err
+	}
+
+	canonicalExists, err := tx.NewSelect().
+		Model((*entities.User)(nil)).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid = ?", canonicalUID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if canonicalExists {
+		return nil
+	}
+
+	canonicalUser := &entities.User{
+		PublicIdentifier: aliasUser.PublicIdentifier,
+		TenantID:         tenantID,
+		FirebaseUID:      canonicalUID,
+	}
+
+	if _, err := tx.NewInsert().Model(canonicalUser).Returning("*").Exec(ctx); err != nil {
+		return err
+	}
+
+	return insertUserEvent(ctx, tx, entities.OutboxEventUserCreated, canonicalUser)
+}
+
+func NewLinkUsersRepository(db bun.IDB) LinkUsersRepository {
+	return &linkUsersRepositoryImpl{
+		db: db,
+	}
+}
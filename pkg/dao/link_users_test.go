@@ -0,0 +1,206 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+func listUserAliases(db bun.IDB, tenantID string) []*entities.UserAlias {
+	aliases := make([]*entities.UserAlias, 0)
+	err := db.NewSelect().Model(&aliases).Where("tenant_id = ?", tenantID).Order("alias_uid ASC").Scan(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	for _, alias := range aliases {
+		alias.CreatedAt = nil
+	}
+
+	return aliases
+}
+
+func TestLinkUsers(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name          string
+		canonicalUID  string
+		aliasUID      string
+		expect        *entities.UserAlias
+		expectAliases []*entities.UserAlias
+		expectEvents  []*entities.OutboxEvent
+		expectErr     error
+	}{
+		{
+			name:         "LinkUsers",
+			canonicalUID: "firebase-uid-1",
+			aliasUID:     "firebase-uid-2",
+			expect: &entities.UserAlias{
+				AliasUID:     "firebase-uid-2",
+				CanonicalUID: "firebase-uid-1",
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-2",
+					Type:        entities.OutboxEventUserDeleted,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-2",
+						PublicIdentifier: "public-identifier-2",
+					},
+				},
+			},
+		},
+		{
+			name:         "MoveRow",
+			canonicalUID: "firebase-uid-6",
+			aliasUID:     "firebase-uid-2",
+			expect: &entities.UserAlias{
+				AliasUID:     "firebase-uid-2",
+				CanonicalUID: "firebase-uid-6",
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-6"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-2",
+					Type:        entities.OutboxEventUserDeleted,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-2",
+						PublicIdentifier: "public-identifier-2",
+					},
+				},
+				{
+					AggregateID: "firebase-uid-6",
+					Type:        entities.OutboxEventUserCreated,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-6",
+						PublicIdentifier: "public-identifier-2",
+					},
+				},
+			},
+		},
+		{
+			name:         "RepointAliases",
+			canonicalUID: "firebase-uid-1",
+			aliasUID:     "firebase-uid-3",
+			expect: &entities.UserAlias{
+				AliasUID:     "firebase-uid-3",
+				CanonicalUID: "firebase-uid-1",
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-3", CanonicalUID: "firebase-uid-1"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-1"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-3",
+					Type:        entities.OutboxEventUserDeleted,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-3",
+						PublicIdentifier: "public-identifier-3",
+					},
+				},
+			},
+		},
+		{
+			name:         "NoRows",
+			canonicalUID: "firebase-uid-1",
+			aliasUID:     "firebase-uid-6",
+			expect: &entities.UserAlias{
+				AliasUID:     "firebase-uid-6",
+				CanonicalUID: "firebase-uid-1",
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+				{AliasUID: "firebase-uid-6", CanonicalUID: "firebase-uid-1"},
+			},
+			expectEvents: []*entities.OutboxEvent{},
+		},
+		{
+			name:         "AliasAlreadyExists",
+			canonicalUID: "firebase-uid-1",
+			aliasUID:     "firebase-uid-4",
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{},
+			expectErr:    dao.ErrUserAliasAlreadyExists,
+		},
+		{
+			name:         "CanonicalIsAlias",
+			canonicalUID: "firebase-uid-4",
+			aliasUID:     "firebase-uid-1",
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{},
+			expectErr:    dao.ErrUserAliasAlreadyExists,
+		},
+	}
+
+	stx := BeginTX(db, userAliasesFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewLinkUsersRepository(tx)
+			alias, err := repo.LinkUsers(context.TODO(), "", data.canonicalUID, data.aliasUID)
+
+			if alias != nil {
+				// Since creation date is random, nullify it for comparison.
+				alias.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, alias)
+			require.Equal(t, data.expectAliases, listUserAliases(tx, ""))
+			require.Equal(t, data.expectEvents, listOutboxEvents(tx))
+		})
+	}
+}
+
+func TestLinkUsersConcurrently(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	const tenantID = "tenant-concurrent-links"
+	defer func() {
+		_, _ = db.NewDelete().Model((*entities.UserAlias)(nil)).Where("tenant_id = ?", tenantID).Exec(context.TODO())
+	}()
+
+	// The first link is not committed yet when the opposite one starts.
+	tx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(tx)
+
+	_, err := dao.NewLinkUsersRepository(tx).LinkUsers(context.TODO(), tenantID, "firebase-uid-1", "firebase-uid-2")
+	require.NoError(t, err)
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := dao.NewLinkUsersRepository(db).LinkUsers(context.TODO(), tenantID, "firebase-uid-2", "firebase-uid-1")
+		errs <- err
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, tx.Commit())
+
+	require.ErrorIs(t, <-errs, dao.ErrUserAliasAlreadyExists)
+	require.Equal(t, []*entities.UserAlias{
+		{TenantID: tenantID, AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+	}, listUserAliases(db, tenantID))
+}
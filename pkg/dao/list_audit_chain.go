@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListAuditChainRepository interface {
+	// ListAuditChain returns events in chronological order, starting after the given ID.
+	ListAuditChain(ctx context.Context, afterID int64, limit int) ([]*entities.AuditEvent, error)
+}
+
+type listAuditChainRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listAuditChainRepositoryImpl) ListAuditChain(
+	ctx context.Context, afterID int64, limit int,
+) ([]*entities.AuditEvent, error) {
+	events := make([]*entities.AuditEvent, 0)
+
+	err := r.db.NewSelect().
+		Model(&events).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func NewListAuditChainRepository(db bun.IDB) ListAuditChainRepository {
+	return &listAuditChainRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,58 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListAuditChain(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name    string
+		afterID int64
+		limit   int
+		expect  []int64
+	}{
+		{
+			name:   "ListAuditChain",
+			limit:  10,
+			expect: []int64{1, 2, 3},
+		},
+		{
+			name:    "Paginate",
+			afterID: 1,
+			limit:   1,
+			expect:  []int64{2},
+		},
+		{
+			name:    "NoEvents",
+			afterID: 3,
+			limit:   10,
+			expect:  []int64{},
+		},
+	}
+
+	stx := BeginTX(db, auditChainFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListAuditChainRepository(tx)
+			events, err := repo.ListAuditChain(context.TODO(), data.afterID, data.limit)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, lo.Map(events, func(item *entities.AuditEvent, _ int) int64 {
+				return item.ID
+			}))
+		})
+	}
+}
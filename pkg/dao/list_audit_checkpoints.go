@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListAuditCheckpointsRepository interface {
+	ListAuditCheckpoints(ctx context.Context) ([]*entities.AuditCheckpoint, error)
+}
+
+type listAuditCheckpointsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listAuditCheckpointsRepositoryImpl) ListAuditCheckpoints(ctx context.Context) ([]*entities.AuditCheckpoint, error) {
+	checkpoints := make([]*entities.AuditCheckpoint, 0)
+
+	if err := r.db.NewSelect().Model(&checkpoints).Order("event_id ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return checkpoints, nil
+}
+
+func NewListAuditCheckpointsRepository(db bun.IDB) ListAuditCheckpointsRepository {
+	return &listAuditCheckpointsRepositoryImpl{
+		db: db,
+	}
+}
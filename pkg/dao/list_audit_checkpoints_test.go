@@ -0,0 +1,26 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListAuditCheckpoints(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX(db, auditChainFixtures)
+	defer RollbackTX(tx)
+
+	repo := dao.NewListAuditCheckpointsRepository(tx)
+	checkpoints, err := repo.ListAuditCheckpoints(context.TODO())
+
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, lo.Map(checkpoints, func(item *entities.AuditCheckpoint, _ int) int64 {
+		return item.EventID
+	}))
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListAuditEventsData struct {
+	ActorUID   string
+	SubjectUID string
+	Action     string
+
+	// BeforeID only returns events older than the given ID. It is ignored when zero.
+	BeforeID int64
+	Limit    int
+}
+
+type ListAuditEventsRepository interface {
+	ListAuditEvents(ctx context.Context, data *ListAuditEventsData) ([]*entities.AuditEvent, error)
+}
+
+type listAuditEventsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listAuditEventsRepositoryImpl) ListAuditEvents(
+	ctx context.Context, data *ListAuditEventsData,
+) ([]*entities.AuditEvent, error) {
+	events := make([]*entities.AuditEvent, 0)
+
+	query := r.db.NewSelect().Model(&events)
+
+	if data.ActorUID != "" {
+		query = query.Where("actor_uid = ?", data.ActorUID)
+	}
+	if data.SubjectUID != "" {
+		query = query.Where("subject_uid = ?", data.SubjectUID)
+	}
+	if data.Action != "" {
+		query = query.Where("action = ?", data.Action)
+	}
+	if data.BeforeID > 0 {
+		query = query.Where("id < ?", data.BeforeID)
+	}
+
+	if err := query.Order("id DESC").Limit(data.Limit).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func NewListAuditEventsRepository(db bun.IDB) ListAuditEventsRepository {
+	return &listAuditEventsRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,106 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var listAuditEventsFixtures = []*entities.AuditEvent{
+	{
+		ID:         1,
+		ActorUID:   lo.ToPtr("firebase-uid-1"),
+		SubjectUID: lo.ToPtr("firebase-uid-1"),
+		Action:     "user.update",
+		Outcome:    entities.AuditOutcomeSuccess,
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        2,
+		Action:    "authenticate",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    lo.ToPtr("invalid token"),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:         3,
+		ActorUID:   lo.ToPtr("firebase-uid-1"),
+		SubjectUID: lo.ToPtr("firebase-uid-2"),
+		Target:     lo.ToPtr("organizations/00000000-0000-0000-0000-000000000001"),
+		Action:     "organization.member.add",
+		Outcome:    entities.AuditOutcomeSuccess,
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:         4,
+		ActorUID:   lo.ToPtr("firebase-uid-2"),
+		SubjectUID: lo.ToPtr("firebase-uid-2"),
+		Action:     "user.update",
+		Outcome:    entities.AuditOutcomeSuccess,
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestListAuditEvents(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		data   *dao.ListAuditEventsData
+		expect []int64
+	}{
+		{
+			name:   "ListAll",
+			data:   &dao.ListAuditEventsData{Limit: 10},
+			expect: []int64{4, 3, 2, 1},
+		},
+		{
+			name:   "FilterByActor",
+			data:   &dao.ListAuditEventsData{ActorUID: "firebase-uid-1", Limit: 10},
+			expect: []int64{3, 1},
+		},
+		{
+			name:   "FilterBySubject",
+			data:   &dao.ListAuditEventsData{SubjectUID: "firebase-uid-2", Limit: 10},
+			expect: []int64{4, 3},
+		},
+		{
+			name:   "FilterByAction",
+			data:   &dao.ListAuditEventsData{Action: "user.update", Limit: 10},
+			expect: []int64{4, 1},
+		},
+		{
+			name:   "Paginate",
+			data:   &dao.ListAuditEventsData{BeforeID: 4, Limit: 2},
+			expect: []int64{3, 2},
+		},
+		{
+			name:   "NoEvents",
+			data:   &dao.ListAuditEventsData{ActorUID: "firebase-uid-3", Limit: 10},
+			expect: []int64{},
+		},
+	}
+
+	stx := BeginTX(db, listAuditEventsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListAuditEventsRepository(tx)
+			events, err := repo.ListAuditEvents(context.TODO(), data.data)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, lo.Map(events, func(item *entities.AuditEvent, _ int) int64 {
+				return item.ID
+			}))
+		})
+	}
+}
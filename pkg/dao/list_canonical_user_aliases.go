@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListCanonicalUserAliasesRepository interface {
+	// ListCanonicalUserAliases returns the aliases linked to the given canonical UIDs.
+	ListCanonicalUserAliases(ctx context.Context, tenantID string, canonicalUIDs []string) ([]*entities.UserAlias, error)
+}
+
+type listCanonicalUserAliasesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listCanonicalUserAliasesRepositoryImpl) ListCanonicalUserAliases(
+	ctx context.Context, tenantID string, canonicalUIDs []string,
+) ([]*entities.UserAlias, error) {
+	aliases := make([]*entities.UserAlias, 0)
+
+	err := r.db.NewSelect().
+		Model(&aliases).
+		Where("tenant_id = ?", tenantID).
+		Where("canonical_uid IN (?)", bun.In(canonicalUIDs)).
+		Order("alias_uid").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+func NewListCanonicalUserAliasesRepository(db bun.IDB) ListCanonicalUserAliasesRepository {
+	return &listCanonicalUserAliasesRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,70 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListCanonicalUserAliases(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name          string
+		tenantID      string
+		canonicalUIDs []string
+		expect        []*entities.UserAlias
+		expectErr     error
+	}{
+		{
+			name:          "ListCanonicalUserAliases",
+			canonicalUIDs: []string{"firebase-uid-1", "firebase-uid-2", "firebase-uid-3"},
+			expect: []*entities.UserAlias{
+				{
+					AliasUID:     "firebase-uid-4",
+					CanonicalUID: "firebase-uid-3",
+					CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:          "ListTenantCanonicalUserAliases",
+			tenantID:      "tenant-1",
+			canonicalUIDs: []string{"firebase-uid-1", "firebase-uid-3"},
+			expect: []*entities.UserAlias{
+				{
+					TenantID:     "tenant-1",
+					AliasUID:     "firebase-uid-5",
+					CanonicalUID: "firebase-uid-1",
+					CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:          "NoAliases",
+			canonicalUIDs: []string{"firebase-uid-1", "firebase-uid-4"},
+			expect:        []*entities.UserAlias{},
+		},
+	}
+
+	stx := BeginTX(db, userAliasesFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListCanonicalUserAliasesRepository(tx)
+			aliases, err := repo.ListCanonicalUserAliases(context.TODO(), data.tenantID, data.canonicalUIDs)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, aliases)
+		})
+	}
+}
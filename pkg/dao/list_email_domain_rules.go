@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListEmailDomainRulesRepository interface {
+	ListEmailDomainRules(ctx context.Context) ([]*entities.EmailDomainRule, error)
+}
+
+type listEmailDomainRulesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listEmailDomainRulesRepositoryImpl) ListEmailDomainRules(ctx context.Context) ([]*entities.EmailDomainRule, error) {
+	rules := make([]*entities.EmailDomainRule, 0)
+
+	err := r.db.NewSelect().Model(&rules).Order("tenant_id", "domain", "kind").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func NewListEmailDomainRulesRepository(db bun.IDB) ListEmailDomainRulesRepository {
+	return &listEmailDomainRulesRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,76 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var listEmailDomainRulesFixtures = []*entities.EmailDomainRule{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		TenantID:  "tenant-1",
+		Domain:    "company.com",
+		Kind:      entities.EmailDomainRuleAllow,
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		Domain:    "*.disposable.com",
+		Kind:      entities.EmailDomainRuleBlock,
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestListEmailDomainRules(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name     string
+		fixtures []*entities.EmailDomainRule
+		expect   []*entities.EmailDomainRule
+	}{
+		{
+			name:     "ListEmailDomainRules",
+			fixtures: listEmailDomainRulesFixtures,
+			expect: []*entities.EmailDomainRule{
+				{
+					ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					Domain:    "*.disposable.com",
+					Kind:      entities.EmailDomainRuleBlock,
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					TenantID:  "tenant-1",
+					Domain:    "company.com",
+					Kind:      entities.EmailDomainRuleAllow,
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:   "ListEmailDomainRulesEmpty",
+			expect: []*entities.EmailDomainRule{},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX(db, data.fixtures)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListEmailDomainRulesRepository(tx)
+			rules, err := repo.ListEmailDomainRules(context.TODO())
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, rules)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListInvitationsRepository interface {
+	ListInvitations(ctx context.Context, organizationID uuid.UUID) ([]*entities.OrganizationInvitation, error)
+}
+
+type listInvitationsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listInvitationsRepositoryImpl) ListInvitations(
+	ctx context.Context, organizationID uuid.UUID,
+) ([]*entities.OrganizationInvitation, error) {
+	invitations := make([]*entities.OrganizationInvitation, 0)
+
+	// Only pending invitations are listed. Expired ones are kept until cleaned up, so they can be resent.
+	err := r.db.NewSelect().
+		Model(&invitations).
+		Where("organization_id = ?", organizationID).
+		Where("accepted_at IS NULL").
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+func NewListInvitationsRepository(db bun.IDB) ListInvitationsRepository {
+	return &listInvitationsRepositoryImpl{
+		db: db,
+	}
+}
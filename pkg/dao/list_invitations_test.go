@@ -0,0 +1,54 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListInvitations(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		expect         []uuid.UUID
+	}{
+		{
+			name:           "ListInvitations",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			expect: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+		},
+		{
+			name:           "NoInvitations",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			expect:         []uuid.UUID{},
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListInvitationsRepository(tx)
+			invitations, err := repo.ListInvitations(context.TODO(), data.organizationID)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, lo.Map(invitations, func(item *entities.OrganizationInvitation, _ int) uuid.UUID {
+				return *item.ID
+			}))
+		})
+	}
+}
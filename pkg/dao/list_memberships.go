@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListMembershipsRepository interface {
+	ListMemberships(ctx context.Context, firebaseUID string) ([]*entities.Membership, error)
+}
+
+type listMembershipsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listMembershipsRepositoryImpl) ListMemberships(
+	ctx context.Context, firebaseUID string,
+) ([]*entities.Membership, error) {
+	memberships := make([]*entities.Membership, 0)
+
+	err := r.db.NewSelect().
+		Model(&memberships).
+		Relation("Organization").
+		Where("membership.firebase_uid = ?", firebaseUID).
+		Order("membership.created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+func NewListMembershipsRepository(db bun.IDB) ListMembershipsRepository {
+	return &listMembershipsRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,75 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListMemberships(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		firebaseUID string
+		expect      []*entities.Membership
+	}{
+		{
+			name:        "ListMemberships",
+			firebaseUID: "firebase-uid-1",
+			expect: []*entities.Membership{
+				{
+					ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					FirebaseUID:    "firebase-uid-1",
+					Role:           entities.MembershipRoleOwner,
+					Organization: &entities.Organization{
+						ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Name:      "organization-1",
+						CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					},
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+					OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					FirebaseUID:    "firebase-uid-1",
+					Role:           entities.MembershipRoleAdmin,
+					Organization: &entities.Organization{
+						ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						Name:      "organization-2",
+						CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+					},
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:        "NoMemberships",
+			firebaseUID: "firebase-uid-3",
+			expect:      []*entities.Membership{},
+		},
+	}
+
+	stx := BeginTX(db, organizationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListMembershipsRepository(tx)
+			memberships, err := repo.ListMemberships(context.TODO(), data.firebaseUID)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, memberships)
+		})
+	}
+}
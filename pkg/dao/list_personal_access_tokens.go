@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListPersonalAccessTokensRepository interface {
+	ListPersonalAccessTokens(ctx context.Context, firebaseUID string) ([]*entities.PersonalAccessToken, error)
+}
+
+type listPersonalAccessTokensRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listPersonalAccessTokensRepositoryImpl) ListPersonalAccessTokens(
+	ctx context.Context, firebaseUID string,
+) ([]*entities.PersonalAccessToken, error) {
+	tokens := make([]*entities.PersonalAccessToken, 0)
+
+	err := r.db.NewSelect().
+		Model(&tokens).
+		Where("firebase_uid = ?", firebaseUID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func NewListPersonalAccessTokensRepository(db bun.IDB) ListPersonalAccessTokensRepository {
+	return &listPersonalAccessTokensRepositoryImpl{
+		db: db,
+	}
+}
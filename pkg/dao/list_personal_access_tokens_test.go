@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var listPersonalAccessTokensFixtures = []*entities.PersonalAccessToken{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		FirebaseUID: "firebase-uid-1",
+		Name:        "token-1",
+		TokenHash:   "token-hash-1",
+		Scopes:      []string{"user:read"},
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		FirebaseUID: "firebase-uid-1",
+		Name:        "token-2",
+		TokenHash:   "token-hash-2",
+		Scopes:      []string{"user:write"},
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		FirebaseUID: "firebase-uid-2",
+		Name:        "token-3",
+		TokenHash:   "token-hash-3",
+		Scopes:      []string{"user:read"},
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		firebaseUID string
+		expect      []*entities.PersonalAccessToken
+	}{
+		{
+			name:        "ListPersonalAccessTokens",
+			firebaseUID: "firebase-uid-1",
+			expect: []*entities.PersonalAccessToken{
+				{
+					ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					FirebaseUID: "firebase-uid-1",
+					Name:        "token-2",
+					TokenHash:   "token-hash-2",
+					Scopes:      []string{"user:write"},
+					CreatedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					FirebaseUID: "firebase-uid-1",
+					Name:        "token-1",
+					TokenHash:   "token-hash-1",
+					Scopes:      []string{"user:read"},
+					CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:        "ListPersonalAccessTokensEmpty",
+			firebaseUID: "firebase-uid-3",
+			expect:      []*entities.PersonalAccessToken{},
+		},
+	}
+
+	stx := BeginTX(db, listPersonalAccessTokensFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListPersonalAccessTokensRepository(tx)
+			tokens, err := repo.ListPersonalAccessTokens(context.TODO(), data.firebaseUID)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, tokens)
+		})
+	}
+}
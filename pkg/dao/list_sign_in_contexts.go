@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListSignInContextsRepository interface {
+	// ListSignInContexts returns the contexts a user authenticated from, most recently seen first.
+	ListSignInContexts(ctx context.Context, tenantID string, firebaseUID string, limit int) ([]*entities.SignInContext, error)
+}
+
+type listSignInContextsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listSignInContextsRepositoryImpl) ListSignInContexts(
+	ctx context.Context, tenantID string, firebaseUID string, limit int,
+) ([]*entities.SignInContext, error) {
+	signInContexts := make([]*entities.SignInContext, 0)
+
+	err := r.db.NewSelect().
+		Model(&signInContexts).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid = ?", firebaseUID).
+		Order("last_seen_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signInContexts, nil
+}
+
+func NewListSignInContextsRepository(db bun.IDB) ListSignInContextsRepository {
+	return &listSignInContextsRepositoryImpl{
+		db: db,
+	}
+}
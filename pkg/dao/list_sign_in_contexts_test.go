@@ -0,0 +1,110 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var listSignInContextsFixtures = []*entities.SignInContext{
+	{
+		ID:          1,
+		FirebaseUID: "firebase-uid-1",
+		Device:      "device-1",
+		Network:     "203.0.113.0/24",
+		Country:     lo.ToPtr("FR"),
+		FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)),
+		LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          2,
+		FirebaseUID: "firebase-uid-1",
+		Device:      "device-2",
+		Network:     "198.51.100.0/24",
+		FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)),
+		LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          3,
+		FirebaseUID: "firebase-uid-2",
+		Device:      "device-1",
+		Network:     "203.0.113.0/24",
+		FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+		LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          4,
+		TenantID:    "tenant-1",
+		FirebaseUID: "firebase-uid-1",
+		Device:      "device-1",
+		Network:     "203.0.113.0/24",
+		FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+		LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestListSignInContexts(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		tenantID    string
+		firebaseUID string
+		limit       int
+		expect      []*entities.SignInContext
+	}{
+		{
+			name:        "ListSignInContexts",
+			firebaseUID: "firebase-uid-1",
+			limit:       10,
+			expect: []*entities.SignInContext{
+				listSignInContextsFixtures[1],
+				listSignInContextsFixtures[0],
+			},
+		},
+		{
+			name:        "Limit",
+			firebaseUID: "firebase-uid-1",
+			limit:       1,
+			expect: []*entities.SignInContext{
+				listSignInContextsFixtures[1],
+			},
+		},
+		{
+			name:        "TenantSignInContexts",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-1",
+			limit:       10,
+			expect: []*entities.SignInContext{
+				listSignInContextsFixtures[3],
+			},
+		},
+		{
+			name:        "NoSignInContexts",
+			firebaseUID: "firebase-uid-3",
+			limit:       10,
+			expect:      []*entities.SignInContext{},
+		},
+	}
+
+	stx := BeginTX(db, listSignInContextsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListSignInContextsRepository(tx)
+			signInContexts, err := repo.ListSignInContexts(context.TODO(), data.tenantID, data.firebaseUID, data.limit)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, signInContexts)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListUserAliasesRepository interface {
+	// ListUserAliases returns the aliases among the given UIDs. UIDs that are not aliases are left out.
+	ListUserAliases(ctx context.Context, tenantID string, aliasUIDs []string) ([]*entities.UserAlias, error)
+}
+
+type listUserAliasesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listUserAliasesRepositoryImpl) ListUserAliases(
+	ctx context.Context, tenantID string, aliasUIDs []string,
+) ([]*entities.UserAlias, error) {
+	aliases := make([]*entities.UserAlias, 0)
+
+	err := r.db.NewSelect().
+		Model(&aliases).
+		Where("tenant_id = ?", tenantID).
+		Where("alias_uid IN (?)", bun.In(aliasUIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+func NewListUserAliasesRepository(db bun.IDB) ListUserAliasesRepository {
+	return &listUserAliasesRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,70 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListUserAliases(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		tenantID  string
+		aliasUIDs []string
+		expect    []*entities.UserAlias
+		expectErr error
+	}{
+		{
+			name:      "ListUserAliases",
+			aliasUIDs: []string{"firebase-uid-1", "firebase-uid-4", "firebase-uid-5"},
+			expect: []*entities.UserAlias{
+				{
+					AliasUID:     "firebase-uid-4",
+					CanonicalUID: "firebase-uid-3",
+					CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:      "ListTenantUserAliases",
+			tenantID:  "tenant-1",
+			aliasUIDs: []string{"firebase-uid-4", "firebase-uid-5"},
+			expect: []*entities.UserAlias{
+				{
+					TenantID:     "tenant-1",
+					AliasUID:     "firebase-uid-5",
+					CanonicalUID: "firebase-uid-1",
+					CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:      "NoAliases",
+			aliasUIDs: []string{"firebase-uid-1", "firebase-uid-2"},
+			expect:    []*entities.UserAlias{},
+		},
+	}
+
+	stx := BeginTX(db, userAliasesFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListUserAliasesRepository(tx)
+			aliases, err := repo.ListUserAliases(context.TODO(), data.tenantID, data.aliasUIDs)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, aliases)
+		})
+	}
+}
@@ -7,17 +7,23 @@ import (
 )
 
 type ListUsersRepository interface {
-	ListUsers(ctx context.Context, firebaseUIDs []string) ([]*entities.User, error)
+	ListUsers(ctx context.Context, tenantID string, firebaseUIDs []string) ([]*entities.User, error)
 }
 
 type listUsersRepositoryImpl struct {
 	db bun.IDB
 }
 
-func (r *listUsersRepositoryImpl) ListUsers(ctx context.Context, firebaseUIDs []string) ([]*entities.User, error) {
+func (r *listUsersRepositoryImpl) ListUsers(
+	ctx context.Context, tenantID string, firebaseUIDs []string,
+) ([]*entities.User, error) {
 	users := make([]*entities.User, 0)
 
-	err := r.db.NewSelect().Model(&users).Where("firebase_uid IN (?)", bun.In(firebaseUIDs)).Scan(ctx)
+	err := r.db.NewSelect().
+		Model(&users).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid IN (?)", bun.In(firebaseUIDs)).
+		Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
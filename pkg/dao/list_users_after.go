@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListUsersAfterRepository interface {
+	// ListUsersAfter pages through the users of a tenant, ordered by Firebase UID. An empty afterUID starts from the
+	// first user.
+	ListUsersAfter(ctx context.Context, tenantID string, afterUID string, limit int) ([]*entities.User, error)
+}
+
+type listUsersAfterRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listUsersAfterRepositoryImpl) ListUsersAfter(
+	ctx context.Context, tenantID string, afterUID string, limit int,
+) ([]*entities.User, error) {
+	users := make([]*entities.User, 0)
+
+	err := r.db.NewSelect().
+		Model(&users).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid > ?", afterUID).
+		Order("firebase_uid ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func NewListUsersAfterRepository(db bun.IDB) ListUsersAfterRepository {
+	return &listUsersAfterRepositoryImpl{
+		db: db,
+	}
+}
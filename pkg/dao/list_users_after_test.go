@@ -0,0 +1,112 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var listUsersAfterFixtures = []*entities.User{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		PublicIdentifier: "public-identifier-1",
+		FirebaseUID:      "firebase-uid-2",
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		PublicIdentifier: "public-identifier-2",
+		FirebaseUID:      "firebase-uid-1",
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		PublicIdentifier: "public-identifier-3",
+		FirebaseUID:      "firebase-uid-3",
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		PublicIdentifier: "public-identifier-4",
+		TenantID:         "tenant-1",
+		FirebaseUID:      "firebase-uid-1",
+	},
+}
+
+func TestListUsersAfter(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name     string
+		tenantID string
+		afterUID string
+		limit    int
+		expect   []*entities.User
+	}{
+		{
+			name:  "FirstPage",
+			limit: 2,
+			expect: []*entities.User{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					PublicIdentifier: "public-identifier-2",
+					FirebaseUID:      "firebase-uid-1",
+				},
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "firebase-uid-2",
+				},
+			},
+		},
+		{
+			name:     "NextPage",
+			afterUID: "firebase-uid-2",
+			limit:    2,
+			expect: []*entities.User{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+					PublicIdentifier: "public-identifier-3",
+					FirebaseUID:      "firebase-uid-3",
+				},
+			},
+		},
+		{
+			name:     "TenantUsers",
+			tenantID: "tenant-1",
+			limit:    2,
+			expect: []*entities.User{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+					PublicIdentifier: "public-identifier-4",
+					TenantID:         "tenant-1",
+					FirebaseUID:      "firebase-uid-1",
+				},
+			},
+		},
+		{
+			name:     "LastPage",
+			afterUID: "firebase-uid-3",
+			limit:    2,
+			expect:   []*entities.User{},
+		},
+	}
+
+	stx := BeginTX(db, listUsersAfterFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListUsersAfterRepository(tx)
+			users, err := repo.ListUsersAfter(context.TODO(), data.tenantID, data.afterUID, data.limit)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, users)
+		})
+	}
+}
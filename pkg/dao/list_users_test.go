@@ -26,6 +26,12 @@ var listUserFixtures = []*entities.User{
 		PublicIdentifier: "public-identifier-3",
 		FirebaseUID:      "firebase-uid-3",
 	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		PublicIdentifier: "public-identifier-4",
+		TenantID:         "tenant-1",
+		FirebaseUID:      "firebase-uid-1",
+	},
 }
 
 func TestListUsers(t *testing.T) {
@@ -34,6 +40,7 @@ func TestListUsers(t *testing.T) {
 
 	testData := []struct {
 		name         string
+		tenantID     string
 		firebaseUIDs []string
 		expect       []*entities.User
 	}{
@@ -53,6 +60,19 @@ func TestListUsers(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "ListTenantUsers",
+			tenantID:     "tenant-1",
+			firebaseUIDs: []string{"firebase-uid-1", "firebase-uid-3"},
+			expect: []*entities.User{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+					PublicIdentifier: "public-identifier-4",
+					TenantID:         "tenant-1",
+					FirebaseUID:      "firebase-uid-1",
+				},
+			},
+		},
 		{
 			name:         "ListUsersEmpty",
 			firebaseUIDs: []string{"firebase-uid-4"},
@@ -69,7 +89,7 @@ func TestListUsers(t *testing.T) {
 			defer RollbackTX(tx)
 
 			repo := dao.NewListUsersRepository(tx)
-			users, err := repo.ListUsers(context.TODO(), data.firebaseUIDs)
+			users, err := repo.ListUsers(context.TODO(), data.tenantID, data.firebaseUIDs)
 
 			require.NoError(t, err)
 			require.Equal(t, data.expect, users)
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListWebhookDeliveriesData struct {
+	SubscriptionID uuid.UUID
+	// Status is optional.
+	Status entities.WebhookDeliveryStatus
+	Limit  int
+}
+
+type ListWebhookDeliveriesRepository interface {
+	// ListWebhookDeliveries returns the most recent deliveries first, each with its log of attempts.
+	ListWebhookDeliveries(ctx context.Context, data *ListWebhookDeliveriesData) ([]*entities.WebhookDelivery, error)
+}
+
+type listWebhookDeliveriesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listWebhookDeliveriesRepositoryImpl) ListWebhookDeliveries(
+	ctx context.Context, data *ListWebhookDeliveriesData,
+) ([]*entities.WebhookDelivery, error) {
+	deliveries := make([]*entities.WebhookDelivery, 0)
+
+	query := r.db.NewSelect().
+		Model(&deliveries).
+		Relation("Log", func(query *bun.SelectQuery) *bun.SelectQuery {
+			return query.Order("id ASC")
+		}).
+		Where("subscription_id = ?", data.SubscriptionID).
+		Order("created_at DESC").
+		Limit(data.Limit)
+
+	if data.Status != "" {
+		query = query.Where("status = ?", data.Status)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func NewListWebhookDeliveriesRepository(db bun.IDB) ListWebhookDeliveriesRepository {
+	return &listWebhookDeliveriesRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,89 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListWebhookDeliveries(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name string
+		data *dao.ListWebhookDeliveriesData
+
+		expect    []uuid.UUID
+		expectLog map[uuid.UUID][]int64
+	}{
+		{
+			name: "ListWebhookDeliveries",
+			data: &dao.ListWebhookDeliveriesData{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Limit:          10,
+			},
+			expect: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			expectLog: map[uuid.UUID][]int64{
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"): {1},
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"): {},
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"): {},
+			},
+		},
+		{
+			name: "Status",
+			data: &dao.ListWebhookDeliveriesData{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Status:         entities.WebhookDeliveryStatusDead,
+				Limit:          10,
+			},
+			expect: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000004")},
+			expectLog: map[uuid.UUID][]int64{
+				uuid.MustParse("00000000-0000-0000-0000-000000000004"): {2, 3},
+			},
+		},
+		{
+			name: "Limit",
+			data: &dao.ListWebhookDeliveriesData{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Limit:          1,
+			},
+			expect: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000003")},
+			expectLog: map[uuid.UUID][]int64{
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"): {1},
+			},
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListWebhookDeliveriesRepository(tx)
+			deliveries, err := repo.ListWebhookDeliveries(context.TODO(), data.data)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, lo.Map(deliveries, func(item *entities.WebhookDelivery, _ int) uuid.UUID {
+				return *item.ID
+			}))
+
+			for _, delivery := range deliveries {
+				require.Equal(t, data.expectLog[*delivery.ID], lo.Map(delivery.Log, func(item *entities.WebhookDeliveryAttempt, _ int) int64 {
+					return item.ID
+				}))
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type MarkOutboxEventDeadRepository interface {
+	MarkOutboxEventDead(ctx context.Context, id int64, lastError string) error
+}
+
+type markOutboxEventDeadRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *markOutboxEventDeadRepositoryImpl) MarkOutboxEventDead(ctx context.Context, id int64, lastError string) error {
+	_, err := r.db.NewUpdate().
+		Model((*entities.OutboxEvent)(nil)).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", lastError).
+		Set("dead_at = NOW()").
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
+
+func NewMarkOutboxEventDeadRepository(db bun.IDB) MarkOutboxEventDeadRepository {
+	return &markOutboxEventDeadRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,38 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMarkOutboxEventDead(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX(db, outboxEventsFixtures)
+	defer RollbackTX(tx)
+
+	repo := dao.NewMarkOutboxEventDeadRepository(tx)
+	require.NoError(t, repo.MarkOutboxEventDead(context.TODO(), 2, "publish failed for good"))
+
+	event := &entities.OutboxEvent{ID: 2}
+	require.NoError(t, tx.NewSelect().Model(event).WherePK().Scan(context.TODO()))
+
+	require.Equal(t, 1, event.Attempts)
+	require.Equal(t, lo.ToPtr("publish failed for good"), event.LastError)
+	require.NotNil(t, event.DeadAt)
+	require.Nil(t, event.PublishedAt)
+
+	// The dead event is never claimed again, and the next event of the same user is unblocked.
+	claimRepo := dao.NewClaimOutboxEventsRepository(tx)
+	events, err := claimRepo.ClaimOutboxEvents(context.TODO(), time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4, 7}, lo.Map(events, func(item *entities.OutboxEvent, _ int) int64 {
+		return item.ID
+	}))
+}
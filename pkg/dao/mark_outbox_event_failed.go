@@ -0,0 +1,36 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type MarkOutboxEventFailedRepository interface {
+	MarkOutboxEventFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error
+}
+
+type markOutboxEventFailedRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *markOutboxEventFailedRepositoryImpl) MarkOutboxEventFailed(
+	ctx context.Context, id int64, lastError string, nextAttemptAt time.Time,
+) error {
+	_, err := r.db.NewUpdate().
+		Model((*entities.OutboxEvent)(nil)).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", lastError).
+		Set("next_attempt_at = ?", nextAttemptAt).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
+
+func NewMarkOutboxEventFailedRepository(db bun.IDB) MarkOutboxEventFailedRepository {
+	return &markOutboxEventFailedRepositoryImpl{
+		db: db,
+	}
+}
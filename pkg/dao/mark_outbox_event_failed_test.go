@@ -0,0 +1,32 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMarkOutboxEventFailed(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX(db, outboxEventsFixtures)
+	defer RollbackTX(tx)
+
+	nextAttemptAt := time.Date(2100, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	repo := dao.NewMarkOutboxEventFailedRepository(tx)
+	require.NoError(t, repo.MarkOutboxEventFailed(context.TODO(), 5, "publish failed again", nextAttemptAt))
+
+	event := &entities.OutboxEvent{ID: 5}
+	require.NoError(t, tx.NewSelect().Model(event).WherePK().Scan(context.TODO()))
+
+	require.Equal(t, 2, event.Attempts)
+	require.Equal(t, lo.ToPtr("publish failed again"), event.LastError)
+	require.True(t, nextAttemptAt.Equal(*event.NextAttemptAt))
+	require.Nil(t, event.PublishedAt)
+}
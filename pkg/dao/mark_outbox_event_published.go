@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type MarkOutboxEventPublishedRepository interface {
+	MarkOutboxEventPublished(ctx context.Context, id int64) error
+}
+
+type markOutboxEventPublishedRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *markOutboxEventPublishedRepositoryImpl) MarkOutboxEventPublished(ctx context.Context, id int64) error {
+	_, err := r.db.NewUpdate().
+		Model((*entities.OutboxEvent)(nil)).
+		Set("published_at = NOW()").
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
+
+func NewMarkOutboxEventPublishedRepository(db bun.IDB) MarkOutboxEventPublishedRepository {
+	return &markOutboxEventPublishedRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,31 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMarkOutboxEventPublished(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	tx := BeginTX(db, outboxEventsFixtures)
+	defer RollbackTX(tx)
+
+	repo := dao.NewMarkOutboxEventPublishedRepository(tx)
+	require.NoError(t, repo.MarkOutboxEventPublished(context.TODO(), 2))
+
+	event := &entities.OutboxEvent{ID: 2}
+	require.NoError(t, tx.NewSelect().Model(event).WherePK().Scan(context.TODO()))
+	require.NotNil(t, event.PublishedAt)
+
+	// The next event of the same user is now unblocked.
+	events, err := dao.NewClaimOutboxEventsRepository(tx).ClaimOutboxEvents(context.TODO(), time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, int64(3), events[0].ID)
+}
@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+// errMergeUsersDryRun rolls back the transaction of a dry run.
+var errMergeUsersDryRun = errors.New("merge users dry run")
+
+type MergeUsersData struct {
+	SurvivorUID string
+	MergedUIDs  []string
+	// PublicIdentifier is set on the row of the survivor, which is created if needed. When empty, the row of the
+	// survivor is left as is.
+	PublicIdentifier string
+	// DryRun rolls the merge back once done, so the result shows what it would change.
+	DryRun bool
+}
+
+type MergeUsersResult struct {
+	// Survivor is the row of the survivor after the merge, or nil if it has none.
+	Survivor *entities.User
+	// Deleted are the rows of the merged users.
+	Deleted []*entities.User
+	// Aliases are the aliases created for the merged users, and the former aliases of the merged users, that now
+	// point to the survivor.
+	Aliases []*entities.UserAlias
+}
+
+type MergeUsersRepository interface {
+	// MergeUsers makes each merged UID an alias of the survivor, and deletes their rows. A merge event is emitted
+	// for each merged UID, in place of a deletion event. The survivor must not be an alias, nor any of the merged
+	// UIDs.
+	MergeUsers(ctx context.Context, tenantID string, data *MergeUsersData) (*MergeUsersResult, error)
+}
+
+type mergeUsersRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *mergeUsersRepositoryImpl) mergeUsers(
+	ctx context.Context, tx bun.Tx, tenantID string, data *MergeUsersData,
+) (*MergeUsersResult, error) {
+	result := &MergeUsersResult{
+		Deleted: make([]*entities.User, 0),
+		Aliases: make([]*entities.UserAlias, 0),
+	}
+
+	for _, mergedUID := range data.MergedUIDs {
+		alias := &entities.UserAlias{
+			TenantID:     tenantID,
+			AliasUID:     mergedUID,
+			CanonicalUID: data.SurvivorUID,
+		}
+
+		repointed, err := insertUserAlias(ctx, tx, alias)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Aliases = append(append(result.Aliases, alias), repointed...)
+	}
+
+	_, err := tx.NewDelete().
+		Model(&result.Deleted).
+		Where("tenant_id = ?", tenantID).
+		Where("firebase_uid IN (?)", bun.In(data.MergedUIDs)).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	survivor := &entities.User{
+		PublicIdentifier: data.PublicIdentifier,
+		TenantID:         tenantID,
+		FirebaseUID:      data.SurvivorUID,
+	}
+
+	if data.PublicIdentifier != "" {
+		_, err = tx.NewInsert().
+			Model(survivor).
+			On("CONFLICT (tenant_id, firebase_uid) DO UPDATE").
+			Set("public_identifier = EXCLUDED.public_identifier").
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Survivor = survivor
+	} else {
+		err = tx.NewSelect().
+			Model(survivor).
+			Where("tenant_id = ?", tenantID).
+			Where("firebase_uid = ?", data.SurvivorUID).
+			Scan(ctx)
+		if err == nil {
+			result.Survivor = survivor
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	for _, mergedUID := range data.MergedUIDs {
+		if err := insertUserMergedEvent(ctx, tx, survivor, mergedUID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *mergeUsersRepositoryImpl) MergeUsers(
+	ctx context.Context, tenantID string, data *MergeUsersData,
+) (*MergeUsersResult, error) {
+	var result *MergeUsersResult
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		if result, err = r.mergeUsers(ctx, tx, tenantID, data); err != nil {
+			return err
+		}
+
+		if data.DryRun {
+			return errMergeUsersDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errMergeUsersDryRun) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func NewMergeUsersRepository(db bun.IDB) MergeUsersRepository {
+	return &mergeUsersRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,279 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMergeUsers(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name          string
+		data          *dao.MergeUsersData
+		expect        *dao.MergeUsersResult
+		expectAliases []*entities.UserAlias
+		expectEvents  []*entities.OutboxEvent
+		expectErr     error
+	}{
+		{
+			name: "MergeUsers",
+			data: &dao.MergeUsersData{
+				SurvivorUID: "firebase-uid-1",
+				MergedUIDs:  []string{"firebase-uid-2"},
+			},
+			expect: &dao.MergeUsersResult{
+				Survivor: &entities.User{
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "firebase-uid-1",
+				},
+				Deleted: []*entities.User{
+					{
+						ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						PublicIdentifier: "public-identifier-2",
+						FirebaseUID:      "firebase-uid-2",
+					},
+				},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+				},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-1",
+					Type:        entities.OutboxEventUserMerged,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-1",
+						PublicIdentifier: "public-identifier-1",
+						MergedUID:        "firebase-uid-2",
+					},
+				},
+			},
+		},
+		{
+			name: "MovePublicIdentifier",
+			data: &dao.MergeUsersData{
+				SurvivorUID:      "firebase-uid-1",
+				MergedUIDs:       []string{"firebase-uid-2"},
+				PublicIdentifier: "public-identifier-2",
+			},
+			expect: &dao.MergeUsersResult{
+				Survivor: &entities.User{
+					PublicIdentifier: "public-identifier-2",
+					FirebaseUID:      "firebase-uid-1",
+				},
+				Deleted: []*entities.User{
+					{
+						ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						PublicIdentifier: "public-identifier-2",
+						FirebaseUID:      "firebase-uid-2",
+					},
+				},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+				},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-1",
+					Type:        entities.OutboxEventUserMerged,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-1",
+						PublicIdentifier: "public-identifier-2",
+						MergedUID:        "firebase-uid-2",
+					},
+				},
+			},
+		},
+		{
+			name: "CreateSurvivorRow",
+			data: &dao.MergeUsersData{
+				SurvivorUID:      "firebase-uid-6",
+				MergedUIDs:       []string{"firebase-uid-1", "firebase-uid-2"},
+				PublicIdentifier: "public-identifier-2",
+			},
+			expect: &dao.MergeUsersResult{
+				Survivor: &entities.User{
+					PublicIdentifier: "public-identifier-2",
+					FirebaseUID:      "firebase-uid-6",
+				},
+				Deleted: []*entities.User{
+					{
+						ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						PublicIdentifier: "public-identifier-1",
+						FirebaseUID:      "firebase-uid-1",
+					},
+					{
+						ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						PublicIdentifier: "public-identifier-2",
+						FirebaseUID:      "firebase-uid-2",
+					},
+				},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "firebase-uid-1", CanonicalUID: "firebase-uid-6"},
+					{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-6"},
+				},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-1", CanonicalUID: "firebase-uid-6"},
+				{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-6"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-6",
+					Type:        entities.OutboxEventUserMerged,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-6",
+						PublicIdentifier: "public-identifier-2",
+						MergedUID:        "firebase-uid-1",
+					},
+				},
+				{
+					AggregateID: "firebase-uid-6",
+					Type:        entities.OutboxEventUserMerged,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-6",
+						PublicIdentifier: "public-identifier-2",
+						MergedUID:        "firebase-uid-2",
+					},
+				},
+			},
+		},
+		{
+			name: "RepointAliases",
+			data: &dao.MergeUsersData{
+				SurvivorUID: "firebase-uid-1",
+				MergedUIDs:  []string{"firebase-uid-3"},
+			},
+			expect: &dao.MergeUsersResult{
+				Survivor: &entities.User{
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "firebase-uid-1",
+				},
+				Deleted: []*entities.User{
+					{
+						ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+						PublicIdentifier: "public-identifier-3",
+						FirebaseUID:      "firebase-uid-3",
+					},
+				},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "firebase-uid-3", CanonicalUID: "firebase-uid-1"},
+					{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-1"},
+				},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-3", CanonicalUID: "firebase-uid-1"},
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-1"},
+			},
+			expectEvents: []*entities.OutboxEvent{
+				{
+					AggregateID: "firebase-uid-1",
+					Type:        entities.OutboxEventUserMerged,
+					Payload: &entities.UserEventPayload{
+						FirebaseUID:      "firebase-uid-1",
+						PublicIdentifier: "public-identifier-1",
+						MergedUID:        "firebase-uid-3",
+					},
+				},
+			},
+		},
+		{
+			// The result shows what would change, but nothing is written.
+			name: "DryRun",
+			data: &dao.MergeUsersData{
+				SurvivorUID:      "firebase-uid-1",
+				MergedUIDs:       []string{"firebase-uid-2"},
+				PublicIdentifier: "public-identifier-2",
+				DryRun:           true,
+			},
+			expect: &dao.MergeUsersResult{
+				Survivor: &entities.User{
+					PublicIdentifier: "public-identifier-2",
+					FirebaseUID:      "firebase-uid-1",
+				},
+				Deleted: []*entities.User{
+					{
+						ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						PublicIdentifier: "public-identifier-2",
+						FirebaseUID:      "firebase-uid-2",
+					},
+				},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "firebase-uid-2", CanonicalUID: "firebase-uid-1"},
+				},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{},
+		},
+		{
+			name: "AliasAlreadyExists",
+			data: &dao.MergeUsersData{
+				SurvivorUID: "firebase-uid-1",
+				MergedUIDs:  []string{"firebase-uid-2", "firebase-uid-4"},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{},
+			expectErr:    dao.ErrUserAliasAlreadyExists,
+		},
+		{
+			name: "SurvivorIsAlias",
+			data: &dao.MergeUsersData{
+				SurvivorUID: "firebase-uid-4",
+				MergedUIDs:  []string{"firebase-uid-1"},
+			},
+			expectAliases: []*entities.UserAlias{
+				{AliasUID: "firebase-uid-4", CanonicalUID: "firebase-uid-3"},
+			},
+			expectEvents: []*entities.OutboxEvent{},
+			expectErr:    dao.ErrUserAliasAlreadyExists,
+		},
+	}
+
+	stx := BeginTX(db, userAliasesFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewMergeUsersRepository(tx)
+			result, err := repo.MergeUsers(context.TODO(), "", data.data)
+
+			if result != nil {
+				// Since IDs and creation dates are random, nullify them for comparison.
+				if result.Survivor != nil {
+					result.Survivor.ID = nil
+				}
+				for _, alias := range result.Aliases {
+					alias.CreatedAt = nil
+				}
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, result)
+			require.Equal(t, data.expectAliases, listUserAliases(tx, ""))
+			require.Equal(t, data.expectEvents, listOutboxEvents(tx))
+		})
+	}
+}
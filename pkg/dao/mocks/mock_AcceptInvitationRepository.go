@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAcceptInvitationRepository is an autogenerated mock type for the AcceptInvitationRepository type
+type MockAcceptInvitationRepository struct {
+	mock.Mock
+}
+
+type MockAcceptInvitationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAcceptInvitationRepository) EXPECT() *MockAcceptInvitationRepository_Expecter {
+	return &MockAcceptInvitationRepository_Expecter{mock: &_m.Mock}
+}
+
+// AcceptInvitation provides a mock function with given fields: ctx, id, firebaseUID
+func (_m *MockAcceptInvitationRepository) AcceptInvitation(ctx context.Context, id uuid.UUID, firebaseUID string) (*entities.Membership, error) {
+	ret := _m.Called(ctx, id, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvitation")
+	}
+
+	var r0 *entities.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*entities.Membership, error)); ok {
+		return rf(ctx, id, firebaseUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *entities.Membership); ok {
+		r0 = rf(ctx, id, firebaseUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, id, firebaseUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAcceptInvitationRepository_AcceptInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvitation'
+type MockAcceptInvitationRepository_AcceptInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - firebaseUID string
+func (_e *MockAcceptInvitationRepository_Expecter) AcceptInvitation(ctx interface{}, id interface{}, firebaseUID interface{}) *MockAcceptInvitationRepository_AcceptInvitation_Call {
+	return &MockAcceptInvitationRepository_AcceptInvitation_Call{Call: _e.mock.On("AcceptInvitation", ctx, id, firebaseUID)}
+}
+
+func (_c *MockAcceptInvitationRepository_AcceptInvitation_Call) Run(run func(ctx context.Context, id uuid.UUID, firebaseUID string)) *MockAcceptInvitationRepository_AcceptInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAcceptInvitationRepository_AcceptInvitation_Call) Return(_a0 *entities.Membership, _a1 error) *MockAcceptInvitationRepository_AcceptInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAcceptInvitationRepository_AcceptInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*entities.Membership, error)) *MockAcceptInvitationRepository_AcceptInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAcceptInvitationRepository creates a new instance of MockAcceptInvitationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAcceptInvitationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAcceptInvitationRepository {
+	mock := &MockAcceptInvitationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
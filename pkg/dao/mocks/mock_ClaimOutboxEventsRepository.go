@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockClaimOutboxEventsRepository is an autogenerated mock type for the ClaimOutboxEventsRepository type
+type MockClaimOutboxEventsRepository struct {
+	mock.Mock
+}
+
+type MockClaimOutboxEventsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimOutboxEventsRepository) EXPECT() *MockClaimOutboxEventsRepository_Expecter {
+	return &MockClaimOutboxEventsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimOutboxEvents provides a mock function with given fields: ctx, leaseUntil, limit
+func (_m *MockClaimOutboxEventsRepository) ClaimOutboxEvents(ctx context.Context, leaseUntil time.Time, limit int) ([]*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutboxEvents")
+	}
+
+	var r0 []*entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entities.OutboxEvent, error)); ok {
+		return rf(ctx, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entities.OutboxEvent); ok {
+		r0 = rf(ctx, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimOutboxEvents'
+type MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ClaimOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockClaimOutboxEventsRepository_Expecter) ClaimOutboxEvents(ctx interface{}, leaseUntil interface{}, limit interface{}) *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call {
+	return &MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call{Call: _e.mock.On("ClaimOutboxEvents", ctx, leaseUntil, limit)}
+}
+
+func (_c *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call) Run(run func(ctx context.Context, leaseUntil time.Time, limit int)) *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call) Return(_a0 []*entities.OutboxEvent, _a1 error) *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entities.OutboxEvent, error)) *MockClaimOutboxEventsRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimOutboxEventsRepository creates a new instance of MockClaimOutboxEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimOutboxEventsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimOutboxEventsRepository {
+	mock := &MockClaimOutboxEventsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockClaimWebhookDeliveriesRepository is an autogenerated mock type for the ClaimWebhookDeliveriesRepository type
+type MockClaimWebhookDeliveriesRepository struct {
+	mock.Mock
+}
+
+type MockClaimWebhookDeliveriesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimWebhookDeliveriesRepository) EXPECT() *MockClaimWebhookDeliveriesRepository_Expecter {
+	return &MockClaimWebhookDeliveriesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimWebhookDeliveries provides a mock function with given fields: ctx, leaseUntil, limit
+func (_m *MockClaimWebhookDeliveriesRepository) ClaimWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int) ([]*entities.WebhookDelivery, error) {
+	ret := _m.Called(ctx, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimWebhookDeliveries")
+	}
+
+	var r0 []*entities.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entities.WebhookDelivery, error)); ok {
+		return rf(ctx, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entities.WebhookDelivery); ok {
+		r0 = rf(ctx, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimWebhookDeliveries'
+type MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockClaimWebhookDeliveriesRepository_Expecter) ClaimWebhookDeliveries(ctx interface{}, leaseUntil interface{}, limit interface{}) *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call {
+	return &MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call{Call: _e.mock.On("ClaimWebhookDeliveries", ctx, leaseUntil, limit)}
+}
+
+func (_c *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call) Run(run func(ctx context.Context, leaseUntil time.Time, limit int)) *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call) Return(_a0 []*entities.WebhookDelivery, _a1 error) *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entities.WebhookDelivery, error)) *MockClaimWebhookDeliveriesRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimWebhookDeliveriesRepository creates a new instance of MockClaimWebhookDeliveriesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimWebhookDeliveriesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimWebhookDeliveriesRepository {
+	mock := &MockClaimWebhookDeliveriesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCountActiveUsersRepository is an autogenerated mock type for the CountActiveUsersRepository type
+type MockCountActiveUsersRepository struct {
+	mock.Mock
+}
+
+type MockCountActiveUsersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountActiveUsersRepository) EXPECT() *MockCountActiveUsersRepository_Expecter {
+	return &MockCountActiveUsersRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountActiveUsers provides a mock function with given fields: ctx, tenantID, since
+func (_m *MockCountActiveUsersRepository) CountActiveUsers(ctx context.Context, tenantID string, since time.Time) (int, error) {
+	ret := _m.Called(ctx, tenantID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountActiveUsers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (int, error)); ok {
+		return rf(ctx, tenantID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int); ok {
+		r0 = rf(ctx, tenantID, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, tenantID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCountActiveUsersRepository_CountActiveUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountActiveUsers'
+type MockCountActiveUsersRepository_CountActiveUsers_Call struct {
+	*mock.Call
+}
+
+// CountActiveUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - since time.Time
+func (_e *MockCountActiveUsersRepository_Expecter) CountActiveUsers(ctx interface{}, tenantID interface{}, since interface{}) *MockCountActiveUsersRepository_CountActiveUsers_Call {
+	return &MockCountActiveUsersRepository_CountActiveUsers_Call{Call: _e.mock.On("CountActiveUsers", ctx, tenantID, since)}
+}
+
+func (_c *MockCountActiveUsersRepository_CountActiveUsers_Call) Run(run func(ctx context.Context, tenantID string, since time.Time)) *MockCountActiveUsersRepository_CountActiveUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCountActiveUsersRepository_CountActiveUsers_Call) Return(_a0 int, _a1 error) *MockCountActiveUsersRepository_CountActiveUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCountActiveUsersRepository_CountActiveUsers_Call) RunAndReturn(run func(context.Context, string, time.Time) (int, error)) *MockCountActiveUsersRepository_CountActiveUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountActiveUsersRepository creates a new instance of MockCountActiveUsersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountActiveUsersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountActiveUsersRepository {
+	mock := &MockCountActiveUsersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
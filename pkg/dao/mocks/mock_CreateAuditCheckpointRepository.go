@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateAuditCheckpointRepository is an autogenerated mock type for the CreateAuditCheckpointRepository type
+type MockCreateAuditCheckpointRepository struct {
+	mock.Mock
+}
+
+type MockCreateAuditCheckpointRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateAuditCheckpointRepository) EXPECT() *MockCreateAuditCheckpointRepository_Expecter {
+	return &MockCreateAuditCheckpointRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAuditCheckpoint provides a mock function with given fields: ctx, data
+func (_m *MockCreateAuditCheckpointRepository) CreateAuditCheckpoint(ctx context.Context, data *dao.CreateAuditCheckpointData) (*entities.AuditCheckpoint, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditCheckpoint")
+	}
+
+	var r0 *entities.AuditCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateAuditCheckpointData) (*entities.AuditCheckpoint, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateAuditCheckpointData) *entities.AuditCheckpoint); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateAuditCheckpointData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditCheckpoint'
+type MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call struct {
+	*mock.Call
+}
+
+// CreateAuditCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateAuditCheckpointData
+func (_e *MockCreateAuditCheckpointRepository_Expecter) CreateAuditCheckpoint(ctx interface{}, data interface{}) *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call {
+	return &MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call{Call: _e.mock.On("CreateAuditCheckpoint", ctx, data)}
+}
+
+func (_c *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call) Run(run func(ctx context.Context, data *dao.CreateAuditCheckpointData)) *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateAuditCheckpointData))
+	})
+	return _c
+}
+
+func (_c *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call) Return(_a0 *entities.AuditCheckpoint, _a1 error) *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call) RunAndReturn(run func(context.Context, *dao.CreateAuditCheckpointData) (*entities.AuditCheckpoint, error)) *MockCreateAuditCheckpointRepository_CreateAuditCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateAuditCheckpointRepository creates a new instance of MockCreateAuditCheckpointRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateAuditCheckpointRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateAuditCheckpointRepository {
+	mock := &MockCreateAuditCheckpointRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateAuditEventRepository is an autogenerated mock type for the CreateAuditEventRepository type
+type MockCreateAuditEventRepository struct {
+	mock.Mock
+}
+
+type MockCreateAuditEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateAuditEventRepository) EXPECT() *MockCreateAuditEventRepository_Expecter {
+	return &MockCreateAuditEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAuditEvent provides a mock function with given fields: ctx, data
+func (_m *MockCreateAuditEventRepository) CreateAuditEvent(ctx context.Context, data *dao.CreateAuditEventData) (*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 *entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateAuditEventData) (*entities.AuditEvent, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateAuditEventData) *entities.AuditEvent); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateAuditEventData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateAuditEventRepository_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type MockCreateAuditEventRepository_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateAuditEventData
+func (_e *MockCreateAuditEventRepository_Expecter) CreateAuditEvent(ctx interface{}, data interface{}) *MockCreateAuditEventRepository_CreateAuditEvent_Call {
+	return &MockCreateAuditEventRepository_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, data)}
+}
+
+func (_c *MockCreateAuditEventRepository_CreateAuditEvent_Call) Run(run func(ctx context.Context, data *dao.CreateAuditEventData)) *MockCreateAuditEventRepository_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateAuditEventData))
+	})
+	return _c
+}
+
+func (_c *MockCreateAuditEventRepository_CreateAuditEvent_Call) Return(_a0 *entities.AuditEvent, _a1 error) *MockCreateAuditEventRepository_CreateAuditEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateAuditEventRepository_CreateAuditEvent_Call) RunAndReturn(run func(context.Context, *dao.CreateAuditEventData) (*entities.AuditEvent, error)) *MockCreateAuditEventRepository_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateAuditEventRepository creates a new instance of MockCreateAuditEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateAuditEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateAuditEventRepository {
+	mock := &MockCreateAuditEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
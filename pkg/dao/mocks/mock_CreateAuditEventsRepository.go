@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateAuditEventsRepository is an autogenerated mock type for the CreateAuditEventsRepository type
+type MockCreateAuditEventsRepository struct {
+	mock.Mock
+}
+
+type MockCreateAuditEventsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateAuditEventsRepository) EXPECT() *MockCreateAuditEventsRepository_Expecter {
+	return &MockCreateAuditEventsRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAuditEvents provides a mock function with given fields: ctx, data
+func (_m *MockCreateAuditEventsRepository) CreateAuditEvents(ctx context.Context, data []*dao.CreateAuditEventData) ([]*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvents")
+	}
+
+	var r0 []*entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*dao.CreateAuditEventData) ([]*entities.AuditEvent, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*dao.CreateAuditEventData) []*entities.AuditEvent); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*dao.CreateAuditEventData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateAuditEventsRepository_CreateAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvents'
+type MockCreateAuditEventsRepository_CreateAuditEvents_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []*dao.CreateAuditEventData
+func (_e *MockCreateAuditEventsRepository_Expecter) CreateAuditEvents(ctx interface{}, data interface{}) *MockCreateAuditEventsRepository_CreateAuditEvents_Call {
+	return &MockCreateAuditEventsRepository_CreateAuditEvents_Call{Call: _e.mock.On("CreateAuditEvents", ctx, data)}
+}
+
+func (_c *MockCreateAuditEventsRepository_CreateAuditEvents_Call) Run(run func(ctx context.Context, data []*dao.CreateAuditEventData)) *MockCreateAuditEventsRepository_CreateAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*dao.CreateAuditEventData))
+	})
+	return _c
+}
+
+func (_c *MockCreateAuditEventsRepository_CreateAuditEvents_Call) Return(_a0 []*entities.AuditEvent, _a1 error) *MockCreateAuditEventsRepository_CreateAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateAuditEventsRepository_CreateAuditEvents_Call) RunAndReturn(run func(context.Context, []*dao.CreateAuditEventData) ([]*entities.AuditEvent, error)) *MockCreateAuditEventsRepository_CreateAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateAuditEventsRepository creates a new instance of MockCreateAuditEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateAuditEventsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateAuditEventsRepository {
+	mock := &MockCreateAuditEventsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
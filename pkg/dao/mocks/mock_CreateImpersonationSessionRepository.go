@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateImpersonationSessionRepository is an autogenerated mock type for the CreateImpersonationSessionRepository type
+type MockCreateImpersonationSessionRepository struct {
+	mock.Mock
+}
+
+type MockCreateImpersonationSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateImpersonationSessionRepository) EXPECT() *MockCreateImpersonationSessionRepository_Expecter {
+	return &MockCreateImpersonationSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateImpersonationSession provides a mock function with given fields: ctx, data
+func (_m *MockCreateImpersonationSessionRepository) CreateImpersonationSession(ctx context.Context, data *dao.CreateImpersonationSessionData) (*entities.ImpersonationSession, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImpersonationSession")
+	}
+
+	var r0 *entities.ImpersonationSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateImpersonationSessionData) (*entities.ImpersonationSession, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateImpersonationSessionData) *entities.ImpersonationSession); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ImpersonationSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateImpersonationSessionData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImpersonationSession'
+type MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call struct {
+	*mock.Call
+}
+
+// CreateImpersonationSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateImpersonationSessionData
+func (_e *MockCreateImpersonationSessionRepository_Expecter) CreateImpersonationSession(ctx interface{}, data interface{}) *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call {
+	return &MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call{Call: _e.mock.On("CreateImpersonationSession", ctx, data)}
+}
+
+func (_c *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call) Run(run func(ctx context.Context, data *dao.CreateImpersonationSessionData)) *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateImpersonationSessionData))
+	})
+	return _c
+}
+
+func (_c *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call) Return(_a0 *entities.ImpersonationSession, _a1 error) *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call) RunAndReturn(run func(context.Context, *dao.CreateImpersonationSessionData) (*entities.ImpersonationSession, error)) *MockCreateImpersonationSessionRepository_CreateImpersonationSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateImpersonationSessionRepository creates a new instance of MockCreateImpersonationSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateImpersonationSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateImpersonationSessionRepository {
+	mock := &MockCreateImpersonationSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
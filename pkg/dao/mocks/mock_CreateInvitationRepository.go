@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockCreateInvitationRepository is an autogenerated mock type for the CreateInvitationRepository type
+type MockCreateInvitationRepository struct {
+	mock.Mock
+}
+
+type MockCreateInvitationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateInvitationRepository) EXPECT() *MockCreateInvitationRepository_Expecter {
+	return &MockCreateInvitationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInvitation provides a mock function with given fields: ctx, organizationID, data
+func (_m *MockCreateInvitationRepository) CreateInvitation(ctx context.Context, organizationID uuid.UUID, data *dao.CreateInvitationData) (*entities.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvitation")
+	}
+
+	var r0 *entities.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.CreateInvitationData) (*entities.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.CreateInvitationData) *entities.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dao.CreateInvitationData) error); ok {
+		r1 = rf(ctx, organizationID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateInvitationRepository_CreateInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvitation'
+type MockCreateInvitationRepository_CreateInvitation_Call struct {
+	*mock.Call
+}
+
+// CreateInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - data *dao.CreateInvitationData
+func (_e *MockCreateInvitationRepository_Expecter) CreateInvitation(ctx interface{}, organizationID interface{}, data interface{}) *MockCreateInvitationRepository_CreateInvitation_Call {
+	return &MockCreateInvitationRepository_CreateInvitation_Call{Call: _e.mock.On("CreateInvitation", ctx, organizationID, data)}
+}
+
+func (_c *MockCreateInvitationRepository_CreateInvitation_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, data *dao.CreateInvitationData)) *MockCreateInvitationRepository_CreateInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dao.CreateInvitationData))
+	})
+	return _c
+}
+
+func (_c *MockCreateInvitationRepository_CreateInvitation_Call) Return(_a0 *entities.OrganizationInvitation, _a1 error) *MockCreateInvitationRepository_CreateInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateInvitationRepository_CreateInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dao.CreateInvitationData) (*entities.OrganizationInvitation, error)) *MockCreateInvitationRepository_CreateInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateInvitationRepository creates a new instance of MockCreateInvitationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateInvitationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateInvitationRepository {
+	mock := &MockCreateInvitationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockCreateMembershipRepository is an autogenerated mock type for the CreateMembershipRepository type
+type MockCreateMembershipRepository struct {
+	mock.Mock
+}
+
+type MockCreateMembershipRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateMembershipRepository) EXPECT() *MockCreateMembershipRepository_Expecter {
+	return &MockCreateMembershipRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMembership provides a mock function with given fields: ctx, organizationID, firebaseUID, data
+func (_m *MockCreateMembershipRepository) CreateMembership(ctx context.Context, organizationID uuid.UUID, firebaseUID string, data *dao.CreateMembershipData) (*entities.Membership, error) {
+	ret := _m.Called(ctx, organizationID, firebaseUID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMembership")
+	}
+
+	var r0 *entities.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.CreateMembershipData) (*entities.Membership, error)); ok {
+		return rf(ctx, organizationID, firebaseUID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.CreateMembershipData) *entities.Membership); ok {
+		r0 = rf(ctx, organizationID, firebaseUID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *dao.CreateMembershipData) error); ok {
+		r1 = rf(ctx, organizationID, firebaseUID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateMembershipRepository_CreateMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMembership'
+type MockCreateMembershipRepository_CreateMembership_Call struct {
+	*mock.Call
+}
+
+// CreateMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - firebaseUID string
+//   - data *dao.CreateMembershipData
+func (_e *MockCreateMembershipRepository_Expecter) CreateMembership(ctx interface{}, organizationID interface{}, firebaseUID interface{}, data interface{}) *MockCreateMembershipRepository_CreateMembership_Call {
+	return &MockCreateMembershipRepository_CreateMembership_Call{Call: _e.mock.On("CreateMembership", ctx, organizationID, firebaseUID, data)}
+}
+
+func (_c *MockCreateMembershipRepository_CreateMembership_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, firebaseUID string, data *dao.CreateMembershipData)) *MockCreateMembershipRepository_CreateMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*dao.CreateMembershipData))
+	})
+	return _c
+}
+
+func (_c *MockCreateMembershipRepository_CreateMembership_Call) Return(_a0 *entities.Membership, _a1 error) *MockCreateMembershipRepository_CreateMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateMembershipRepository_CreateMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *dao.CreateMembershipData) (*entities.Membership, error)) *MockCreateMembershipRepository_CreateMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateMembershipRepository creates a new instance of MockCreateMembershipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateMembershipRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateMembershipRepository {
+	mock := &MockCreateMembershipRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
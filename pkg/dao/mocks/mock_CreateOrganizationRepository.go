@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateOrganizationRepository is an autogenerated mock type for the CreateOrganizationRepository type
+type MockCreateOrganizationRepository struct {
+	mock.Mock
+}
+
+type MockCreateOrganizationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateOrganizationRepository) EXPECT() *MockCreateOrganizationRepository_Expecter {
+	return &MockCreateOrganizationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, ownerUID, data
+func (_m *MockCreateOrganizationRepository) CreateOrganization(ctx context.Context, ownerUID string, data *dao.CreateOrganizationData) (*entities.Organization, error) {
+	ret := _m.Called(ctx, ownerUID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *entities.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateOrganizationData) (*entities.Organization, error)); ok {
+		return rf(ctx, ownerUID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateOrganizationData) *entities.Organization); ok {
+		r0 = rf(ctx, ownerUID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.CreateOrganizationData) error); ok {
+		r1 = rf(ctx, ownerUID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateOrganizationRepository_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockCreateOrganizationRepository_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerUID string
+//   - data *dao.CreateOrganizationData
+func (_e *MockCreateOrganizationRepository_Expecter) CreateOrganization(ctx interface{}, ownerUID interface{}, data interface{}) *MockCreateOrganizationRepository_CreateOrganization_Call {
+	return &MockCreateOrganizationRepository_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, ownerUID, data)}
+}
+
+func (_c *MockCreateOrganizationRepository_CreateOrganization_Call) Run(run func(ctx context.Context, ownerUID string, data *dao.CreateOrganizationData)) *MockCreateOrganizationRepository_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dao.CreateOrganizationData))
+	})
+	return _c
+}
+
+func (_c *MockCreateOrganizationRepository_CreateOrganization_Call) Return(_a0 *entities.Organization, _a1 error) *MockCreateOrganizationRepository_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateOrganizationRepository_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, *dao.CreateOrganizationData) (*entities.Organization, error)) *MockCreateOrganizationRepository_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateOrganizationRepository creates a new instance of MockCreateOrganizationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateOrganizationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateOrganizationRepository {
+	mock := &MockCreateOrganizationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreatePersonalAccessTokenRepository is an autogenerated mock type for the CreatePersonalAccessTokenRepository type
+type MockCreatePersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+type MockCreatePersonalAccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreatePersonalAccessTokenRepository) EXPECT() *MockCreatePersonalAccessTokenRepository_Expecter {
+	return &MockCreatePersonalAccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreatePersonalAccessToken provides a mock function with given fields: ctx, firebaseUID, data
+func (_m *MockCreatePersonalAccessTokenRepository) CreatePersonalAccessToken(ctx context.Context, firebaseUID string, data *dao.CreatePersonalAccessTokenData) (*entities.PersonalAccessToken, error) {
+	ret := _m.Called(ctx, firebaseUID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePersonalAccessToken")
+	}
+
+	var r0 *entities.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreatePersonalAccessTokenData) (*entities.PersonalAccessToken, error)); ok {
+		return rf(ctx, firebaseUID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreatePersonalAccessTokenData) *entities.PersonalAccessToken); ok {
+		r0 = rf(ctx, firebaseUID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.CreatePersonalAccessTokenData) error); ok {
+		r1 = rf(ctx, firebaseUID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePersonalAccessToken'
+type MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call struct {
+	*mock.Call
+}
+
+// CreatePersonalAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firebaseUID string
+//   - data *dao.CreatePersonalAccessTokenData
+func (_e *MockCreatePersonalAccessTokenRepository_Expecter) CreatePersonalAccessToken(ctx interface{}, firebaseUID interface{}, data interface{}) *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call {
+	return &MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call{Call: _e.mock.On("CreatePersonalAccessToken", ctx, firebaseUID, data)}
+}
+
+func (_c *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call) Run(run func(ctx context.Context, firebaseUID string, data *dao.CreatePersonalAccessTokenData)) *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dao.CreatePersonalAccessTokenData))
+	})
+	return _c
+}
+
+func (_c *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call) Return(_a0 *entities.PersonalAccessToken, _a1 error) *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call) RunAndReturn(run func(context.Context, string, *dao.CreatePersonalAccessTokenData) (*entities.PersonalAccessToken, error)) *MockCreatePersonalAccessTokenRepository_CreatePersonalAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreatePersonalAccessTokenRepository creates a new instance of MockCreatePersonalAccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreatePersonalAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreatePersonalAccessTokenRepository {
+	mock := &MockCreatePersonalAccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
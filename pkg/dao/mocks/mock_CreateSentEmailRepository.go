@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateSentEmailRepository is an autogenerated mock type for the CreateSentEmailRepository type
+type MockCreateSentEmailRepository struct {
+	mock.Mock
+}
+
+type MockCreateSentEmailRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateSentEmailRepository) EXPECT() *MockCreateSentEmailRepository_Expecter {
+	return &MockCreateSentEmailRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSentEmail provides a mock function with given fields: ctx, data
+func (_m *MockCreateSentEmailRepository) CreateSentEmail(ctx context.Context, data *dao.CreateSentEmailData) (*entities.SentEmail, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSentEmail")
+	}
+
+	var r0 *entities.SentEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateSentEmailData) (*entities.SentEmail, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateSentEmailData) *entities.SentEmail); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SentEmail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateSentEmailData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateSentEmailRepository_CreateSentEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSentEmail'
+type MockCreateSentEmailRepository_CreateSentEmail_Call struct {
+	*mock.Call
+}
+
+// CreateSentEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateSentEmailData
+func (_e *MockCreateSentEmailRepository_Expecter) CreateSentEmail(ctx interface{}, data interface{}) *MockCreateSentEmailRepository_CreateSentEmail_Call {
+	return &MockCreateSentEmailRepository_CreateSentEmail_Call{Call: _e.mock.On("CreateSentEmail", ctx, data)}
+}
+
+func (_c *MockCreateSentEmailRepository_CreateSentEmail_Call) Run(run func(ctx context.Context, data *dao.CreateSentEmailData)) *MockCreateSentEmailRepository_CreateSentEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateSentEmailData))
+	})
+	return _c
+}
+
+func (_c *MockCreateSentEmailRepository_CreateSentEmail_Call) Return(_a0 *entities.SentEmail, _a1 error) *MockCreateSentEmailRepository_CreateSentEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateSentEmailRepository_CreateSentEmail_Call) RunAndReturn(run func(context.Context, *dao.CreateSentEmailData) (*entities.SentEmail, error)) *MockCreateSentEmailRepository_CreateSentEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateSentEmailRepository creates a new instance of MockCreateSentEmailRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateSentEmailRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateSentEmailRepository {
+	mock := &MockCreateSentEmailRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
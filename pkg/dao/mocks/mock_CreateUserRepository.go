@@ -24,9 +24,9 @@ func (_m *MockCreateUserRepository) EXPECT() *MockCreateUserRepository_Expecter
 	return &MockCreateUserRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateUser provides a mock function with given fields: ctx, firebaseUID, data
-func (_m *MockCreateUserRepository) CreateUser(ctx context.Context, firebaseUID string, data *dao.CreateUserData) (*entities.User, error) {
-	ret := _m.Called(ctx, firebaseUID, data)
+// CreateUser provides a mock function with given fields: ctx, tenantID, firebaseUID, data
+func (_m *MockCreateUserRepository) CreateUser(ctx context.Context, tenantID string, firebaseUID string, data *dao.CreateUserData) (*entities.User, error) {
+	ret := _m.Called(ctx, tenantID, firebaseUID, data)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateUser")
@@ -34,19 +34,19 @@ func (_m *MockCreateUserRepository) CreateUser(ctx context.Context, firebaseUID
 
 	var r0 *entities.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateUserData) (*entities.User, error)); ok {
-		return rf(ctx, firebaseUID, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *dao.CreateUserData) (*entities.User, error)); ok {
+		return rf(ctx, tenantID, firebaseUID, data)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateUserData) *entities.User); ok {
-		r0 = rf(ctx, firebaseUID, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *dao.CreateUserData) *entities.User); ok {
+		r0 = rf(ctx, tenantID, firebaseUID, data)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.CreateUserData) error); ok {
-		r1 = rf(ctx, firebaseUID, data)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *dao.CreateUserData) error); ok {
+		r1 = rf(ctx, tenantID, firebaseUID, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,15 +61,16 @@ type MockCreateUserRepository_CreateUser_Call struct {
 
 // CreateUser is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tenantID string
 //   - firebaseUID string
 //   - data *dao.CreateUserData
-func (_e *MockCreateUserRepository_Expecter) CreateUser(ctx interface{}, firebaseUID interface{}, data interface{}) *MockCreateUserRepository_CreateUser_Call {
-	return &MockCreateUserRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, firebaseUID, data)}
+func (_e *MockCreateUserRepository_Expecter) CreateUser(ctx interface{}, tenantID interface{}, firebaseUID interface{}, data interface{}) *MockCreateUserRepository_CreateUser_Call {
+	return &MockCreateUserRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, tenantID, firebaseUID, data)}
 }
 
-func (_c *MockCreateUserRepository_CreateUser_Call) Run(run func(ctx context.Context, firebaseUID string, data *dao.CreateUserData)) *MockCreateUserRepository_CreateUser_Call {
+func (_c *MockCreateUserRepository_CreateUser_Call) Run(run func(ctx context.Context, tenantID string, firebaseUID string, data *dao.CreateUserData)) *MockCreateUserRepository_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*dao.CreateUserData))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*dao.CreateUserData))
 	})
 	return _c
 }
@@ -79,7 +80,7 @@ func (_c *MockCreateUserRepository_CreateUser_Call) Return(_a0 *entities.User, _
 	return _c
 }
 
-func (_c *MockCreateUserRepository_CreateUser_Call) RunAndReturn(run func(context.Context, string, *dao.CreateUserData) (*entities.User, error)) *MockCreateUserRepository_CreateUser_Call {
+func (_c *MockCreateUserRepository_CreateUser_Call) RunAndReturn(run func(context.Context, string, string, *dao.CreateUserData) (*entities.User, error)) *MockCreateUserRepository_CreateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateWebhookDeliveriesRepository is an autogenerated mock type for the CreateWebhookDeliveriesRepository type
+type MockCreateWebhookDeliveriesRepository struct {
+	mock.Mock
+}
+
+type MockCreateWebhookDeliveriesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateWebhookDeliveriesRepository) EXPECT() *MockCreateWebhookDeliveriesRepository_Expecter {
+	return &MockCreateWebhookDeliveriesRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhookDeliveries provides a mock function with given fields: ctx, data
+func (_m *MockCreateWebhookDeliveriesRepository) CreateWebhookDeliveries(ctx context.Context, data *dao.CreateWebhookDeliveriesData) (int, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDeliveries")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateWebhookDeliveriesData) (int, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateWebhookDeliveriesData) int); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateWebhookDeliveriesData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDeliveries'
+type MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateWebhookDeliveriesData
+func (_e *MockCreateWebhookDeliveriesRepository_Expecter) CreateWebhookDeliveries(ctx interface{}, data interface{}) *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call {
+	return &MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call{Call: _e.mock.On("CreateWebhookDeliveries", ctx, data)}
+}
+
+func (_c *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call) Run(run func(ctx context.Context, data *dao.CreateWebhookDeliveriesData)) *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateWebhookDeliveriesData))
+	})
+	return _c
+}
+
+func (_c *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call) Return(_a0 int, _a1 error) *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call) RunAndReturn(run func(context.Context, *dao.CreateWebhookDeliveriesData) (int, error)) *MockCreateWebhookDeliveriesRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateWebhookDeliveriesRepository creates a new instance of MockCreateWebhookDeliveriesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateWebhookDeliveriesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateWebhookDeliveriesRepository {
+	mock := &MockCreateWebhookDeliveriesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
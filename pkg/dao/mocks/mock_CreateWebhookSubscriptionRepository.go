@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateWebhookSubscriptionRepository is an autogenerated mock type for the CreateWebhookSubscriptionRepository type
+type MockCreateWebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockCreateWebhookSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateWebhookSubscriptionRepository) EXPECT() *MockCreateWebhookSubscriptionRepository_Expecter {
+	return &MockCreateWebhookSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhookSubscription provides a mock function with given fields: ctx, data
+func (_m *MockCreateWebhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, data *dao.CreateWebhookSubscriptionData) (*entities.WebhookSubscription, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookSubscription")
+	}
+
+	var r0 *entities.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateWebhookSubscriptionData) (*entities.WebhookSubscription, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateWebhookSubscriptionData) *entities.WebhookSubscription); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateWebhookSubscriptionData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookSubscription'
+type MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateWebhookSubscriptionData
+func (_e *MockCreateWebhookSubscriptionRepository_Expecter) CreateWebhookSubscription(ctx interface{}, data interface{}) *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	return &MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call{Call: _e.mock.On("CreateWebhookSubscription", ctx, data)}
+}
+
+func (_c *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call) Run(run func(ctx context.Context, data *dao.CreateWebhookSubscriptionData)) *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateWebhookSubscriptionData))
+	})
+	return _c
+}
+
+func (_c *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call) Return(_a0 *entities.WebhookSubscription, _a1 error) *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call) RunAndReturn(run func(context.Context, *dao.CreateWebhookSubscriptionData) (*entities.WebhookSubscription, error)) *MockCreateWebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateWebhookSubscriptionRepository creates a new instance of MockCreateWebhookSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateWebhookSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateWebhookSubscriptionRepository {
+	mock := &MockCreateWebhookSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
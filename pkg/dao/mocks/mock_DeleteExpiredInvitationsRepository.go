@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockDeleteExpiredInvitationsRepository is an autogenerated mock type for the DeleteExpiredInvitationsRepository type
+type MockDeleteExpiredInvitationsRepository struct {
+	mock.Mock
+}
+
+type MockDeleteExpiredInvitationsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteExpiredInvitationsRepository) EXPECT() *MockDeleteExpiredInvitationsRepository_Expecter {
+	return &MockDeleteExpiredInvitationsRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpiredInvitations provides a mock function with given fields: ctx, before
+func (_m *MockDeleteExpiredInvitationsRepository) DeleteExpiredInvitations(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredInvitations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredInvitations'
+type MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockDeleteExpiredInvitationsRepository_Expecter) DeleteExpiredInvitations(ctx interface{}, before interface{}) *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call {
+	return &MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call{Call: _e.mock.On("DeleteExpiredInvitations", ctx, before)}
+}
+
+func (_c *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call) Run(run func(ctx context.Context, before time.Time)) *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call) Return(_a0 int, _a1 error) *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *MockDeleteExpiredInvitationsRepository_DeleteExpiredInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteExpiredInvitationsRepository creates a new instance of MockDeleteExpiredInvitationsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteExpiredInvitationsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteExpiredInvitationsRepository {
+	mock := &MockDeleteExpiredInvitationsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
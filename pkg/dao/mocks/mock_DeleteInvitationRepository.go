@@ -0,0 +1,86 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeleteInvitationRepository is an autogenerated mock type for the DeleteInvitationRepository type
+type MockDeleteInvitationRepository struct {
+	mock.Mock
+}
+
+type MockDeleteInvitationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteInvitationRepository) EXPECT() *MockDeleteInvitationRepository_Expecter {
+	return &MockDeleteInvitationRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteInvitation provides a mock function with given fields: ctx, organizationID, id
+func (_m *MockDeleteInvitationRepository) DeleteInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error {
+	ret := _m.Called(ctx, organizationID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, organizationID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteInvitationRepository_DeleteInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInvitation'
+type MockDeleteInvitationRepository_DeleteInvitation_Call struct {
+	*mock.Call
+}
+
+// DeleteInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockDeleteInvitationRepository_Expecter) DeleteInvitation(ctx interface{}, organizationID interface{}, id interface{}) *MockDeleteInvitationRepository_DeleteInvitation_Call {
+	return &MockDeleteInvitationRepository_DeleteInvitation_Call{Call: _e.mock.On("DeleteInvitation", ctx, organizationID, id)}
+}
+
+func (_c *MockDeleteInvitationRepository_DeleteInvitation_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, id uuid.UUID)) *MockDeleteInvitationRepository_DeleteInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDeleteInvitationRepository_DeleteInvitation_Call) Return(_a0 error) *MockDeleteInvitationRepository_DeleteInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteInvitationRepository_DeleteInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDeleteInvitationRepository_DeleteInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteInvitationRepository creates a new instance of MockDeleteInvitationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteInvitationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteInvitationRepository {
+	mock := &MockDeleteInvitationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
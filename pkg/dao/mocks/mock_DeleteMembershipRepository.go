@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeleteMembershipRepository is an autogenerated mock type for the DeleteMembershipRepository type
+type MockDeleteMembershipRepository struct {
+	mock.Mock
+}
+
+type MockDeleteMembershipRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteMembershipRepository) EXPECT() *MockDeleteMembershipRepository_Expecter {
+	return &MockDeleteMembershipRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteMembership provides a mock function with given fields: ctx, organizationID, firebaseUID, audit
+func (_m *MockDeleteMembershipRepository) DeleteMembership(ctx context.Context, organizationID uuid.UUID, firebaseUID string, audit *dao.CreateAuditEventData) (*entities.Membership, error) {
+	ret := _m.Called(ctx, organizationID, firebaseUID, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMembership")
+	}
+
+	var r0 *entities.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.CreateAuditEventData) (*entities.Membership, error)); ok {
+		return rf(ctx, organizationID, firebaseUID, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.CreateAuditEventData) *entities.Membership); ok {
+		r0 = rf(ctx, organizationID, firebaseUID, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *dao.CreateAuditEventData) error); ok {
+		r1 = rf(ctx, organizationID, firebaseUID, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteMembershipRepository_DeleteMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMembership'
+type MockDeleteMembershipRepository_DeleteMembership_Call struct {
+	*mock.Call
+}
+
+// DeleteMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - firebaseUID string
+//   - audit *dao.CreateAuditEventData
+func (_e *MockDeleteMembershipRepository_Expecter) DeleteMembership(ctx interface{}, organizationID interface{}, firebaseUID interface{}, audit interface{}) *MockDeleteMembershipRepository_DeleteMembership_Call {
+	return &MockDeleteMembershipRepository_DeleteMembership_Call{Call: _e.mock.On("DeleteMembership", ctx, organizationID, firebaseUID, audit)}
+}
+
+func (_c *MockDeleteMembershipRepository_DeleteMembership_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, firebaseUID string, audit *dao.CreateAuditEventData)) *MockDeleteMembershipRepository_DeleteMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*dao.CreateAuditEventData))
+	})
+	return _c
+}
+
+func (_c *MockDeleteMembershipRepository_DeleteMembership_Call) Return(_a0 *entities.Membership, _a1 error) *MockDeleteMembershipRepository_DeleteMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteMembershipRepository_DeleteMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *dao.CreateAuditEventData) (*entities.Membership, error)) *MockDeleteMembershipRepository_DeleteMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteMembershipRepository creates a new instance of MockDeleteMembershipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteMembershipRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteMembershipRepository {
+	mock := &MockDeleteMembershipRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockDeleteOutboxEventsRepository is an autogenerated mock type for the DeleteOutboxEventsRepository type
+type MockDeleteOutboxEventsRepository struct {
+	mock.Mock
+}
+
+type MockDeleteOutboxEventsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteOutboxEventsRepository) EXPECT() *MockDeleteOutboxEventsRepository_Expecter {
+	return &MockDeleteOutboxEventsRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteOutboxEvents provides a mock function with given fields: ctx, before
+func (_m *MockDeleteOutboxEventsRepository) DeleteOutboxEvents(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOutboxEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOutboxEvents'
+type MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// DeleteOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockDeleteOutboxEventsRepository_Expecter) DeleteOutboxEvents(ctx interface{}, before interface{}) *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call {
+	return &MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call{Call: _e.mock.On("DeleteOutboxEvents", ctx, before)}
+}
+
+func (_c *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call) Run(run func(ctx context.Context, before time.Time)) *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call) Return(_a0 int, _a1 error) *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *MockDeleteOutboxEventsRepository_DeleteOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteOutboxEventsRepository creates a new instance of MockDeleteOutboxEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteOutboxEventsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteOutboxEventsRepository {
+	mock := &MockDeleteOutboxEventsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,86 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeletePersonalAccessTokenRepository is an autogenerated mock type for the DeletePersonalAccessTokenRepository type
+type MockDeletePersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+type MockDeletePersonalAccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeletePersonalAccessTokenRepository) EXPECT() *MockDeletePersonalAccessTokenRepository_Expecter {
+	return &MockDeletePersonalAccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeletePersonalAccessToken provides a mock function with given fields: ctx, firebaseUID, id
+func (_m *MockDeletePersonalAccessTokenRepository) DeletePersonalAccessToken(ctx context.Context, firebaseUID string, id uuid.UUID) error {
+	ret := _m.Called(ctx, firebaseUID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePersonalAccessToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, firebaseUID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePersonalAccessToken'
+type MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call struct {
+	*mock.Call
+}
+
+// DeletePersonalAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firebaseUID string
+//   - id uuid.UUID
+func (_e *MockDeletePersonalAccessTokenRepository_Expecter) DeletePersonalAccessToken(ctx interface{}, firebaseUID interface{}, id interface{}) *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call {
+	return &MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call{Call: _e.mock.On("DeletePersonalAccessToken", ctx, firebaseUID, id)}
+}
+
+func (_c *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call) Run(run func(ctx context.Context, firebaseUID string, id uuid.UUID)) *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call) Return(_a0 error) *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *MockDeletePersonalAccessTokenRepository_DeletePersonalAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeletePersonalAccessTokenRepository creates a new instance of MockDeletePersonalAccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeletePersonalAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeletePersonalAccessTokenRepository {
+	mock := &MockDeletePersonalAccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
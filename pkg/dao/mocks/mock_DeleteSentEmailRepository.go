@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeleteSentEmailRepository is an autogenerated mock type for the DeleteSentEmailRepository type
+type MockDeleteSentEmailRepository struct {
+	mock.Mock
+}
+
+type MockDeleteSentEmailRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteSentEmailRepository) EXPECT() *MockDeleteSentEmailRepository_Expecter {
+	return &MockDeleteSentEmailRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteSentEmail provides a mock function with given fields: ctx, id
+func (_m *MockDeleteSentEmailRepository) DeleteSentEmail(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSentEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteSentEmailRepository_DeleteSentEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSentEmail'
+type MockDeleteSentEmailRepository_DeleteSentEmail_Call struct {
+	*mock.Call
+}
+
+// DeleteSentEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockDeleteSentEmailRepository_Expecter) DeleteSentEmail(ctx interface{}, id interface{}) *MockDeleteSentEmailRepository_DeleteSentEmail_Call {
+	return &MockDeleteSentEmailRepository_DeleteSentEmail_Call{Call: _e.mock.On("DeleteSentEmail", ctx, id)}
+}
+
+func (_c *MockDeleteSentEmailRepository_DeleteSentEmail_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockDeleteSentEmailRepository_DeleteSentEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDeleteSentEmailRepository_DeleteSentEmail_Call) Return(_a0 error) *MockDeleteSentEmailRepository_DeleteSentEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteSentEmailRepository_DeleteSentEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockDeleteSentEmailRepository_DeleteSentEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteSentEmailRepository creates a new instance of MockDeleteSentEmailRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteSentEmailRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteSentEmailRepository {
+	mock := &MockDeleteSentEmailRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockDeleteStaleSignInContextsRepository is an autogenerated mock type for the DeleteStaleSignInContextsRepository type
+type MockDeleteStaleSignInContextsRepository struct {
+	mock.Mock
+}
+
+type MockDeleteStaleSignInContextsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteStaleSignInContextsRepository) EXPECT() *MockDeleteStaleSignInContextsRepository_Expecter {
+	return &MockDeleteStaleSignInContextsRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteStaleSignInContexts provides a mock function with given fields: ctx, before
+func (_m *MockDeleteStaleSignInContextsRepository) DeleteStaleSignInContexts(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStaleSignInContexts")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStaleSignInContexts'
+type MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call struct {
+	*mock.Call
+}
+
+// DeleteStaleSignInContexts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockDeleteStaleSignInContextsRepository_Expecter) DeleteStaleSignInContexts(ctx interface{}, before interface{}) *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call {
+	return &MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call{Call: _e.mock.On("DeleteStaleSignInContexts", ctx, before)}
+}
+
+func (_c *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call) Run(run func(ctx context.Context, before time.Time)) *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call) Return(_a0 int, _a1 error) *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *MockDeleteStaleSignInContextsRepository_DeleteStaleSignInContexts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteStaleSignInContextsRepository creates a new instance of MockDeleteStaleSignInContextsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteStaleSignInContextsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteStaleSignInContextsRepository {
+	mock := &MockDeleteStaleSignInContextsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteUserRepository is an autogenerated mock type for the DeleteUserRepository type
+type MockDeleteUserRepository struct {
+	mock.Mock
+}
+
+type MockDeleteUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteUserRepository) EXPECT() *MockDeleteUserRepository_Expecter {
+	return &MockDeleteUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteUser provides a mock function with given fields: ctx, tenantID, firebaseUID
+func (_m *MockDeleteUserRepository) DeleteUser(ctx context.Context, tenantID string, firebaseUID string) error {
+	ret := _m.Called(ctx, tenantID, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenantID, firebaseUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteUserRepository_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockDeleteUserRepository_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - firebaseUID string
+func (_e *MockDeleteUserRepository_Expecter) DeleteUser(ctx interface{}, tenantID interface{}, firebaseUID interface{}) *MockDeleteUserRepository_DeleteUser_Call {
+	return &MockDeleteUserRepository_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, tenantID, firebaseUID)}
+}
+
+func (_c *MockDeleteUserRepository_DeleteUser_Call) Run(run func(ctx context.Context, tenantID string, firebaseUID string)) *MockDeleteUserRepository_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeleteUserRepository_DeleteUser_Call) Return(_a0 error) *MockDeleteUserRepository_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteUserRepository_DeleteUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDeleteUserRepository_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteUserRepository creates a new instance of MockDeleteUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteUserRepository {
+	mock := &MockDeleteUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeleteWebhookSubscriptionRepository is an autogenerated mock type for the DeleteWebhookSubscriptionRepository type
+type MockDeleteWebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockDeleteWebhookSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteWebhookSubscriptionRepository) EXPECT() *MockDeleteWebhookSubscriptionRepository_Expecter {
+	return &MockDeleteWebhookSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteWebhookSubscription provides a mock function with given fields: ctx, id
+func (_m *MockDeleteWebhookSubscriptionRepository) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookSubscription'
+type MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockDeleteWebhookSubscriptionRepository_Expecter) DeleteWebhookSubscription(ctx interface{}, id interface{}) *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call {
+	return &MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call{Call: _e.mock.On("DeleteWebhookSubscription", ctx, id)}
+}
+
+func (_c *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call) Return(_a0 error) *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockDeleteWebhookSubscriptionRepository_DeleteWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteWebhookSubscriptionRepository creates a new instance of MockDeleteWebhookSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteWebhookSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteWebhookSubscriptionRepository {
+	mock := &MockDeleteWebhookSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
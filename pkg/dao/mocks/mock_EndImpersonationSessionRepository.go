@@ -0,0 +1,103 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockEndImpersonationSessionRepository is an autogenerated mock type for the EndImpersonationSessionRepository type
+type MockEndImpersonationSessionRepository struct {
+	mock.Mock
+}
+
+type MockEndImpersonationSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEndImpersonationSessionRepository) EXPECT() *MockEndImpersonationSessionRepository_Expecter {
+	return &MockEndImpersonationSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// EndImpersonationSession provides a mock function with given fields: ctx, impersonatorUID, id, endedAt
+func (_m *MockEndImpersonationSessionRepository) EndImpersonationSession(ctx context.Context, impersonatorUID string, id uuid.UUID, endedAt time.Time) (*entities.ImpersonationSession, error) {
+	ret := _m.Called(ctx, impersonatorUID, id, endedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndImpersonationSession")
+	}
+
+	var r0 *entities.ImpersonationSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (*entities.ImpersonationSession, error)); ok {
+		return rf(ctx, impersonatorUID, id, endedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) *entities.ImpersonationSession); ok {
+		r0 = rf(ctx, impersonatorUID, id, endedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ImpersonationSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, impersonatorUID, id, endedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEndImpersonationSessionRepository_EndImpersonationSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndImpersonationSession'
+type MockEndImpersonationSessionRepository_EndImpersonationSession_Call struct {
+	*mock.Call
+}
+
+// EndImpersonationSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - impersonatorUID string
+//   - id uuid.UUID
+//   - endedAt time.Time
+func (_e *MockEndImpersonationSessionRepository_Expecter) EndImpersonationSession(ctx interface{}, impersonatorUID interface{}, id interface{}, endedAt interface{}) *MockEndImpersonationSessionRepository_EndImpersonationSession_Call {
+	return &MockEndImpersonationSessionRepository_EndImpersonationSession_Call{Call: _e.mock.On("EndImpersonationSession", ctx, impersonatorUID, id, endedAt)}
+}
+
+func (_c *MockEndImpersonationSessionRepository_EndImpersonationSession_Call) Run(run func(ctx context.Context, impersonatorUID string, id uuid.UUID, endedAt time.Time)) *MockEndImpersonationSessionRepository_EndImpersonationSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEndImpersonationSessionRepository_EndImpersonationSession_Call) Return(_a0 *entities.ImpersonationSession, _a1 error) *MockEndImpersonationSessionRepository_EndImpersonationSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEndImpersonationSessionRepository_EndImpersonationSession_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) (*entities.ImpersonationSession, error)) *MockEndImpersonationSessionRepository_EndImpersonationSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEndImpersonationSessionRepository creates a new instance of MockEndImpersonationSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEndImpersonationSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEndImpersonationSessionRepository {
+	mock := &MockEndImpersonationSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockGetImpersonationSessionRepository is an autogenerated mock type for the GetImpersonationSessionRepository type
+type MockGetImpersonationSessionRepository struct {
+	mock.Mock
+}
+
+type MockGetImpersonationSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetImpersonationSessionRepository) EXPECT() *MockGetImpersonationSessionRepository_Expecter {
+	return &MockGetImpersonationSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetImpersonationSession provides a mock function with given fields: ctx, id
+func (_m *MockGetImpersonationSessionRepository) GetImpersonationSession(ctx context.Context, id uuid.UUID) (*entities.ImpersonationSession, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImpersonationSession")
+	}
+
+	var r0 *entities.ImpersonationSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entities.ImpersonationSession, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entities.ImpersonationSession); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ImpersonationSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetImpersonationSessionRepository_GetImpersonationSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImpersonationSession'
+type MockGetImpersonationSessionRepository_GetImpersonationSession_Call struct {
+	*mock.Call
+}
+
+// GetImpersonationSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockGetImpersonationSessionRepository_Expecter) GetImpersonationSession(ctx interface{}, id interface{}) *MockGetImpersonationSessionRepository_GetImpersonationSession_Call {
+	return &MockGetImpersonationSessionRepository_GetImpersonationSession_Call{Call: _e.mock.On("GetImpersonationSession", ctx, id)}
+}
+
+func (_c *MockGetImpersonationSessionRepository_GetImpersonationSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockGetImpersonationSessionRepository_GetImpersonationSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockGetImpersonationSessionRepository_GetImpersonationSession_Call) Return(_a0 *entities.ImpersonationSession, _a1 error) *MockGetImpersonationSessionRepository_GetImpersonationSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetImpersonationSessionRepository_GetImpersonationSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entities.ImpersonationSession, error)) *MockGetImpersonationSessionRepository_GetImpersonationSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetImpersonationSessionRepository creates a new instance of MockGetImpersonationSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetImpersonationSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetImpersonationSessionRepository {
+	mock := &MockGetImpersonationSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetInvitationByCodeRepository is an autogenerated mock type for the GetInvitationByCodeRepository type
+type MockGetInvitationByCodeRepository struct {
+	mock.Mock
+}
+
+type MockGetInvitationByCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetInvitationByCodeRepository) EXPECT() *MockGetInvitationByCodeRepository_Expecter {
+	return &MockGetInvitationByCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetInvitationByCode provides a mock function with given fields: ctx, codeHash
+func (_m *MockGetInvitationByCodeRepository) GetInvitationByCode(ctx context.Context, codeHash string) (*entities.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitationByCode")
+	}
+
+	var r0 *entities.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.OrganizationInvitation, error)); ok {
+		return rf(ctx, codeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.OrganizationInvitation); ok {
+		r0 = rf(ctx, codeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetInvitationByCodeRepository_GetInvitationByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitationByCode'
+type MockGetInvitationByCodeRepository_GetInvitationByCode_Call struct {
+	*mock.Call
+}
+
+// GetInvitationByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - codeHash string
+func (_e *MockGetInvitationByCodeRepository_Expecter) GetInvitationByCode(ctx interface{}, codeHash interface{}) *MockGetInvitationByCodeRepository_GetInvitationByCode_Call {
+	return &MockGetInvitationByCodeRepository_GetInvitationByCode_Call{Call: _e.mock.On("GetInvitationByCode", ctx, codeHash)}
+}
+
+func (_c *MockGetInvitationByCodeRepository_GetInvitationByCode_Call) Run(run func(ctx context.Context, codeHash string)) *MockGetInvitationByCodeRepository_GetInvitationByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetInvitationByCodeRepository_GetInvitationByCode_Call) Return(_a0 *entities.OrganizationInvitation, _a1 error) *MockGetInvitationByCodeRepository_GetInvitationByCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetInvitationByCodeRepository_GetInvitationByCode_Call) RunAndReturn(run func(context.Context, string) (*entities.OrganizationInvitation, error)) *MockGetInvitationByCodeRepository_GetInvitationByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetInvitationByCodeRepository creates a new instance of MockGetInvitationByCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetInvitationByCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetInvitationByCodeRepository {
+	mock := &MockGetInvitationByCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
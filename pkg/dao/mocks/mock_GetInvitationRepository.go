@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockGetInvitationRepository is an autogenerated mock type for the GetInvitationRepository type
+type MockGetInvitationRepository struct {
+	mock.Mock
+}
+
+type MockGetInvitationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetInvitationRepository) EXPECT() *MockGetInvitationRepository_Expecter {
+	return &MockGetInvitationRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetInvitation provides a mock function with given fields: ctx, organizationID, id
+func (_m *MockGetInvitationRepository) GetInvitation(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*entities.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitation")
+	}
+
+	var r0 *entities.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entities.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entities.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetInvitationRepository_GetInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitation'
+type MockGetInvitationRepository_GetInvitation_Call struct {
+	*mock.Call
+}
+
+// GetInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockGetInvitationRepository_Expecter) GetInvitation(ctx interface{}, organizationID interface{}, id interface{}) *MockGetInvitationRepository_GetInvitation_Call {
+	return &MockGetInvitationRepository_GetInvitation_Call{Call: _e.mock.On("GetInvitation", ctx, organizationID, id)}
+}
+
+func (_c *MockGetInvitationRepository_GetInvitation_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, id uuid.UUID)) *MockGetInvitationRepository_GetInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockGetInvitationRepository_GetInvitation_Call) Return(_a0 *entities.OrganizationInvitation, _a1 error) *MockGetInvitationRepository_GetInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetInvitationRepository_GetInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entities.OrganizationInvitation, error)) *MockGetInvitationRepository_GetInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetInvitationRepository creates a new instance of MockGetInvitationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetInvitationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetInvitationRepository {
+	mock := &MockGetInvitationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLastAuditCheckpointRepository is an autogenerated mock type for the GetLastAuditCheckpointRepository type
+type MockGetLastAuditCheckpointRepository struct {
+	mock.Mock
+}
+
+type MockGetLastAuditCheckpointRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLastAuditCheckpointRepository) EXPECT() *MockGetLastAuditCheckpointRepository_Expecter {
+	return &MockGetLastAuditCheckpointRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLastAuditCheckpoint provides a mock function with given fields: ctx
+func (_m *MockGetLastAuditCheckpointRepository) GetLastAuditCheckpoint(ctx context.Context) (*entities.AuditCheckpoint, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastAuditCheckpoint")
+	}
+
+	var r0 *entities.AuditCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entities.AuditCheckpoint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entities.AuditCheckpoint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastAuditCheckpoint'
+type MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call struct {
+	*mock.Call
+}
+
+// GetLastAuditCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetLastAuditCheckpointRepository_Expecter) GetLastAuditCheckpoint(ctx interface{}) *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call {
+	return &MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call{Call: _e.mock.On("GetLastAuditCheckpoint", ctx)}
+}
+
+func (_c *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call) Run(run func(ctx context.Context)) *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call) Return(_a0 *entities.AuditCheckpoint, _a1 error) *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call) RunAndReturn(run func(context.Context) (*entities.AuditCheckpoint, error)) *MockGetLastAuditCheckpointRepository_GetLastAuditCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLastAuditCheckpointRepository creates a new instance of MockGetLastAuditCheckpointRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLastAuditCheckpointRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLastAuditCheckpointRepository {
+	mock := &MockGetLastAuditCheckpointRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLastAuditEventRepository is an autogenerated mock type for the GetLastAuditEventRepository type
+type MockGetLastAuditEventRepository struct {
+	mock.Mock
+}
+
+type MockGetLastAuditEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLastAuditEventRepository) EXPECT() *MockGetLastAuditEventRepository_Expecter {
+	return &MockGetLastAuditEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLastAuditEvent provides a mock function with given fields: ctx
+func (_m *MockGetLastAuditEventRepository) GetLastAuditEvent(ctx context.Context) (*entities.AuditEvent, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastAuditEvent")
+	}
+
+	var r0 *entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entities.AuditEvent, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entities.AuditEvent); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLastAuditEventRepository_GetLastAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastAuditEvent'
+type MockGetLastAuditEventRepository_GetLastAuditEvent_Call struct {
+	*mock.Call
+}
+
+// GetLastAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetLastAuditEventRepository_Expecter) GetLastAuditEvent(ctx interface{}) *MockGetLastAuditEventRepository_GetLastAuditEvent_Call {
+	return &MockGetLastAuditEventRepository_GetLastAuditEvent_Call{Call: _e.mock.On("GetLastAuditEvent", ctx)}
+}
+
+func (_c *MockGetLastAuditEventRepository_GetLastAuditEvent_Call) Run(run func(ctx context.Context)) *MockGetLastAuditEventRepository_GetLastAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetLastAuditEventRepository_GetLastAuditEvent_Call) Return(_a0 *entities.AuditEvent, _a1 error) *MockGetLastAuditEventRepository_GetLastAuditEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLastAuditEventRepository_GetLastAuditEvent_Call) RunAndReturn(run func(context.Context) (*entities.AuditEvent, error)) *MockGetLastAuditEventRepository_GetLastAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLastAuditEventRepository creates a new instance of MockGetLastAuditEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLastAuditEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLastAuditEventRepository {
+	mock := &MockGetLastAuditEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
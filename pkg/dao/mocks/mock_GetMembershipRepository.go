@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockGetMembershipRepository is an autogenerated mock type for the GetMembershipRepository type
+type MockGetMembershipRepository struct {
+	mock.Mock
+}
+
+type MockGetMembershipRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMembershipRepository) EXPECT() *MockGetMembershipRepository_Expecter {
+	return &MockGetMembershipRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMembership provides a mock function with given fields: ctx, organizationID, firebaseUID
+func (_m *MockGetMembershipRepository) GetMembership(ctx context.Context, organizationID uuid.UUID, firebaseUID string) (*entities.Membership, error) {
+	ret := _m.Called(ctx, organizationID, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembership")
+	}
+
+	var r0 *entities.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*entities.Membership, error)); ok {
+		return rf(ctx, organizationID, firebaseUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *entities.Membership); ok {
+		r0 = rf(ctx, organizationID, firebaseUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationID, firebaseUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMembershipRepository_GetMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembership'
+type MockGetMembershipRepository_GetMembership_Call struct {
+	*mock.Call
+}
+
+// GetMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - firebaseUID string
+func (_e *MockGetMembershipRepository_Expecter) GetMembership(ctx interface{}, organizationID interface{}, firebaseUID interface{}) *MockGetMembershipRepository_GetMembership_Call {
+	return &MockGetMembershipRepository_GetMembership_Call{Call: _e.mock.On("GetMembership", ctx, organizationID, firebaseUID)}
+}
+
+func (_c *MockGetMembershipRepository_GetMembership_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, firebaseUID string)) *MockGetMembershipRepository_GetMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetMembershipRepository_GetMembership_Call) Return(_a0 *entities.Membership, _a1 error) *MockGetMembershipRepository_GetMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMembershipRepository_GetMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*entities.Membership, error)) *MockGetMembershipRepository_GetMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMembershipRepository creates a new instance of MockGetMembershipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMembershipRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMembershipRepository {
+	mock := &MockGetMembershipRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
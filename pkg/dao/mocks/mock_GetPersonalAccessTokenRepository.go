@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetPersonalAccessTokenRepository is an autogenerated mock type for the GetPersonalAccessTokenRepository type
+type MockGetPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+type MockGetPersonalAccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetPersonalAccessTokenRepository) EXPECT() *MockGetPersonalAccessTokenRepository_Expecter {
+	return &MockGetPersonalAccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPersonalAccessToken provides a mock function with given fields: ctx, tokenHash
+func (_m *MockGetPersonalAccessTokenRepository) GetPersonalAccessToken(ctx context.Context, tokenHash string) (*entities.PersonalAccessToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonalAccessToken")
+	}
+
+	var r0 *entities.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.PersonalAccessToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.PersonalAccessToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccessToken'
+type MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call struct {
+	*mock.Call
+}
+
+// GetPersonalAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockGetPersonalAccessTokenRepository_Expecter) GetPersonalAccessToken(ctx interface{}, tokenHash interface{}) *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call {
+	return &MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call{Call: _e.mock.On("GetPersonalAccessToken", ctx, tokenHash)}
+}
+
+func (_c *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call) Run(run func(ctx context.Context, tokenHash string)) *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call) Return(_a0 *entities.PersonalAccessToken, _a1 error) *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call) RunAndReturn(run func(context.Context, string) (*entities.PersonalAccessToken, error)) *MockGetPersonalAccessTokenRepository_GetPersonalAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetPersonalAccessTokenRepository creates a new instance of MockGetPersonalAccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetPersonalAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetPersonalAccessTokenRepository {
+	mock := &MockGetPersonalAccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetUserActivityRepository is an autogenerated mock type for the GetUserActivityRepository type
+type MockGetUserActivityRepository struct {
+	mock.Mock
+}
+
+type MockGetUserActivityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetUserActivityRepository) EXPECT() *MockGetUserActivityRepository_Expecter {
+	return &MockGetUserActivityRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetUserActivity provides a mock function with given fields: ctx, tenantID, firebaseUID
+func (_m *MockGetUserActivityRepository) GetUserActivity(ctx context.Context, tenantID string, firebaseUID string) (*entities.UserActivity, error) {
+	ret := _m.Called(ctx, tenantID, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserActivity")
+	}
+
+	var r0 *entities.UserActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.UserActivity, error)); ok {
+		return rf(ctx, tenantID, firebaseUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.UserActivity); ok {
+		r0 = rf(ctx, tenantID, firebaseUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, firebaseUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetUserActivityRepository_GetUserActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserActivity'
+type MockGetUserActivityRepository_GetUserActivity_Call struct {
+	*mock.Call
+}
+
+// GetUserActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - firebaseUID string
+func (_e *MockGetUserActivityRepository_Expecter) GetUserActivity(ctx interface{}, tenantID interface{}, firebaseUID interface{}) *MockGetUserActivityRepository_GetUserActivity_Call {
+	return &MockGetUserActivityRepository_GetUserActivity_Call{Call: _e.mock.On("GetUserActivity", ctx, tenantID, firebaseUID)}
+}
+
+func (_c *MockGetUserActivityRepository_GetUserActivity_Call) Run(run func(ctx context.Context, tenantID string, firebaseUID string)) *MockGetUserActivityRepository_GetUserActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetUserActivityRepository_GetUserActivity_Call) Return(_a0 *entities.UserActivity, _a1 error) *MockGetUserActivityRepository_GetUserActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetUserActivityRepository_GetUserActivity_Call) RunAndReturn(run func(context.Context, string, string) (*entities.UserActivity, error)) *MockGetUserActivityRepository_GetUserActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetUserActivityRepository creates a new instance of MockGetUserActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetUserActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetUserActivityRepository {
+	mock := &MockGetUserActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
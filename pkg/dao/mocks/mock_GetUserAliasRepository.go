@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetUserAliasRepository is an autogenerated mock type for the GetUserAliasRepository type
+type MockGetUserAliasRepository struct {
+	mock.Mock
+}
+
+type MockGetUserAliasRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetUserAliasRepository) EXPECT() *MockGetUserAliasRepository_Expecter {
+	return &MockGetUserAliasRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetUserAlias provides a mock function with given fields: ctx, tenantID, aliasUID
+func (_m *MockGetUserAliasRepository) GetUserAlias(ctx context.Context, tenantID string, aliasUID string) (*entities.UserAlias, error) {
+	ret := _m.Called(ctx, tenantID, aliasUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAlias")
+	}
+
+	var r0 *entities.UserAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.UserAlias, error)); ok {
+		return rf(ctx, tenantID, aliasUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.UserAlias); ok {
+		r0 = rf(ctx, tenantID, aliasUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, aliasUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetUserAliasRepository_GetUserAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAlias'
+type MockGetUserAliasRepository_GetUserAlias_Call struct {
+	*mock.Call
+}
+
+// GetUserAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - aliasUID string
+func (_e *MockGetUserAliasRepository_Expecter) GetUserAlias(ctx interface{}, tenantID interface{}, aliasUID interface{}) *MockGetUserAliasRepository_GetUserAlias_Call {
+	return &MockGetUserAliasRepository_GetUserAlias_Call{Call: _e.mock.On("GetUserAlias", ctx, tenantID, aliasUID)}
+}
+
+func (_c *MockGetUserAliasRepository_GetUserAlias_Call) Run(run func(ctx context.Context, tenantID string, aliasUID string)) *MockGetUserAliasRepository_GetUserAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetUserAliasRepository_GetUserAlias_Call) Return(_a0 *entities.UserAlias, _a1 error) *MockGetUserAliasRepository_GetUserAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetUserAliasRepository_GetUserAlias_Call) RunAndReturn(run func(context.Context, string, string) (*entities.UserAlias, error)) *MockGetUserAliasRepository_GetUserAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetUserAliasRepository creates a new instance of MockGetUserAliasRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetUserAliasRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetUserAliasRepository {
+	mock := &MockGetUserAliasRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,9 +23,9 @@ func (_m *MockGetUserRepository) EXPECT() *MockGetUserRepository_Expecter {
 	return &MockGetUserRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetUser provides a mock function with given fields: ctx, uid
-func (_m *MockGetUserRepository) GetUser(ctx context.Context, uid string) (*entities.User, error) {
-	ret := _m.Called(ctx, uid)
+// GetUser provides a mock function with given fields: ctx, tenantID, uid
+func (_m *MockGetUserRepository) GetUser(ctx context.Context, tenantID string, uid string) (*entities.User, error) {
+	ret := _m.Called(ctx, tenantID, uid)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUser")
@@ -33,19 +33,19 @@ func (_m *MockGetUserRepository) GetUser(ctx context.Context, uid string) (*enti
 
 	var r0 *entities.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.User, error)); ok {
-		return rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.User, error)); ok {
+		return rf(ctx, tenantID, uid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.User); ok {
-		r0 = rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.User); ok {
+		r0 = rf(ctx, tenantID, uid)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, uid)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, uid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,14 +60,15 @@ type MockGetUserRepository_GetUser_Call struct {
 
 // GetUser is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tenantID string
 //   - uid string
-func (_e *MockGetUserRepository_Expecter) GetUser(ctx interface{}, uid interface{}) *MockGetUserRepository_GetUser_Call {
-	return &MockGetUserRepository_GetUser_Call{Call: _e.mock.On("GetUser", ctx, uid)}
+func (_e *MockGetUserRepository_Expecter) GetUser(ctx interface{}, tenantID interface{}, uid interface{}) *MockGetUserRepository_GetUser_Call {
+	return &MockGetUserRepository_GetUser_Call{Call: _e.mock.On("GetUser", ctx, tenantID, uid)}
 }
 
-func (_c *MockGetUserRepository_GetUser_Call) Run(run func(ctx context.Context, uid string)) *MockGetUserRepository_GetUser_Call {
+func (_c *MockGetUserRepository_GetUser_Call) Run(run func(ctx context.Context, tenantID string, uid string)) *MockGetUserRepository_GetUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -77,7 +78,7 @@ func (_c *MockGetUserRepository_GetUser_Call) Return(_a0 *entities.User, _a1 err
 	return _c
 }
 
-func (_c *MockGetUserRepository_GetUser_Call) RunAndReturn(run func(context.Context, string) (*entities.User, error)) *MockGetUserRepository_GetUser_Call {
+func (_c *MockGetUserRepository_GetUser_Call) RunAndReturn(run func(context.Context, string, string) (*entities.User, error)) *MockGetUserRepository_GetUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
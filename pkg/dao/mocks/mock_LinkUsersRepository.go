@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLinkUsersRepository is an autogenerated mock type for the LinkUsersRepository type
+type MockLinkUsersRepository struct {
+	mock.Mock
+}
+
+type MockLinkUsersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLinkUsersRepository) EXPECT() *MockLinkUsersRepository_Expecter {
+	return &MockLinkUsersRepository_Expecter{mock: &_m.Mock}
+}
+
+// LinkUsers provides a mock function with given fields: ctx, tenantID, canonicalUID, aliasUID
+func (_m *MockLinkUsersRepository) LinkUsers(ctx context.Context, tenantID string, canonicalUID string, aliasUID string) (*entities.UserAlias, error) {
+	ret := _m.Called(ctx, tenantID, canonicalUID, aliasUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkUsers")
+	}
+
+	var r0 *entities.UserAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*entities.UserAlias, error)); ok {
+		return rf(ctx, tenantID, canonicalUID, aliasUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *entities.UserAlias); ok {
+		r0 = rf(ctx, tenantID, canonicalUID, aliasUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, tenantID, canonicalUID, aliasUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLinkUsersRepository_LinkUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkUsers'
+type MockLinkUsersRepository_LinkUsers_Call struct {
+	*mock.Call
+}
+
+// LinkUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - canonicalUID string
+//   - aliasUID string
+func (_e *MockLinkUsersRepository_Expecter) LinkUsers(ctx interface{}, tenantID interface{}, canonicalUID interface{}, aliasUID interface{}) *MockLinkUsersRepository_LinkUsers_Call {
+	return &MockLinkUsersRepository_LinkUsers_Call{Call: _e.mock.On("LinkUsers", ctx, tenantID, canonicalUID, aliasUID)}
+}
+
+func (_c *MockLinkUsersRepository_LinkUsers_Call) Run(run func(ctx context.Context, tenantID string, canonicalUID string, aliasUID string)) *MockLinkUsersRepository_LinkUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockLinkUsersRepository_LinkUsers_Call) Return(_a0 *entities.UserAlias, _a1 error) *MockLinkUsersRepository_LinkUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLinkUsersRepository_LinkUsers_Call) RunAndReturn(run func(context.Context, string, string, string) (*entities.UserAlias, error)) *MockLinkUsersRepository_LinkUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLinkUsersRepository creates a new instance of MockLinkUsersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLinkUsersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLinkUsersRepository {
+	mock := &MockLinkUsersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListAuditChainRepository is an autogenerated mock type for the ListAuditChainRepository type
+type MockListAuditChainRepository struct {
+	mock.Mock
+}
+
+type MockListAuditChainRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAuditChainRepository) EXPECT() *MockListAuditChainRepository_Expecter {
+	return &MockListAuditChainRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListAuditChain provides a mock function with given fields: ctx, afterID, limit
+func (_m *MockListAuditChainRepository) ListAuditChain(ctx context.Context, afterID int64, limit int) ([]*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditChain")
+	}
+
+	var r0 []*entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]*entities.AuditEvent, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*entities.AuditEvent); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListAuditChainRepository_ListAuditChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditChain'
+type MockListAuditChainRepository_ListAuditChain_Call struct {
+	*mock.Call
+}
+
+// ListAuditChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterID int64
+//   - limit int
+func (_e *MockListAuditChainRepository_Expecter) ListAuditChain(ctx interface{}, afterID interface{}, limit interface{}) *MockListAuditChainRepository_ListAuditChain_Call {
+	return &MockListAuditChainRepository_ListAuditChain_Call{Call: _e.mock.On("ListAuditChain", ctx, afterID, limit)}
+}
+
+func (_c *MockListAuditChainRepository_ListAuditChain_Call) Run(run func(ctx context.Context, afterID int64, limit int)) *MockListAuditChainRepository_ListAuditChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockListAuditChainRepository_ListAuditChain_Call) Return(_a0 []*entities.AuditEvent, _a1 error) *MockListAuditChainRepository_ListAuditChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListAuditChainRepository_ListAuditChain_Call) RunAndReturn(run func(context.Context, int64, int) ([]*entities.AuditEvent, error)) *MockListAuditChainRepository_ListAuditChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAuditChainRepository creates a new instance of MockListAuditChainRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAuditChainRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAuditChainRepository {
+	mock := &MockListAuditChainRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListAuditCheckpointsRepository is an autogenerated mock type for the ListAuditCheckpointsRepository type
+type MockListAuditCheckpointsRepository struct {
+	mock.Mock
+}
+
+type MockListAuditCheckpointsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAuditCheckpointsRepository) EXPECT() *MockListAuditCheckpointsRepository_Expecter {
+	return &MockListAuditCheckpointsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListAuditCheckpoints provides a mock function with given fields: ctx
+func (_m *MockListAuditCheckpointsRepository) ListAuditCheckpoints(ctx context.Context) ([]*entities.AuditCheckpoint, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditCheckpoints")
+	}
+
+	var r0 []*entities.AuditCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.AuditCheckpoint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.AuditCheckpoint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.AuditCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditCheckpoints'
+type MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call struct {
+	*mock.Call
+}
+
+// ListAuditCheckpoints is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockListAuditCheckpointsRepository_Expecter) ListAuditCheckpoints(ctx interface{}) *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call {
+	return &MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call{Call: _e.mock.On("ListAuditCheckpoints", ctx)}
+}
+
+func (_c *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call) Run(run func(ctx context.Context)) *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call) Return(_a0 []*entities.AuditCheckpoint, _a1 error) *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call) RunAndReturn(run func(context.Context) ([]*entities.AuditCheckpoint, error)) *MockListAuditCheckpointsRepository_ListAuditCheckpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAuditCheckpointsRepository creates a new instance of MockListAuditCheckpointsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAuditCheckpointsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAuditCheckpointsRepository {
+	mock := &MockListAuditCheckpointsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListAuditEventsRepository is an autogenerated mock type for the ListAuditEventsRepository type
+type MockListAuditEventsRepository struct {
+	mock.Mock
+}
+
+type MockListAuditEventsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAuditEventsRepository) EXPECT() *MockListAuditEventsRepository_Expecter {
+	return &MockListAuditEventsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, data
+func (_m *MockListAuditEventsRepository) ListAuditEvents(ctx context.Context, data *dao.ListAuditEventsData) ([]*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []*entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ListAuditEventsData) ([]*entities.AuditEvent, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ListAuditEventsData) []*entities.AuditEvent); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ListAuditEventsData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListAuditEventsRepository_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type MockListAuditEventsRepository_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.ListAuditEventsData
+func (_e *MockListAuditEventsRepository_Expecter) ListAuditEvents(ctx interface{}, data interface{}) *MockListAuditEventsRepository_ListAuditEvents_Call {
+	return &MockListAuditEventsRepository_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", ctx, data)}
+}
+
+func (_c *MockListAuditEventsRepository_ListAuditEvents_Call) Run(run func(ctx context.Context, data *dao.ListAuditEventsData)) *MockListAuditEventsRepository_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ListAuditEventsData))
+	})
+	return _c
+}
+
+func (_c *MockListAuditEventsRepository_ListAuditEvents_Call) Return(_a0 []*entities.AuditEvent, _a1 error) *MockListAuditEventsRepository_ListAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListAuditEventsRepository_ListAuditEvents_Call) RunAndReturn(run func(context.Context, *dao.ListAuditEventsData) ([]*entities.AuditEvent, error)) *MockListAuditEventsRepository_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAuditEventsRepository creates a new instance of MockListAuditEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAuditEventsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAuditEventsRepository {
+	mock := &MockListAuditEventsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListCanonicalUserAliasesRepository is an autogenerated mock type for the ListCanonicalUserAliasesRepository type
+type MockListCanonicalUserAliasesRepository struct {
+	mock.Mock
+}
+
+type MockListCanonicalUserAliasesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListCanonicalUserAliasesRepository) EXPECT() *MockListCanonicalUserAliasesRepository_Expecter {
+	return &MockListCanonicalUserAliasesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListCanonicalUserAliases provides a mock function with given fields: ctx, tenantID, canonicalUIDs
+func (_m *MockListCanonicalUserAliasesRepository) ListCanonicalUserAliases(ctx context.Context, tenantID string, canonicalUIDs []string) ([]*entities.UserAlias, error) {
+	ret := _m.Called(ctx, tenantID, canonicalUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCanonicalUserAliases")
+	}
+
+	var r0 []*entities.UserAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*entities.UserAlias, error)); ok {
+		return rf(ctx, tenantID, canonicalUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*entities.UserAlias); ok {
+		r0 = rf(ctx, tenantID, canonicalUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, canonicalUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCanonicalUserAliases'
+type MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call struct {
+	*mock.Call
+}
+
+// ListCanonicalUserAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - canonicalUIDs []string
+func (_e *MockListCanonicalUserAliasesRepository_Expecter) ListCanonicalUserAliases(ctx interface{}, tenantID interface{}, canonicalUIDs interface{}) *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call {
+	return &MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call{Call: _e.mock.On("ListCanonicalUserAliases", ctx, tenantID, canonicalUIDs)}
+}
+
+func (_c *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call) Run(run func(ctx context.Context, tenantID string, canonicalUIDs []string)) *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call) Return(_a0 []*entities.UserAlias, _a1 error) *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call) RunAndReturn(run func(context.Context, string, []string) ([]*entities.UserAlias, error)) *MockListCanonicalUserAliasesRepository_ListCanonicalUserAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListCanonicalUserAliasesRepository creates a new instance of MockListCanonicalUserAliasesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListCanonicalUserAliasesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListCanonicalUserAliasesRepository {
+	mock := &MockListCanonicalUserAliasesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListEmailDomainRulesRepository is an autogenerated mock type for the ListEmailDomainRulesRepository type
+type MockListEmailDomainRulesRepository struct {
+	mock.Mock
+}
+
+type MockListEmailDomainRulesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListEmailDomainRulesRepository) EXPECT() *MockListEmailDomainRulesRepository_Expecter {
+	return &MockListEmailDomainRulesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListEmailDomainRules provides a mock function with given fields: ctx
+func (_m *MockListEmailDomainRulesRepository) ListEmailDomainRules(ctx context.Context) ([]*entities.EmailDomainRule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailDomainRules")
+	}
+
+	var r0 []*entities.EmailDomainRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.EmailDomainRule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.EmailDomainRule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.EmailDomainRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListEmailDomainRulesRepository_ListEmailDomainRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmailDomainRules'
+type MockListEmailDomainRulesRepository_ListEmailDomainRules_Call struct {
+	*mock.Call
+}
+
+// ListEmailDomainRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockListEmailDomainRulesRepository_Expecter) ListEmailDomainRules(ctx interface{}) *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call {
+	return &MockListEmailDomainRulesRepository_ListEmailDomainRules_Call{Call: _e.mock.On("ListEmailDomainRules", ctx)}
+}
+
+func (_c *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call) Run(run func(ctx context.Context)) *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call) Return(_a0 []*entities.EmailDomainRule, _a1 error) *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call) RunAndReturn(run func(context.Context) ([]*entities.EmailDomainRule, error)) *MockListEmailDomainRulesRepository_ListEmailDomainRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListEmailDomainRulesRepository creates a new instance of MockListEmailDomainRulesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListEmailDomainRulesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListEmailDomainRulesRepository {
+	mock := &MockListEmailDomainRulesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
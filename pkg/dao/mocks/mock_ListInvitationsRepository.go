@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockListInvitationsRepository is an autogenerated mock type for the ListInvitationsRepository type
+type MockListInvitationsRepository struct {
+	mock.Mock
+}
+
+type MockListInvitationsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListInvitationsRepository) EXPECT() *MockListInvitationsRepository_Expecter {
+	return &MockListInvitationsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListInvitations provides a mock function with given fields: ctx, organizationID
+func (_m *MockListInvitationsRepository) ListInvitations(ctx context.Context, organizationID uuid.UUID) ([]*entities.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []*entities.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entities.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entities.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListInvitationsRepository_ListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitations'
+type MockListInvitationsRepository_ListInvitations_Call struct {
+	*mock.Call
+}
+
+// ListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+func (_e *MockListInvitationsRepository_Expecter) ListInvitations(ctx interface{}, organizationID interface{}) *MockListInvitationsRepository_ListInvitations_Call {
+	return &MockListInvitationsRepository_ListInvitations_Call{Call: _e.mock.On("ListInvitations", ctx, organizationID)}
+}
+
+func (_c *MockListInvitationsRepository_ListInvitations_Call) Run(run func(ctx context.Context, organizationID uuid.UUID)) *MockListInvitationsRepository_ListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListInvitationsRepository_ListInvitations_Call) Return(_a0 []*entities.OrganizationInvitation, _a1 error) *MockListInvitationsRepository_ListInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListInvitationsRepository_ListInvitations_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entities.OrganizationInvitation, error)) *MockListInvitationsRepository_ListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListInvitationsRepository creates a new instance of MockListInvitationsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListInvitationsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListInvitationsRepository {
+	mock := &MockListInvitationsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
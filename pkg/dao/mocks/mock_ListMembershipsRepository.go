@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListMembershipsRepository is an autogenerated mock type for the ListMembershipsRepository type
+type MockListMembershipsRepository struct {
+	mock.Mock
+}
+
+type MockListMembershipsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListMembershipsRepository) EXPECT() *MockListMembershipsRepository_Expecter {
+	return &MockListMembershipsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListMemberships provides a mock function with given fields: ctx, firebaseUID
+func (_m *MockListMembershipsRepository) ListMemberships(ctx context.Context, firebaseUID string) ([]*entities.Membership, error) {
+	ret := _m.Called(ctx, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberships")
+	}
+
+	var r0 []*entities.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.Membership, error)); ok {
+		return rf(ctx, firebaseUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.Membership); ok {
+		r0 = rf(ctx, firebaseUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, firebaseUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListMembershipsRepository_ListMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMemberships'
+type MockListMembershipsRepository_ListMemberships_Call struct {
+	*mock.Call
+}
+
+// ListMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firebaseUID string
+func (_e *MockListMembershipsRepository_Expecter) ListMemberships(ctx interface{}, firebaseUID interface{}) *MockListMembershipsRepository_ListMemberships_Call {
+	return &MockListMembershipsRepository_ListMemberships_Call{Call: _e.mock.On("ListMemberships", ctx, firebaseUID)}
+}
+
+func (_c *MockListMembershipsRepository_ListMemberships_Call) Run(run func(ctx context.Context, firebaseUID string)) *MockListMembershipsRepository_ListMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockListMembershipsRepository_ListMemberships_Call) Return(_a0 []*entities.Membership, _a1 error) *MockListMembershipsRepository_ListMemberships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListMembershipsRepository_ListMemberships_Call) RunAndReturn(run func(context.Context, string) ([]*entities.Membership, error)) *MockListMembershipsRepository_ListMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListMembershipsRepository creates a new instance of MockListMembershipsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListMembershipsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListMembershipsRepository {
+	mock := &MockListMembershipsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
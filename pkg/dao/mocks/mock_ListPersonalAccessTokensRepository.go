@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListPersonalAccessTokensRepository is an autogenerated mock type for the ListPersonalAccessTokensRepository type
+type MockListPersonalAccessTokensRepository struct {
+	mock.Mock
+}
+
+type MockListPersonalAccessTokensRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListPersonalAccessTokensRepository) EXPECT() *MockListPersonalAccessTokensRepository_Expecter {
+	return &MockListPersonalAccessTokensRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListPersonalAccessTokens provides a mock function with given fields: ctx, firebaseUID
+func (_m *MockListPersonalAccessTokensRepository) ListPersonalAccessTokens(ctx context.Context, firebaseUID string) ([]*entities.PersonalAccessToken, error) {
+	ret := _m.Called(ctx, firebaseUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPersonalAccessTokens")
+	}
+
+	var r0 []*entities.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.PersonalAccessToken, error)); ok {
+		return rf(ctx, firebaseUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.PersonalAccessToken); ok {
+		r0 = rf(ctx, firebaseUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, firebaseUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPersonalAccessTokens'
+type MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call struct {
+	*mock.Call
+}
+
+// ListPersonalAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firebaseUID string
+func (_e *MockListPersonalAccessTokensRepository_Expecter) ListPersonalAccessTokens(ctx interface{}, firebaseUID interface{}) *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call {
+	return &MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call{Call: _e.mock.On("ListPersonalAccessTokens", ctx, firebaseUID)}
+}
+
+func (_c *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call) Run(run func(ctx context.Context, firebaseUID string)) *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call) Return(_a0 []*entities.PersonalAccessToken, _a1 error) *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call) RunAndReturn(run func(context.Context, string) ([]*entities.PersonalAccessToken, error)) *MockListPersonalAccessTokensRepository_ListPersonalAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListPersonalAccessTokensRepository creates a new instance of MockListPersonalAccessTokensRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListPersonalAccessTokensRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListPersonalAccessTokensRepository {
+	mock := &MockListPersonalAccessTokensRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
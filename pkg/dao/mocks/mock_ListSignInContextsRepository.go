@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListSignInContextsRepository is an autogenerated mock type for the ListSignInContextsRepository type
+type MockListSignInContextsRepository struct {
+	mock.Mock
+}
+
+type MockListSignInContextsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListSignInContextsRepository) EXPECT() *MockListSignInContextsRepository_Expecter {
+	return &MockListSignInContextsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListSignInContexts provides a mock function with given fields: ctx, tenantID, firebaseUID, limit
+func (_m *MockListSignInContextsRepository) ListSignInContexts(ctx context.Context, tenantID string, firebaseUID string, limit int) ([]*entities.SignInContext, error) {
+	ret := _m.Called(ctx, tenantID, firebaseUID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSignInContexts")
+	}
+
+	var r0 []*entities.SignInContext
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]*entities.SignInContext, error)); ok {
+		return rf(ctx, tenantID, firebaseUID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []*entities.SignInContext); ok {
+		r0 = rf(ctx, tenantID, firebaseUID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.SignInContext)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, tenantID, firebaseUID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListSignInContextsRepository_ListSignInContexts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSignInContexts'
+type MockListSignInContextsRepository_ListSignInContexts_Call struct {
+	*mock.Call
+}
+
+// ListSignInContexts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - firebaseUID string
+//   - limit int
+func (_e *MockListSignInContextsRepository_Expecter) ListSignInContexts(ctx interface{}, tenantID interface{}, firebaseUID interface{}, limit interface{}) *MockListSignInContextsRepository_ListSignInContexts_Call {
+	return &MockListSignInContextsRepository_ListSignInContexts_Call{Call: _e.mock.On("ListSignInContexts", ctx, tenantID, firebaseUID, limit)}
+}
+
+func (_c *MockListSignInContextsRepository_ListSignInContexts_Call) Run(run func(ctx context.Context, tenantID string, firebaseUID string, limit int)) *MockListSignInContextsRepository_ListSignInContexts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockListSignInContextsRepository_ListSignInContexts_Call) Return(_a0 []*entities.SignInContext, _a1 error) *MockListSignInContextsRepository_ListSignInContexts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListSignInContextsRepository_ListSignInContexts_Call) RunAndReturn(run func(context.Context, string, string, int) ([]*entities.SignInContext, error)) *MockListSignInContextsRepository_ListSignInContexts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListSignInContextsRepository creates a new instance of MockListSignInContextsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListSignInContextsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListSignInContextsRepository {
+	mock := &MockListSignInContextsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
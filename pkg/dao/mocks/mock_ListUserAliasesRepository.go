@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListUserAliasesRepository is an autogenerated mock type for the ListUserAliasesRepository type
+type MockListUserAliasesRepository struct {
+	mock.Mock
+}
+
+type MockListUserAliasesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListUserAliasesRepository) EXPECT() *MockListUserAliasesRepository_Expecter {
+	return &MockListUserAliasesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListUserAliases provides a mock function with given fields: ctx, tenantID, aliasUIDs
+func (_m *MockListUserAliasesRepository) ListUserAliases(ctx context.Context, tenantID string, aliasUIDs []string) ([]*entities.UserAlias, error) {
+	ret := _m.Called(ctx, tenantID, aliasUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAliases")
+	}
+
+	var r0 []*entities.UserAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*entities.UserAlias, error)); ok {
+		return rf(ctx, tenantID, aliasUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*entities.UserAlias); ok {
+		r0 = rf(ctx, tenantID, aliasUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, aliasUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListUserAliasesRepository_ListUserAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAliases'
+type MockListUserAliasesRepository_ListUserAliases_Call struct {
+	*mock.Call
+}
+
+// ListUserAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - aliasUIDs []string
+func (_e *MockListUserAliasesRepository_Expecter) ListUserAliases(ctx interface{}, tenantID interface{}, aliasUIDs interface{}) *MockListUserAliasesRepository_ListUserAliases_Call {
+	return &MockListUserAliasesRepository_ListUserAliases_Call{Call: _e.mock.On("ListUserAliases", ctx, tenantID, aliasUIDs)}
+}
+
+func (_c *MockListUserAliasesRepository_ListUserAliases_Call) Run(run func(ctx context.Context, tenantID string, aliasUIDs []string)) *MockListUserAliasesRepository_ListUserAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockListUserAliasesRepository_ListUserAliases_Call) Return(_a0 []*entities.UserAlias, _a1 error) *MockListUserAliasesRepository_ListUserAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListUserAliasesRepository_ListUserAliases_Call) RunAndReturn(run func(context.Context, string, []string) ([]*entities.UserAlias, error)) *MockListUserAliasesRepository_ListUserAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListUserAliasesRepository creates a new instance of MockListUserAliasesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListUserAliasesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListUserAliasesRepository {
+	mock := &MockListUserAliasesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
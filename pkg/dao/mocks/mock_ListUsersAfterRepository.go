@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListUsersAfterRepository is an autogenerated mock type for the ListUsersAfterRepository type
+type MockListUsersAfterRepository struct {
+	mock.Mock
+}
+
+type MockListUsersAfterRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListUsersAfterRepository) EXPECT() *MockListUsersAfterRepository_Expecter {
+	return &MockListUsersAfterRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListUsersAfter provides a mock function with given fields: ctx, tenantID, afterUID, limit
+func (_m *MockListUsersAfterRepository) ListUsersAfter(ctx context.Context, tenantID string, afterUID string, limit int) ([]*entities.User, error) {
+	ret := _m.Called(ctx, tenantID, afterUID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersAfter")
+	}
+
+	var r0 []*entities.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]*entities.User, error)); ok {
+		return rf(ctx, tenantID, afterUID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []*entities.User); ok {
+		r0 = rf(ctx, tenantID, afterUID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, tenantID, afterUID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListUsersAfterRepository_ListUsersAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersAfter'
+type MockListUsersAfterRepository_ListUsersAfter_Call struct {
+	*mock.Call
+}
+
+// ListUsersAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - afterUID string
+//   - limit int
+func (_e *MockListUsersAfterRepository_Expecter) ListUsersAfter(ctx interface{}, tenantID interface{}, afterUID interface{}, limit interface{}) *MockListUsersAfterRepository_ListUsersAfter_Call {
+	return &MockListUsersAfterRepository_ListUsersAfter_Call{Call: _e.mock.On("ListUsersAfter", ctx, tenantID, afterUID, limit)}
+}
+
+func (_c *MockListUsersAfterRepository_ListUsersAfter_Call) Run(run func(ctx context.Context, tenantID string, afterUID string, limit int)) *MockListUsersAfterRepository_ListUsersAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockListUsersAfterRepository_ListUsersAfter_Call) Return(_a0 []*entities.User, _a1 error) *MockListUsersAfterRepository_ListUsersAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListUsersAfterRepository_ListUsersAfter_Call) RunAndReturn(run func(context.Context, string, string, int) ([]*entities.User, error)) *MockListUsersAfterRepository_ListUsersAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListUsersAfterRepository creates a new instance of MockListUsersAfterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListUsersAfterRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListUsersAfterRepository {
+	mock := &MockListUsersAfterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
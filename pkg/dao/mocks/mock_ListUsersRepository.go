@@ -23,9 +23,9 @@ func (_m *MockListUsersRepository) EXPECT() *MockListUsersRepository_Expecter {
 	return &MockListUsersRepository_Expecter{mock: &_m.Mock}
 }
 
-// ListUsers provides a mock function with given fields: ctx, firebaseUIDs
-func (_m *MockListUsersRepository) ListUsers(ctx context.Context, firebaseUIDs []string) ([]*entities.User, error) {
-	ret := _m.Called(ctx, firebaseUIDs)
+// ListUsers provides a mock function with given fields: ctx, tenantID, firebaseUIDs
+func (_m *MockListUsersRepository) ListUsers(ctx context.Context, tenantID string, firebaseUIDs []string) ([]*entities.User, error) {
+	ret := _m.Called(ctx, tenantID, firebaseUIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListUsers")
@@ -33,19 +33,19 @@ func (_m *MockListUsersRepository) ListUsers(ctx context.Context, firebaseUIDs [
 
 	var r0 []*entities.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*entities.User, error)); ok {
-		return rf(ctx, firebaseUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*entities.User, error)); ok {
+		return rf(ctx, tenantID, firebaseUIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []*entities.User); ok {
-		r0 = rf(ctx, firebaseUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*entities.User); ok {
+		r0 = rf(ctx, tenantID, firebaseUIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entities.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, firebaseUIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, firebaseUIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,14 +60,15 @@ type MockListUsersRepository_ListUsers_Call struct {
 
 // ListUsers is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tenantID string
 //   - firebaseUIDs []string
-func (_e *MockListUsersRepository_Expecter) ListUsers(ctx interface{}, firebaseUIDs interface{}) *MockListUsersRepository_ListUsers_Call {
-	return &MockListUsersRepository_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, firebaseUIDs)}
+func (_e *MockListUsersRepository_Expecter) ListUsers(ctx interface{}, tenantID interface{}, firebaseUIDs interface{}) *MockListUsersRepository_ListUsers_Call {
+	return &MockListUsersRepository_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, tenantID, firebaseUIDs)}
 }
 
-func (_c *MockListUsersRepository_ListUsers_Call) Run(run func(ctx context.Context, firebaseUIDs []string)) *MockListUsersRepository_ListUsers_Call {
+func (_c *MockListUsersRepository_ListUsers_Call) Run(run func(ctx context.Context, tenantID string, firebaseUIDs []string)) *MockListUsersRepository_ListUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
 	})
 	return _c
 }
@@ -77,7 +78,7 @@ func (_c *MockListUsersRepository_ListUsers_Call) Return(_a0 []*entities.User, _
 	return _c
 }
 
-func (_c *MockListUsersRepository_ListUsers_Call) RunAndReturn(run func(context.Context, []string) ([]*entities.User, error)) *MockListUsersRepository_ListUsers_Call {
+func (_c *MockListUsersRepository_ListUsers_Call) RunAndReturn(run func(context.Context, string, []string) ([]*entities.User, error)) *MockListUsersRepository_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
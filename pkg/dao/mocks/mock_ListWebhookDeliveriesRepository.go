@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListWebhookDeliveriesRepository is an autogenerated mock type for the ListWebhookDeliveriesRepository type
+type MockListWebhookDeliveriesRepository struct {
+	mock.Mock
+}
+
+type MockListWebhookDeliveriesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListWebhookDeliveriesRepository) EXPECT() *MockListWebhookDeliveriesRepository_Expecter {
+	return &MockListWebhookDeliveriesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, data
+func (_m *MockListWebhookDeliveriesRepository) ListWebhookDeliveries(ctx context.Context, data *dao.ListWebhookDeliveriesData) ([]*entities.WebhookDelivery, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 []*entities.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ListWebhookDeliveriesData) ([]*entities.WebhookDelivery, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.ListWebhookDeliveriesData) []*entities.WebhookDelivery); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.ListWebhookDeliveriesData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.ListWebhookDeliveriesData
+func (_e *MockListWebhookDeliveriesRepository_Expecter) ListWebhookDeliveries(ctx interface{}, data interface{}) *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call {
+	return &MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, data)}
+}
+
+func (_c *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, data *dao.ListWebhookDeliveriesData)) *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.ListWebhookDeliveriesData))
+	})
+	return _c
+}
+
+func (_c *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call) Return(_a0 []*entities.WebhookDelivery, _a1 error) *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call) RunAndReturn(run func(context.Context, *dao.ListWebhookDeliveriesData) ([]*entities.WebhookDelivery, error)) *MockListWebhookDeliveriesRepository_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListWebhookDeliveriesRepository creates a new instance of MockListWebhookDeliveriesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListWebhookDeliveriesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListWebhookDeliveriesRepository {
+	mock := &MockListWebhookDeliveriesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
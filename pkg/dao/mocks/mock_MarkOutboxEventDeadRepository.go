@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMarkOutboxEventDeadRepository is an autogenerated mock type for the MarkOutboxEventDeadRepository type
+type MockMarkOutboxEventDeadRepository struct {
+	mock.Mock
+}
+
+type MockMarkOutboxEventDeadRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkOutboxEventDeadRepository) EXPECT() *MockMarkOutboxEventDeadRepository_Expecter {
+	return &MockMarkOutboxEventDeadRepository_Expecter{mock: &_m.Mock}
+}
+
+// MarkOutboxEventDead provides a mock function with given fields: ctx, id, lastError
+func (_m *MockMarkOutboxEventDeadRepository) MarkOutboxEventDead(ctx context.Context, id int64, lastError string) error {
+	ret := _m.Called(ctx, id, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventDead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventDead'
+type MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventDead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - lastError string
+func (_e *MockMarkOutboxEventDeadRepository_Expecter) MarkOutboxEventDead(ctx interface{}, id interface{}, lastError interface{}) *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call {
+	return &MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call{Call: _e.mock.On("MarkOutboxEventDead", ctx, id, lastError)}
+}
+
+func (_c *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call) Run(run func(ctx context.Context, id int64, lastError string)) *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call) Return(_a0 error) *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockMarkOutboxEventDeadRepository_MarkOutboxEventDead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkOutboxEventDeadRepository creates a new instance of MockMarkOutboxEventDeadRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkOutboxEventDeadRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkOutboxEventDeadRepository {
+	mock := &MockMarkOutboxEventDeadRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
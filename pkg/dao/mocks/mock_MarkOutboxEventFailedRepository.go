@@ -0,0 +1,87 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMarkOutboxEventFailedRepository is an autogenerated mock type for the MarkOutboxEventFailedRepository type
+type MockMarkOutboxEventFailedRepository struct {
+	mock.Mock
+}
+
+type MockMarkOutboxEventFailedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkOutboxEventFailedRepository) EXPECT() *MockMarkOutboxEventFailedRepository_Expecter {
+	return &MockMarkOutboxEventFailedRepository_Expecter{mock: &_m.Mock}
+}
+
+// MarkOutboxEventFailed provides a mock function with given fields: ctx, id, lastError, nextAttemptAt
+func (_m *MockMarkOutboxEventFailedRepository) MarkOutboxEventFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	ret := _m.Called(ctx, id, lastError, nextAttemptAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, id, lastError, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventFailed'
+type MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - lastError string
+//   - nextAttemptAt time.Time
+func (_e *MockMarkOutboxEventFailedRepository_Expecter) MarkOutboxEventFailed(ctx interface{}, id interface{}, lastError interface{}, nextAttemptAt interface{}) *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call {
+	return &MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call{Call: _e.mock.On("MarkOutboxEventFailed", ctx, id, lastError, nextAttemptAt)}
+}
+
+func (_c *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call) Run(run func(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time)) *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call) Return(_a0 error) *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call) RunAndReturn(run func(context.Context, int64, string, time.Time) error) *MockMarkOutboxEventFailedRepository_MarkOutboxEventFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkOutboxEventFailedRepository creates a new instance of MockMarkOutboxEventFailedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkOutboxEventFailedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkOutboxEventFailedRepository {
+	mock := &MockMarkOutboxEventFailedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMarkOutboxEventPublishedRepository is an autogenerated mock type for the MarkOutboxEventPublishedRepository type
+type MockMarkOutboxEventPublishedRepository struct {
+	mock.Mock
+}
+
+type MockMarkOutboxEventPublishedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkOutboxEventPublishedRepository) EXPECT() *MockMarkOutboxEventPublishedRepository_Expecter {
+	return &MockMarkOutboxEventPublishedRepository_Expecter{mock: &_m.Mock}
+}
+
+// MarkOutboxEventPublished provides a mock function with given fields: ctx, id
+func (_m *MockMarkOutboxEventPublishedRepository) MarkOutboxEventPublished(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventPublished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventPublished'
+type MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventPublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockMarkOutboxEventPublishedRepository_Expecter) MarkOutboxEventPublished(ctx interface{}, id interface{}) *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call {
+	return &MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call{Call: _e.mock.On("MarkOutboxEventPublished", ctx, id)}
+}
+
+func (_c *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call) Run(run func(ctx context.Context, id int64)) *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call) Return(_a0 error) *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call) RunAndReturn(run func(context.Context, int64) error) *MockMarkOutboxEventPublishedRepository_MarkOutboxEventPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkOutboxEventPublishedRepository creates a new instance of MockMarkOutboxEventPublishedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkOutboxEventPublishedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkOutboxEventPublishedRepository {
+	mock := &MockMarkOutboxEventPublishedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMergeUsersRepository is an autogenerated mock type for the MergeUsersRepository type
+type MockMergeUsersRepository struct {
+	mock.Mock
+}
+
+type MockMergeUsersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMergeUsersRepository) EXPECT() *MockMergeUsersRepository_Expecter {
+	return &MockMergeUsersRepository_Expecter{mock: &_m.Mock}
+}
+
+// MergeUsers provides a mock function with given fields: ctx, tenantID, data
+func (_m *MockMergeUsersRepository) MergeUsers(ctx context.Context, tenantID string, data *dao.MergeUsersData) (*dao.MergeUsersResult, error) {
+	ret := _m.Called(ctx, tenantID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeUsers")
+	}
+
+	var r0 *dao.MergeUsersResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.MergeUsersData) (*dao.MergeUsersResult, error)); ok {
+		return rf(ctx, tenantID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.MergeUsersData) *dao.MergeUsersResult); ok {
+		r0 = rf(ctx, tenantID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.MergeUsersResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.MergeUsersData) error); ok {
+		r1 = rf(ctx, tenantID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeUsersRepository_MergeUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeUsers'
+type MockMergeUsersRepository_MergeUsers_Call struct {
+	*mock.Call
+}
+
+// MergeUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - data *dao.MergeUsersData
+func (_e *MockMergeUsersRepository_Expecter) MergeUsers(ctx interface{}, tenantID interface{}, data interface{}) *MockMergeUsersRepository_MergeUsers_Call {
+	return &MockMergeUsersRepository_MergeUsers_Call{Call: _e.mock.On("MergeUsers", ctx, tenantID, data)}
+}
+
+func (_c *MockMergeUsersRepository_MergeUsers_Call) Run(run func(ctx context.Context, tenantID string, data *dao.MergeUsersData)) *MockMergeUsersRepository_MergeUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dao.MergeUsersData))
+	})
+	return _c
+}
+
+func (_c *MockMergeUsersRepository_MergeUsers_Call) Return(_a0 *dao.MergeUsersResult, _a1 error) *MockMergeUsersRepository_MergeUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeUsersRepository_MergeUsers_Call) RunAndReturn(run func(context.Context, string, *dao.MergeUsersData) (*dao.MergeUsersResult, error)) *MockMergeUsersRepository_MergeUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMergeUsersRepository creates a new instance of MockMergeUsersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMergeUsersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMergeUsersRepository {
+	mock := &MockMergeUsersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProvisionUserRepository is an autogenerated mock type for the ProvisionUserRepository type
+type MockProvisionUserRepository struct {
+	mock.Mock
+}
+
+type MockProvisionUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProvisionUserRepository) EXPECT() *MockProvisionUserRepository_Expecter {
+	return &MockProvisionUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// ProvisionUser provides a mock function with given fields: ctx, tenantID, firebaseUID, candidates
+func (_m *MockProvisionUserRepository) ProvisionUser(ctx context.Context, tenantID string, firebaseUID string, candidates []string) (*entities.User, error) {
+	ret := _m.Called(ctx, tenantID, firebaseUID, candidates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionUser")
+	}
+
+	var r0 *entities.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) (*entities.User, error)); ok {
+		return rf(ctx, tenantID, firebaseUID, candidates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) *entities.User); ok {
+		r0 = rf(ctx, tenantID, firebaseUID, candidates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, firebaseUID, candidates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvisionUserRepository_ProvisionUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProvisionUser'
+type MockProvisionUserRepository_ProvisionUser_Call struct {
+	*mock.Call
+}
+
+// ProvisionUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - firebaseUID string
+//   - candidates []string
+func (_e *MockProvisionUserRepository_Expecter) ProvisionUser(ctx interface{}, tenantID interface{}, firebaseUID interface{}, candidates interface{}) *MockProvisionUserRepository_ProvisionUser_Call {
+	return &MockProvisionUserRepository_ProvisionUser_Call{Call: _e.mock.On("ProvisionUser", ctx, tenantID, firebaseUID, candidates)}
+}
+
+func (_c *MockProvisionUserRepository_ProvisionUser_Call) Run(run func(ctx context.Context, tenantID string, firebaseUID string, candidates []string)) *MockProvisionUserRepository_ProvisionUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockProvisionUserRepository_ProvisionUser_Call) Return(_a0 *entities.User, _a1 error) *MockProvisionUserRepository_ProvisionUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProvisionUserRepository_ProvisionUser_Call) RunAndReturn(run func(context.Context, string, string, []string) (*entities.User, error)) *MockProvisionUserRepository_ProvisionUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProvisionUserRepository creates a new instance of MockProvisionUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProvisionUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProvisionUserRepository {
+	mock := &MockProvisionUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRecordSignInContextRepository is an autogenerated mock type for the RecordSignInContextRepository type
+type MockRecordSignInContextRepository struct {
+	mock.Mock
+}
+
+type MockRecordSignInContextRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordSignInContextRepository) EXPECT() *MockRecordSignInContextRepository_Expecter {
+	return &MockRecordSignInContextRepository_Expecter{mock: &_m.Mock}
+}
+
+// RecordSignInContext provides a mock function with given fields: ctx, data
+func (_m *MockRecordSignInContextRepository) RecordSignInContext(ctx context.Context, data *dao.RecordSignInContextData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSignInContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.RecordSignInContextData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRecordSignInContextRepository_RecordSignInContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSignInContext'
+type MockRecordSignInContextRepository_RecordSignInContext_Call struct {
+	*mock.Call
+}
+
+// RecordSignInContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.RecordSignInContextData
+func (_e *MockRecordSignInContextRepository_Expecter) RecordSignInContext(ctx interface{}, data interface{}) *MockRecordSignInContextRepository_RecordSignInContext_Call {
+	return &MockRecordSignInContextRepository_RecordSignInContext_Call{Call: _e.mock.On("RecordSignInContext", ctx, data)}
+}
+
+func (_c *MockRecordSignInContextRepository_RecordSignInContext_Call) Run(run func(ctx context.Context, data *dao.RecordSignInContextData)) *MockRecordSignInContextRepository_RecordSignInContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.RecordSignInContextData))
+	})
+	return _c
+}
+
+func (_c *MockRecordSignInContextRepository_RecordSignInContext_Call) Return(_a0 error) *MockRecordSignInContextRepository_RecordSignInContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRecordSignInContextRepository_RecordSignInContext_Call) RunAndReturn(run func(context.Context, *dao.RecordSignInContextData) error) *MockRecordSignInContextRepository_RecordSignInContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordSignInContextRepository creates a new instance of MockRecordSignInContextRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordSignInContextRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordSignInContextRepository {
+	mock := &MockRecordSignInContextRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
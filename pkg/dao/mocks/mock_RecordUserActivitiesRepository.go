@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRecordUserActivitiesRepository is an autogenerated mock type for the RecordUserActivitiesRepository type
+type MockRecordUserActivitiesRepository struct {
+	mock.Mock
+}
+
+type MockRecordUserActivitiesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordUserActivitiesRepository) EXPECT() *MockRecordUserActivitiesRepository_Expecter {
+	return &MockRecordUserActivitiesRepository_Expecter{mock: &_m.Mock}
+}
+
+// RecordUserActivities provides a mock function with given fields: ctx, data
+func (_m *MockRecordUserActivitiesRepository) RecordUserActivities(ctx context.Context, data []*dao.RecordUserActivityData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordUserActivities")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*dao.RecordUserActivityData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRecordUserActivitiesRepository_RecordUserActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordUserActivities'
+type MockRecordUserActivitiesRepository_RecordUserActivities_Call struct {
+	*mock.Call
+}
+
+// RecordUserActivities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []*dao.RecordUserActivityData
+func (_e *MockRecordUserActivitiesRepository_Expecter) RecordUserActivities(ctx interface{}, data interface{}) *MockRecordUserActivitiesRepository_RecordUserActivities_Call {
+	return &MockRecordUserActivitiesRepository_RecordUserActivities_Call{Call: _e.mock.On("RecordUserActivities", ctx, data)}
+}
+
+func (_c *MockRecordUserActivitiesRepository_RecordUserActivities_Call) Run(run func(ctx context.Context, data []*dao.RecordUserActivityData)) *MockRecordUserActivitiesRepository_RecordUserActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*dao.RecordUserActivityData))
+	})
+	return _c
+}
+
+func (_c *MockRecordUserActivitiesRepository_RecordUserActivities_Call) Return(_a0 error) *MockRecordUserActivitiesRepository_RecordUserActivities_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRecordUserActivitiesRepository_RecordUserActivities_Call) RunAndReturn(run func(context.Context, []*dao.RecordUserActivityData) error) *MockRecordUserActivitiesRepository_RecordUserActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordUserActivitiesRepository creates a new instance of MockRecordUserActivitiesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordUserActivitiesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordUserActivitiesRepository {
+	mock := &MockRecordUserActivitiesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,87 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRecordWebhookDeliveryAttemptRepository is an autogenerated mock type for the RecordWebhookDeliveryAttemptRepository type
+type MockRecordWebhookDeliveryAttemptRepository struct {
+	mock.Mock
+}
+
+type MockRecordWebhookDeliveryAttemptRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordWebhookDeliveryAttemptRepository) EXPECT() *MockRecordWebhookDeliveryAttemptRepository_Expecter {
+	return &MockRecordWebhookDeliveryAttemptRepository_Expecter{mock: &_m.Mock}
+}
+
+// RecordWebhookDeliveryAttempt provides a mock function with given fields: ctx, id, data
+func (_m *MockRecordWebhookDeliveryAttemptRepository) RecordWebhookDeliveryAttempt(ctx context.Context, id uuid.UUID, data *dao.RecordWebhookDeliveryAttemptData) error {
+	ret := _m.Called(ctx, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordWebhookDeliveryAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.RecordWebhookDeliveryAttemptData) error); ok {
+		r0 = rf(ctx, id, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordWebhookDeliveryAttempt'
+type MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordWebhookDeliveryAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - data *dao.RecordWebhookDeliveryAttemptData
+func (_e *MockRecordWebhookDeliveryAttemptRepository_Expecter) RecordWebhookDeliveryAttempt(ctx interface{}, id interface{}, data interface{}) *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call {
+	return &MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call{Call: _e.mock.On("RecordWebhookDeliveryAttempt", ctx, id, data)}
+}
+
+func (_c *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call) Run(run func(ctx context.Context, id uuid.UUID, data *dao.RecordWebhookDeliveryAttemptData)) *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dao.RecordWebhookDeliveryAttemptData))
+	})
+	return _c
+}
+
+func (_c *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call) Return(_a0 error) *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dao.RecordWebhookDeliveryAttemptData) error) *MockRecordWebhookDeliveryAttemptRepository_RecordWebhookDeliveryAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordWebhookDeliveryAttemptRepository creates a new instance of MockRecordWebhookDeliveryAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordWebhookDeliveryAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordWebhookDeliveryAttemptRepository {
+	mock := &MockRecordWebhookDeliveryAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
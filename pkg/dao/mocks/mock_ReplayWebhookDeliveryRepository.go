@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReplayWebhookDeliveryRepository is an autogenerated mock type for the ReplayWebhookDeliveryRepository type
+type MockReplayWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+type MockReplayWebhookDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReplayWebhookDeliveryRepository) EXPECT() *MockReplayWebhookDeliveryRepository_Expecter {
+	return &MockReplayWebhookDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// ReplayWebhookDelivery provides a mock function with given fields: ctx, id
+func (_m *MockReplayWebhookDeliveryRepository) ReplayWebhookDelivery(ctx context.Context, id uuid.UUID) (*entities.WebhookDelivery, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayWebhookDelivery")
+	}
+
+	var r0 *entities.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entities.WebhookDelivery, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entities.WebhookDelivery); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayWebhookDelivery'
+type MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// ReplayWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockReplayWebhookDeliveryRepository_Expecter) ReplayWebhookDelivery(ctx interface{}, id interface{}) *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call {
+	return &MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call{Call: _e.mock.On("ReplayWebhookDelivery", ctx, id)}
+}
+
+func (_c *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call) Return(_a0 *entities.WebhookDelivery, _a1 error) *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entities.WebhookDelivery, error)) *MockReplayWebhookDeliveryRepository_ReplayWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReplayWebhookDeliveryRepository creates a new instance of MockReplayWebhookDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReplayWebhookDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReplayWebhookDeliveryRepository {
+	mock := &MockReplayWebhookDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
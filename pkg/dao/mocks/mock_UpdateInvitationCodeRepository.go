@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUpdateInvitationCodeRepository is an autogenerated mock type for the UpdateInvitationCodeRepository type
+type MockUpdateInvitationCodeRepository struct {
+	mock.Mock
+}
+
+type MockUpdateInvitationCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateInvitationCodeRepository) EXPECT() *MockUpdateInvitationCodeRepository_Expecter {
+	return &MockUpdateInvitationCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpdateInvitationCode provides a mock function with given fields: ctx, organizationID, id, data
+func (_m *MockUpdateInvitationCodeRepository) UpdateInvitationCode(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, data *dao.UpdateInvitationCodeData) (*entities.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationID, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInvitationCode")
+	}
+
+	var r0 *entities.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dao.UpdateInvitationCodeData) (*entities.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationID, id, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dao.UpdateInvitationCodeData) *entities.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationID, id, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *dao.UpdateInvitationCodeData) error); ok {
+		r1 = rf(ctx, organizationID, id, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInvitationCode'
+type MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call struct {
+	*mock.Call
+}
+
+// UpdateInvitationCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - id uuid.UUID
+//   - data *dao.UpdateInvitationCodeData
+func (_e *MockUpdateInvitationCodeRepository_Expecter) UpdateInvitationCode(ctx interface{}, organizationID interface{}, id interface{}, data interface{}) *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call {
+	return &MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call{Call: _e.mock.On("UpdateInvitationCode", ctx, organizationID, id, data)}
+}
+
+func (_c *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, data *dao.UpdateInvitationCodeData)) *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*dao.UpdateInvitationCodeData))
+	})
+	return _c
+}
+
+func (_c *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call) Return(_a0 *entities.OrganizationInvitation, _a1 error) *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *dao.UpdateInvitationCodeData) (*entities.OrganizationInvitation, error)) *MockUpdateInvitationCodeRepository_UpdateInvitationCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateInvitationCodeRepository creates a new instance of MockUpdateInvitationCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateInvitationCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateInvitationCodeRepository {
+	mock := &MockUpdateInvitationCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
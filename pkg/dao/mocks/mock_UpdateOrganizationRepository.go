@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-authentication/pkg/dao"
+	entities "github.com/in-rich/uservice-authentication/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUpdateOrganizationRepository is an autogenerated mock type for the UpdateOrganizationRepository type
+type MockUpdateOrganizationRepository struct {
+	mock.Mock
+}
+
+type MockUpdateOrganizationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateOrganizationRepository) EXPECT() *MockUpdateOrganizationRepository_Expecter {
+	return &MockUpdateOrganizationRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpdateOrganization provides a mock function with given fields: ctx, organizationID, data
+func (_m *MockUpdateOrganizationRepository) UpdateOrganization(ctx context.Context, organizationID uuid.UUID, data *dao.UpdateOrganizationData) (*entities.Organization, error) {
+	ret := _m.Called(ctx, organizationID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganization")
+	}
+
+	var r0 *entities.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.UpdateOrganizationData) (*entities.Organization, error)); ok {
+		return rf(ctx, organizationID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.UpdateOrganizationData) *entities.Organization); ok {
+		r0 = rf(ctx, organizationID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dao.UpdateOrganizationData) error); ok {
+		r1 = rf(ctx, organizationID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateOrganizationRepository_UpdateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganization'
+type MockUpdateOrganizationRepository_UpdateOrganization_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+//   - data *dao.UpdateOrganizationData
+func (_e *MockUpdateOrganizationRepository_Expecter) UpdateOrganization(ctx interface{}, organizationID interface{}, data interface{}) *MockUpdateOrganizationRepository_UpdateOrganization_Call {
+	return &MockUpdateOrganizationRepository_UpdateOrganization_Call{Call: _e.mock.On("UpdateOrganization", ctx, organizationID, data)}
+}
+
+func (_c *MockUpdateOrganizationRepository_UpdateOrganization_Call) Run(run func(ctx context.Context, organizationID uuid.UUID, data *dao.UpdateOrganizationData)) *MockUpdateOrganizationRepository_UpdateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dao.UpdateOrganizationData))
+	})
+	return _c
+}
+
+func (_c *MockUpdateOrganizationRepository_UpdateOrganization_Call) Return(_a0 *entities.Organization, _a1 error) *MockUpdateOrganizationRepository_UpdateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateOrganizationRepository_UpdateOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dao.UpdateOrganizationData) (*entities.Organization, error)) *MockUpdateOrganizationRepository_UpdateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateOrganizationRepository creates a new instance of MockUpdateOrganizationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateOrganizationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateOrganizationRepository {
+	mock := &MockUpdateOrganizationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
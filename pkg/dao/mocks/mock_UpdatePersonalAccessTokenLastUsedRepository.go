@@ -0,0 +1,88 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUpdatePersonalAccessTokenLastUsedRepository is an autogenerated mock type for the UpdatePersonalAccessTokenLastUsedRepository type
+type MockUpdatePersonalAccessTokenLastUsedRepository struct {
+	mock.Mock
+}
+
+type MockUpdatePersonalAccessTokenLastUsedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdatePersonalAccessTokenLastUsedRepository) EXPECT() *MockUpdatePersonalAccessTokenLastUsedRepository_Expecter {
+	return &MockUpdatePersonalAccessTokenLastUsedRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpdatePersonalAccessTokenLastUsed provides a mock function with given fields: ctx, id, lastUsedAt
+func (_m *MockUpdatePersonalAccessTokenLastUsedRepository) UpdatePersonalAccessTokenLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	ret := _m.Called(ctx, id, lastUsedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePersonalAccessTokenLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, lastUsedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePersonalAccessTokenLastUsed'
+type MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call struct {
+	*mock.Call
+}
+
+// UpdatePersonalAccessTokenLastUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - lastUsedAt time.Time
+func (_e *MockUpdatePersonalAccessTokenLastUsedRepository_Expecter) UpdatePersonalAccessTokenLastUsed(ctx interface{}, id interface{}, lastUsedAt interface{}) *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call {
+	return &MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call{Call: _e.mock.On("UpdatePersonalAccessTokenLastUsed", ctx, id, lastUsedAt)}
+}
+
+func (_c *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call) Run(run func(ctx context.Context, id uuid.UUID, lastUsedAt time.Time)) *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call) Return(_a0 error) *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockUpdatePersonalAccessTokenLastUsedRepository_UpdatePersonalAccessTokenLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdatePersonalAccessTokenLastUsedRepository creates a new instance of MockUpdatePersonalAccessTokenLastUsedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdatePersonalAccessTokenLastUsedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdatePersonalAccessTokenLastUsedRepository {
+	mock := &MockUpdatePersonalAccessTokenLastUsedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,9 +24,9 @@ func (_m *MockUpdateUserRepository) EXPECT() *MockUpdateUserRepository_Expecter
 	return &MockUpdateUserRepository_Expecter{mock: &_m.Mock}
 }
 
-// UpdateUser provides a mock function with given fields: ctx, firebaseUID, data
-func (_m *MockUpdateUserRepository) UpdateUser(ctx context.Context, firebaseUID string, data *dao.UpdateUserData) (*entities.User, error) {
-	ret := _m.Called(ctx, firebaseUID, data)
+// UpdateUser provides a mock function with given fields: ctx, tenantID, firebaseUID, data
+func (_m *MockUpdateUserRepository) UpdateUser(ctx context.Context, tenantID string, firebaseUID string, data *dao.UpdateUserData) (*entities.User, error) {
+	ret := _m.Called(ctx, tenantID, firebaseUID, data)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateUser")
@@ -34,19 +34,19 @@ func (_m *MockUpdateUserRepository) UpdateUser(ctx context.Context, firebaseUID
 
 	var r0 *entities.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.UpdateUserData) (*entities.User, error)); ok {
-		return rf(ctx, firebaseUID, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *dao.UpdateUserData) (*entities.User, error)); ok {
+		return rf(ctx, tenantID, firebaseUID, data)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.UpdateUserData) *entities.User); ok {
-		r0 = rf(ctx, firebaseUID, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *dao.UpdateUserData) *entities.User); ok {
+		r0 = rf(ctx, tenantID, firebaseUID, data)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.UpdateUserData) error); ok {
-		r1 = rf(ctx, firebaseUID, data)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *dao.UpdateUserData) error); ok {
+		r1 = rf(ctx, tenantID, firebaseUID, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,15 +61,16 @@ type MockUpdateUserRepository_UpdateUser_Call struct {
 
 // UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tenantID string
 //   - firebaseUID string
 //   - data *dao.UpdateUserData
-func (_e *MockUpdateUserRepository_Expecter) UpdateUser(ctx interface{}, firebaseUID interface{}, data interface{}) *MockUpdateUserRepository_UpdateUser_Call {
-	return &MockUpdateUserRepository_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, firebaseUID, data)}
+func (_e *MockUpdateUserRepository_Expecter) UpdateUser(ctx interface{}, tenantID interface{}, firebaseUID interface{}, data interface{}) *MockUpdateUserRepository_UpdateUser_Call {
+	return &MockUpdateUserRepository_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, tenantID, firebaseUID, data)}
 }
 
-func (_c *MockUpdateUserRepository_UpdateUser_Call) Run(run func(ctx context.Context, firebaseUID string, data *dao.UpdateUserData)) *MockUpdateUserRepository_UpdateUser_Call {
+func (_c *MockUpdateUserRepository_UpdateUser_Call) Run(run func(ctx context.Context, tenantID string, firebaseUID string, data *dao.UpdateUserData)) *MockUpdateUserRepository_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*dao.UpdateUserData))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*dao.UpdateUserData))
 	})
 	return _c
 }
@@ -79,7 +80,7 @@ func (_c *MockUpdateUserRepository_UpdateUser_Call) Return(_a0 *entities.User, _
 	return _c
 }
 
-func (_c *MockUpdateUserRepository_UpdateUser_Call) RunAndReturn(run func(context.Context, string, *dao.UpdateUserData) (*entities.User, error)) *MockUpdateUserRepository_UpdateUser_Call {
+func (_c *MockUpdateUserRepository_UpdateUser_Call) RunAndReturn(run func(context.Context, string, string, *dao.UpdateUserData) (*entities.User, error)) *MockUpdateUserRepository_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
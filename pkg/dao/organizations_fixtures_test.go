@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"time"
+)
+
+// organizationsFixtures is shared by membership tests, since memberships cannot exist without their organization.
+var organizationsFixtures = []interface{}{
+	&entities.Organization{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name:      "organization-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.Organization{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		Name:      "organization-2",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.Membership{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		FirebaseUID:    "firebase-uid-1",
+		Role:           entities.MembershipRoleOwner,
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.Membership{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		FirebaseUID:    "firebase-uid-2",
+		Role:           entities.MembershipRoleMember,
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.Membership{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		FirebaseUID:    "firebase-uid-1",
+		Role:           entities.MembershipRoleAdmin,
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+var invitationsFixtures = append(organizationsFixtures, []interface{}{
+	&entities.OrganizationInvitation{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "invitee@gmail.com",
+		Role:           entities.MembershipRoleMember,
+		CodeHash:       "code-hash-1",
+		InvitedBy:      "firebase-uid-1",
+		ExpiresAt:      lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.OrganizationInvitation{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "expired@gmail.com",
+		Role:           entities.MembershipRoleAdmin,
+		CodeHash:       "code-hash-2",
+		InvitedBy:      "firebase-uid-1",
+		ExpiresAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.OrganizationInvitation{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "accepted@gmail.com",
+		Role:           entities.MembershipRoleMember,
+		CodeHash:       "code-hash-3",
+		InvitedBy:      "firebase-uid-1",
+		ExpiresAt:      lo.ToPtr(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)),
+		AcceptedAt:     lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+		AcceptedBy:     lo.ToPtr("firebase-uid-3"),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+}...)
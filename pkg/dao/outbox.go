@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+// insertUserEvent records a user lifecycle event in the outbox. It must run in the same transaction as the change
+// it describes, so the event is published if and only if the change is committed.
+func insertUserEvent(ctx context.Context, tx bun.Tx, eventType string, user *entities.User) error {
+	event := &entities.OutboxEvent{
+		AggregateID: user.FirebaseUID,
+		Type:        eventType,
+		Payload: &entities.UserEventPayload{
+			TenantID:         user.TenantID,
+			FirebaseUID:      user.FirebaseUID,
+			PublicIdentifier: user.PublicIdentifier,
+		},
+	}
+
+	_, err := tx.NewInsert().Model(event).Exec(ctx)
+	return err
+}
+
+// insertUserMergedEvent records the merge of mergedUID into survivor. It replaces the deletion event of the merged
+// user, so consumers can move its data instead of dropping it.
+func insertUserMergedEvent(ctx context.Context, tx bun.Tx, survivor *entities.User, mergedUID string) error {
+	event := &entities.OutboxEvent{
+		AggregateID: survivor.FirebaseUID,
+		Type:        entities.OutboxEventUserMerged,
+		Payload: &entities.UserEventPayload{
+			TenantID:         survivor.TenantID,
+			FirebaseUID:      survivor.FirebaseUID,
+			PublicIdentifier: survivor.PublicIdentifier,
+			MergedUID:        mergedUID,
+		},
+	}
+
+	_, err := tx.NewInsert().Model(event).Exec(ctx)
+	return err
+}
@@ -0,0 +1,87 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+var outboxEventsFixtures = []*entities.OutboxEvent{
+	{
+		ID:            1,
+		AggregateID:   "firebase-uid-1",
+		Type:          entities.OutboxEventUserCreated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-1", PublicIdentifier: "public-identifier-1"},
+		NextAttemptAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		PublishedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:            2,
+		AggregateID:   "firebase-uid-1",
+		Type:          entities.OutboxEventUserUpdated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-1", PublicIdentifier: "public-identifier-2"},
+		NextAttemptAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		// Blocked until the previous event of the same user is published.
+		ID:            3,
+		AggregateID:   "firebase-uid-1",
+		Type:          entities.OutboxEventUserUpdated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-1", PublicIdentifier: "public-identifier-3"},
+		NextAttemptAt: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:            4,
+		AggregateID:   "firebase-uid-2",
+		Type:          entities.OutboxEventUserCreated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-2", PublicIdentifier: "public-identifier-4"},
+		NextAttemptAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		// Waiting for a retry.
+		ID:            5,
+		AggregateID:   "firebase-uid-3",
+		Type:          entities.OutboxEventUserCreated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-3", PublicIdentifier: "public-identifier-5"},
+		Attempts:      1,
+		NextAttemptAt: lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+		LastError:     lo.ToPtr("publish failed"),
+	},
+	{
+		// Failed too many times.
+		ID:            6,
+		AggregateID:   "firebase-uid-4",
+		Type:          entities.OutboxEventUserCreated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-4", PublicIdentifier: "public-identifier-6"},
+		Attempts:      10,
+		NextAttemptAt: lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+		LastError:     lo.ToPtr("publish failed"),
+		DeadAt:        lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		// Not blocked by the dead event of the same user.
+		ID:            7,
+		AggregateID:   "firebase-uid-4",
+		Type:          entities.OutboxEventUserUpdated,
+		Payload:       &entities.UserEventPayload{FirebaseUID: "firebase-uid-4", PublicIdentifier: "public-identifier-7"},
+		NextAttemptAt: lo.ToPtr(time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+// listOutboxEvents returns the events recorded in the outbox, stripped of their generated fields.
+func listOutboxEvents(db bun.IDB) []*entities.OutboxEvent {
+	events := make([]*entities.OutboxEvent, 0)
+	if err := db.NewSelect().Model(&events).Order("id ASC").Scan(context.TODO()); err != nil {
+		panic(err)
+	}
+
+	for _, event := range events {
+		event.ID = 0
+		event.NextAttemptAt = nil
+		event.CreatedAt = nil
+	}
+
+	return events
+}
@@ -0,0 +1,108 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ProvisionUserRepository interface {
+	// ProvisionUser creates the row of a user, with the first public identifier of candidates that no other user of
+	// the tenant holds. If the user already has a row, it is returned unchanged.
+	ProvisionUser(ctx context.Context, tenantID string, firebaseUID string, candidates []string) (*entities.User, error)
+}
+
+type provisionUserRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *provisionUserRepositoryImpl) ProvisionUser(
+	ctx context.Context, tenantID string, firebaseUID string, candidates []string,
+) (*entities.User, error) {
+	var user *entities.User
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		existing := new(entities.User)
+
+		err := tx.NewSelect().
+			Model(existing).
+			Where("tenant_id = ?", tenantID).
+			Where("firebase_uid = ?", firebaseUID).
+			Scan(ctx)
+		if err == nil {
+			user = existing
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		for _, candidate := range candidates {
+			// Public identifiers are not unique in the table, since users pick their own. The lock prevents two
+			// concurrent provisionings from picking the same one.
+			if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", tenantID+"/"+candidate); err != nil {
+				return err
+			}
+
+			taken, err := tx.NewSelect().
+				Model((*entities.User)(nil)).
+				Where("tenant_id = ?", tenantID).
+				Where("public_identifier = ?", candidate).
+				Exists(ctx)
+			if err != nil {
+				return err
+			}
+			if taken {
+				continue
+			}
+
+			user = &entities.User{
+				PublicIdentifier: candidate,
+				TenantID:         tenantID,
+				FirebaseUID:      firebaseUID,
+			}
+
+			res, err := tx.NewInsert().
+				Model(user).
+				On("CONFLICT (tenant_id, firebase_uid) DO NOTHING").
+				Returning("*").
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			rowsAffected, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			// Another login of the same user was provisioned first.
+			if rowsAffected == 0 {
+				user = existing
+
+				return tx.NewSelect().
+					Model(user).
+					Where("tenant_id = ?", tenantID).
+					Where("firebase_uid = ?", firebaseUID).
+					Scan(ctx)
+			}
+
+			return insertUserEvent(ctx, tx, entities.OutboxEventUserCreated, user)
+		}
+
+		return ErrPublicIdentifierUnavailable
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func NewProvisionUserRepository(db bun.IDB) ProvisionUserRepository {
+	return &provisionUserRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,133 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var provisionUserFixtures = []*entities.User{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		PublicIdentifier: "john-doe",
+		FirebaseUID:      "firebase-uid-1",
+	},
+}
+
+func TestProvisionUser(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		tenantID    string
+		firebaseUID string
+		candidates  []string
+		expect      *entities.User
+		expectEvent *entities.OutboxEvent
+		expectErr   error
+	}{
+		{
+			name:        "ProvisionUser",
+			firebaseUID: "firebase-uid-2",
+			candidates:  []string{"jane-doe", "jane-doe-abc123"},
+			expect: &entities.User{
+				PublicIdentifier: "jane-doe",
+				FirebaseUID:      "firebase-uid-2",
+			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-2",
+				Type:        entities.OutboxEventUserCreated,
+				Payload: &entities.UserEventPayload{
+					FirebaseUID:      "firebase-uid-2",
+					PublicIdentifier: "jane-doe",
+				},
+			},
+		},
+		{
+			name:        "PublicIdentifierTaken",
+			firebaseUID: "firebase-uid-2",
+			candidates:  []string{"john-doe", "john-doe-abc123"},
+			expect: &entities.User{
+				PublicIdentifier: "john-doe-abc123",
+				FirebaseUID:      "firebase-uid-2",
+			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-2",
+				Type:        entities.OutboxEventUserCreated,
+				Payload: &entities.UserEventPayload{
+					FirebaseUID:      "firebase-uid-2",
+					PublicIdentifier: "john-doe-abc123",
+				},
+			},
+		},
+		{
+			name:        "PublicIdentifierTakenInAnotherTenant",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-2",
+			candidates:  []string{"john-doe", "john-doe-abc123"},
+			expect: &entities.User{
+				PublicIdentifier: "john-doe",
+				TenantID:         "tenant-1",
+				FirebaseUID:      "firebase-uid-2",
+			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-2",
+				Type:        entities.OutboxEventUserCreated,
+				Payload: &entities.UserEventPayload{
+					TenantID:         "tenant-1",
+					FirebaseUID:      "firebase-uid-2",
+					PublicIdentifier: "john-doe",
+				},
+			},
+		},
+		{
+			name:        "AlreadyProvisioned",
+			firebaseUID: "firebase-uid-1",
+			candidates:  []string{"jane-doe"},
+			expect: &entities.User{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				PublicIdentifier: "john-doe",
+				FirebaseUID:      "firebase-uid-1",
+			},
+		},
+		{
+			name:        "PublicIdentifierUnavailable",
+			firebaseUID: "firebase-uid-2",
+			candidates:  []string{"john-doe"},
+			expectErr:   dao.ErrPublicIdentifierUnavailable,
+		},
+	}
+
+	stx := BeginTX(db, provisionUserFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewProvisionUserRepository(tx)
+			user, err := repo.ProvisionUser(context.TODO(), data.tenantID, data.firebaseUID, data.candidates)
+
+			if user != nil && data.expectEvent != nil {
+				// Since ID is random, nullify it for comparison.
+				user.ID = nil
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, user)
+
+			expectEvents := make([]*entities.OutboxEvent, 0)
+			if data.expectEvent != nil {
+				expectEvents = append(expectEvents, data.expectEvent)
+			}
+
+			require.Equal(t, expectEvents, listOutboxEvents(tx))
+		})
+	}
+}
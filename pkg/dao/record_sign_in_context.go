@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type RecordSignInContextData struct {
+	TenantID    string
+	FirebaseUID string
+	Device      string
+	Network     string
+
+	Country   *string
+	Latitude  *float64
+	Longitude *float64
+
+	SeenAt time.Time
+
+	// Suspicious emits a user.suspicious_sign_in event, along with the write.
+	Suspicious       bool
+	PublicIdentifier string
+}
+
+type RecordSignInContextRepository interface {
+	RecordSignInContext(ctx context.Context, data *RecordSignInContextData) error
+}
+
+type recordSignInContextRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *recordSignInContextRepositoryImpl) RecordSignInContext(ctx context.Context, data *RecordSignInContextData) error {
+	signInContext := &entities.SignInContext{
+		TenantID:    data.TenantID,
+		FirebaseUID: data.FirebaseUID,
+		Device:      data.Device,
+		Network:     data.Network,
+		Country:     data.Country,
+		Latitude:    data.Latitude,
+		Longitude:   data.Longitude,
+		FirstSeenAt: &data.SeenAt,
+		LastSeenAt:  &data.SeenAt,
+	}
+
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(signInContext).
+			On("CONFLICT (tenant_id, firebase_uid, device, network) DO UPDATE").
+			Set("last_seen_at = GREATEST(sign_in_context.last_seen_at, EXCLUDED.last_seen_at)").
+			Set("country = EXCLUDED.country").
+			Set("latitude = EXCLUDED.latitude").
+			Set("longitude = EXCLUDED.longitude").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !data.Suspicious {
+			return nil
+		}
+
+		return insertUserEvent(ctx, tx, entities.OutboxEventUserSuspiciousSignIn, &entities.User{
+			PublicIdentifier: data.PublicIdentifier,
+			TenantID:         data.TenantID,
+			FirebaseUID:      data.FirebaseUID,
+		})
+	})
+}
+
+func NewRecordSignInContextRepository(db bun.IDB) RecordSignInContextRepository {
+	return &recordSignInContextRepositoryImpl{
+		db: db,
+	}
+}
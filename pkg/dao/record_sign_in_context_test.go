@@ -0,0 +1,139 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var recordSignInContextFixtures = []*entities.SignInContext{
+	{
+		FirebaseUID: "firebase-uid-1",
+		Device:      "device-1",
+		Network:     "203.0.113.0/24",
+		FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)),
+		LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestRecordSignInContext(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		data        *dao.RecordSignInContextData
+		expect      []*entities.SignInContext
+		expectEvent *entities.OutboxEvent
+	}{
+		{
+			name: "RecordNewContext",
+			data: &dao.RecordSignInContextData{
+				FirebaseUID: "firebase-uid-1",
+				Device:      "device-2",
+				Network:     "203.0.113.0/24",
+				Country:     lo.ToPtr("FR"),
+				Latitude:    lo.ToPtr(48.8566),
+				Longitude:   lo.ToPtr(2.3522),
+				SeenAt:      time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC),
+			},
+			expect: []*entities.SignInContext{
+				recordSignInContextFixtures[0],
+				{
+					FirebaseUID: "firebase-uid-1",
+					Device:      "device-2",
+					Network:     "203.0.113.0/24",
+					Country:     lo.ToPtr("FR"),
+					Latitude:    lo.ToPtr(48.8566),
+					Longitude:   lo.ToPtr(2.3522),
+					FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+					LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "RecordKnownContext",
+			data: &dao.RecordSignInContextData{
+				FirebaseUID: "firebase-uid-1",
+				Device:      "device-1",
+				Network:     "203.0.113.0/24",
+				Country:     lo.ToPtr("FR"),
+				SeenAt:      time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC),
+			},
+			expect: []*entities.SignInContext{
+				{
+					FirebaseUID: "firebase-uid-1",
+					Device:      "device-1",
+					Network:     "203.0.113.0/24",
+					Country:     lo.ToPtr("FR"),
+					FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)),
+					LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "RecordSuspiciousContext",
+			data: &dao.RecordSignInContextData{
+				FirebaseUID:      "firebase-uid-1",
+				Device:           "device-2",
+				Network:          "198.51.100.0/24",
+				SeenAt:           time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC),
+				Suspicious:       true,
+				PublicIdentifier: "public-identifier-1",
+			},
+			expect: []*entities.SignInContext{
+				recordSignInContextFixtures[0],
+				{
+					FirebaseUID: "firebase-uid-1",
+					Device:      "device-2",
+					Network:     "198.51.100.0/24",
+					FirstSeenAt: lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+					LastSeenAt:  lo.ToPtr(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-1",
+				Type:        entities.OutboxEventUserSuspiciousSignIn,
+				Payload: &entities.UserEventPayload{
+					FirebaseUID:      "firebase-uid-1",
+					PublicIdentifier: "public-identifier-1",
+				},
+			},
+		},
+	}
+
+	stx := BeginTX(db, recordSignInContextFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewRecordSignInContextRepository(tx)
+			err := repo.RecordSignInContext(context.TODO(), data.data)
+
+			require.NoError(t, err)
+
+			signInContexts := make([]*entities.SignInContext, 0)
+			require.NoError(t, tx.NewSelect().Model(&signInContexts).Order("id").Scan(context.TODO()))
+
+			// Since IDs are generated, nullify them for comparison.
+			for _, signInContext := range signInContexts {
+				signInContext.ID = 0
+			}
+
+			expectEvents := make([]*entities.OutboxEvent, 0)
+			if data.expectEvent != nil {
+				expectEvents = append(expectEvents, data.expectEvent)
+			}
+
+			require.Equal(t, data.expect, signInContexts)
+			require.Equal(t, expectEvents, listOutboxEvents(tx))
+		})
+	}
+}
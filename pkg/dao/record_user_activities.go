@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// RecordUserActivityData sums up the authentications of a user since the last write.
+type RecordUserActivityData struct {
+	TenantID    string
+	FirebaseUID string
+
+	LastSeenAt          time.Time
+	AuthenticationCount int64
+	LastPeerIP          *string
+	LastUserAgent       *string
+}
+
+type RecordUserActivitiesRepository interface {
+	RecordUserActivities(ctx context.Context, data []*RecordUserActivityData) error
+}
+
+type recordUserActivitiesRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *recordUserActivitiesRepositoryImpl) RecordUserActivities(ctx context.Context, data []*RecordUserActivityData) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	activities := make([]*entities.UserActivity, len(data))
+	for i, activity := range data {
+		activities[i] = &entities.UserActivity{
+			TenantID:            activity.TenantID,
+			FirebaseUID:         activity.FirebaseUID,
+			LastSeenAt:          &activity.LastSeenAt,
+			AuthenticationCount: activity.AuthenticationCount,
+			LastPeerIP:          activity.LastPeerIP,
+			LastUserAgent:       activity.LastUserAgent,
+		}
+	}
+
+	// Writes from several instances may arrive out of order, so the client metadata is only replaced by a more
+	// recent one.
+	_, err := r.db.NewInsert().
+		Model(&activities).
+		On("CONFLICT (tenant_id, firebase_uid) DO UPDATE").
+		Set("authentication_count = user_activity.authentication_count + EXCLUDED.authentication_count").
+		Set("last_seen_at = GREATEST(user_activity.last_seen_at, EXCLUDED.last_seen_at)").
+		Set("last_peer_ip = CASE WHEN EXCLUDED.last_seen_at >= user_activity.last_seen_at THEN EXCLUDED.last_peer_ip ELSE user_activity.last_peer_ip END").
+		Set("last_user_agent = CASE WHEN EXCLUDED.last_seen_at >= user_activity.last_seen_at THEN EXCLUDED.last_user_agent ELSE user_activity.last_user_agent END").
+		Exec(ctx)
+
+	return err
+}
+
+func NewRecordUserActivitiesRepository(db bun.IDB) RecordUserActivitiesRepository {
+	return &recordUserActivitiesRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,118 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var recordUserActivitiesFixtures = []*entities.UserActivity{
+	{
+		FirebaseUID:         "firebase-uid-1",
+		LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC)),
+		AuthenticationCount: 10,
+		LastPeerIP:          lo.ToPtr("203.0.113.1"),
+		LastUserAgent:       lo.ToPtr("grpc-go/1.64.0"),
+	},
+}
+
+func TestRecordUserActivities(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		data   []*dao.RecordUserActivityData
+		expect []*entities.UserActivity
+	}{
+		{
+			name: "RecordNewUser",
+			data: []*dao.RecordUserActivityData{
+				{
+					TenantID:            "tenant-1",
+					FirebaseUID:         "firebase-uid-1",
+					LastSeenAt:          time.Date(2024, 10, 11, 13, 0, 0, 0, time.UTC),
+					AuthenticationCount: 2,
+					LastPeerIP:          lo.ToPtr("203.0.113.2"),
+				},
+			},
+			expect: []*entities.UserActivity{
+				recordUserActivitiesFixtures[0],
+				{
+					TenantID:            "tenant-1",
+					FirebaseUID:         "firebase-uid-1",
+					LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 13, 0, 0, 0, time.UTC)),
+					AuthenticationCount: 2,
+					LastPeerIP:          lo.ToPtr("203.0.113.2"),
+				},
+			},
+		},
+		{
+			name: "RecordKnownUser",
+			data: []*dao.RecordUserActivityData{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					LastSeenAt:          time.Date(2024, 10, 11, 13, 0, 0, 0, time.UTC),
+					AuthenticationCount: 3,
+					LastPeerIP:          lo.ToPtr("203.0.113.2"),
+					LastUserAgent:       lo.ToPtr("grpc-go/1.67.0"),
+				},
+			},
+			expect: []*entities.UserActivity{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 13, 0, 0, 0, time.UTC)),
+					AuthenticationCount: 13,
+					LastPeerIP:          lo.ToPtr("203.0.113.2"),
+					LastUserAgent:       lo.ToPtr("grpc-go/1.67.0"),
+				},
+			},
+		},
+		{
+			// Another instance may flush an older activity after a more recent one.
+			name: "RecordOutOfOrder",
+			data: []*dao.RecordUserActivityData{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					LastSeenAt:          time.Date(2024, 10, 11, 11, 0, 0, 0, time.UTC),
+					AuthenticationCount: 3,
+					LastPeerIP:          lo.ToPtr("203.0.113.2"),
+					LastUserAgent:       lo.ToPtr("grpc-go/1.67.0"),
+				},
+			},
+			expect: []*entities.UserActivity{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC)),
+					AuthenticationCount: 13,
+					LastPeerIP:          lo.ToPtr("203.0.113.1"),
+					LastUserAgent:       lo.ToPtr("grpc-go/1.64.0"),
+				},
+			},
+		},
+	}
+
+	stx := BeginTX(db, recordUserActivitiesFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewRecordUserActivitiesRepository(tx)
+			err := repo.RecordUserActivities(context.TODO(), data.data)
+
+			require.NoError(t, err)
+
+			activities := make([]*entities.UserActivity, 0)
+			require.NoError(t, tx.NewSelect().Model(&activities).Order("tenant_id", "firebase_uid").Scan(context.TODO()))
+
+			require.Equal(t, data.expect, activities)
+		})
+	}
+}
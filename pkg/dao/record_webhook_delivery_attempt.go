@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type RecordWebhookDeliveryAttemptData struct {
+	StatusCode *int
+	Error      *string
+	Duration   time.Duration
+
+	// Status is the status of the delivery after the attempt.
+	Status entities.WebhookDeliveryStatus
+	// NextAttemptAt is only used when the delivery is still pending.
+	NextAttemptAt time.Time
+}
+
+type RecordWebhookDeliveryAttemptRepository interface {
+	RecordWebhookDeliveryAttempt(ctx context.Context, id uuid.UUID, data *RecordWebhookDeliveryAttemptData) error
+}
+
+type recordWebhookDeliveryAttemptRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *recordWebhookDeliveryAttemptRepositoryImpl) RecordWebhookDeliveryAttempt(
+	ctx context.Context, id uuid.UUID, data *RecordWebhookDeliveryAttemptData,
+) error {
+	attempt := &entities.WebhookDeliveryAttempt{
+		DeliveryID: id,
+		StatusCode: data.StatusCode,
+		Error:      data.Error,
+		DurationMS: data.Duration.Milliseconds(),
+	}
+
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := tx.NewUpdate().
+			Model((*entities.WebhookDelivery)(nil)).
+			Set("attempts = attempts + 1").
+			Set("status = ?", data.Status).
+			Where("id = ?", id)
+
+		switch data.Status {
+		case entities.WebhookDeliveryStatusPending:
+			query = query.Set("next_attempt_at = ?", data.NextAttemptAt)
+		case entities.WebhookDeliveryStatusDelivered:
+			query = query.Set("delivered_at = NOW()")
+		}
+
+		res, err := query.Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrWebhookDeliveryNotFound
+		}
+
+		_, err = tx.NewInsert().Model(attempt).Exec(ctx)
+		return err
+	})
+}
+
+func NewRecordWebhookDeliveryAttemptRepository(db bun.IDB) RecordWebhookDeliveryAttemptRepository {
+	return &recordWebhookDeliveryAttemptRepositoryImpl{
+		db: db,
+	}
+}
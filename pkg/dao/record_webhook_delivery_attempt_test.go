@@ -0,0 +1,116 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRecordWebhookDeliveryAttempt(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name string
+		id   uuid.UUID
+		data *dao.RecordWebhookDeliveryAttemptData
+
+		expectAttempts      int
+		expectStatus        entities.WebhookDeliveryStatus
+		expectNextAttemptAt *time.Time
+		expectDelivered     bool
+		expectErr           error
+	}{
+		{
+			name: "Delivered",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			data: &dao.RecordWebhookDeliveryAttemptData{
+				StatusCode: lo.ToPtr(204),
+				Duration:   20 * time.Millisecond,
+				Status:     entities.WebhookDeliveryStatusDelivered,
+			},
+			expectAttempts:  1,
+			expectStatus:    entities.WebhookDeliveryStatusDelivered,
+			expectDelivered: true,
+		},
+		{
+			name: "Retry",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			data: &dao.RecordWebhookDeliveryAttemptData{
+				StatusCode:    lo.ToPtr(503),
+				Error:         lo.ToPtr("receiver rejected the webhook with status 503"),
+				Duration:      20 * time.Millisecond,
+				Status:        entities.WebhookDeliveryStatusPending,
+				NextAttemptAt: time.Date(2100, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+			expectAttempts:      2,
+			expectStatus:        entities.WebhookDeliveryStatusPending,
+			expectNextAttemptAt: lo.ToPtr(time.Date(2100, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "Dead",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			data: &dao.RecordWebhookDeliveryAttemptData{
+				Error:    lo.ToPtr("connection refused"),
+				Duration: 20 * time.Millisecond,
+				Status:   entities.WebhookDeliveryStatusDead,
+			},
+			expectAttempts: 2,
+			expectStatus:   entities.WebhookDeliveryStatusDead,
+			// Unchanged.
+			expectNextAttemptAt: lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "DeliveryNotFound",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			data: &dao.RecordWebhookDeliveryAttemptData{
+				StatusCode: lo.ToPtr(200),
+				Status:     entities.WebhookDeliveryStatusDelivered,
+			},
+			expectErr: dao.ErrWebhookDeliveryNotFound,
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewRecordWebhookDeliveryAttemptRepository(tx)
+			err := repo.RecordWebhookDeliveryAttempt(context.TODO(), data.id, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			if data.expectErr != nil {
+				return
+			}
+
+			delivery := new(entities.WebhookDelivery)
+			err = tx.NewSelect().
+				Model(delivery).
+				Relation("Log").
+				Where("webhook_delivery.id = ?", data.id).
+				Scan(context.TODO())
+			require.NoError(t, err)
+
+			require.Equal(t, data.expectAttempts, delivery.Attempts)
+			require.Equal(t, data.expectStatus, delivery.Status)
+			require.Equal(t, data.expectDelivered, delivery.DeliveredAt != nil)
+			if data.expectNextAttemptAt != nil {
+				require.True(t, data.expectNextAttemptAt.Equal(*delivery.NextAttemptAt))
+			}
+
+			require.Len(t, delivery.Log, 1)
+			require.Equal(t, data.data.StatusCode, delivery.Log[0].StatusCode)
+			require.Equal(t, data.data.Error, delivery.Log[0].Error)
+			require.Equal(t, data.data.Duration.Milliseconds(), delivery.Log[0].DurationMS)
+		})
+	}
+}
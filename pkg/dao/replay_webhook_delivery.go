@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ReplayWebhookDeliveryRepository interface {
+	ReplayWebhookDelivery(ctx context.Context, id uuid.UUID) (*entities.WebhookDelivery, error)
+}
+
+type replayWebhookDeliveryRepositoryImpl struct {
+	db bun.IDB
+}
+
+// ReplayWebhookDelivery schedules the delivery again, right away and with a fresh set of attempts, whatever its
+// status. Previous attempts are kept in the delivery log.
+func (r *replayWebhookDeliveryRepositoryImpl) ReplayWebhookDelivery(
+	ctx context.Context, id uuid.UUID,
+) (*entities.WebhookDelivery, error) {
+	delivery := new(entities.WebhookDelivery)
+
+	res, err := r.db.NewUpdate().
+		Model(delivery).
+		Set("status = ?", entities.WebhookDeliveryStatusPending).
+		Set("attempts = 0").
+		Set("next_attempt_at = NOW()").
+		Set("delivered_at = NULL").
+		Where("id = ?", id).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	return delivery, nil
+}
+
+func NewReplayWebhookDeliveryRepository(db bun.IDB) ReplayWebhookDeliveryRepository {
+	return &replayWebhookDeliveryRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,60 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		expectErr error
+	}{
+		{
+			name: "ReplayDeadDelivery",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		},
+		{
+			name: "ReplayDeliveredDelivery",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		},
+		{
+			name:      "DeliveryNotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			expectErr: dao.ErrWebhookDeliveryNotFound,
+		},
+	}
+
+	stx := BeginTX(db, webhooksFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewReplayWebhookDeliveryRepository(tx)
+			delivery, err := repo.ReplayWebhookDelivery(context.TODO(), data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+			if data.expectErr != nil {
+				return
+			}
+
+			require.Equal(t, data.id, *delivery.ID)
+			require.Equal(t, entities.WebhookDeliveryStatusPending, delivery.Status)
+			require.Zero(t, delivery.Attempts)
+			require.Nil(t, delivery.DeliveredAt)
+			require.False(t, delivery.NextAttemptAt.After(time.Now()))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"math"
+	"time"
+)
+
+type TakeRateLimitTokenRepository interface {
+	// TakeRateLimitToken takes a token from the bucket of key, which refills at rate tokens per second up to burst.
+	// It returns 0 if a token was available, or how long to wait until one is.
+	TakeRateLimitToken(ctx context.Context, key string, rate float64, burst int) (time.Duration, error)
+}
+
+type takeRateLimitTokenRepositoryImpl struct {
+	db bun.IDB
+}
+
+// The bucket is refilled and taken from in a single statement, so concurrent requests from every instance share the
+// same count. The database clock is used, so instances with skewed clocks agree on the refill.
+const takeRateLimitTokenQuery = `
+INSERT INTO rate_limit_buckets AS bucket (key, tokens, allowed, updated_at)
+VALUES (?0, ?2 - 1, TRUE, now())
+ON CONFLICT (key) DO UPDATE SET
+	tokens = CASE WHEN ?3 >= 1 THEN ?3 - 1 ELSE ?3 END,
+	allowed = ?3 >= 1,
+	updated_at = GREATEST(bucket.updated_at, EXCLUDED.updated_at)
+RETURNING tokens, allowed
+`
+
+// refilledTokens is the content of an existing bucket, once the time elapsed since its last update is accounted for.
+// now() is the start of the transaction, so it may precede the last update made by a concurrent one.
+const refilledTokens = `LEAST(
+	?0::DOUBLE PRECISION,
+	bucket.tokens + GREATEST(EXTRACT(EPOCH FROM (now() - bucket.updated_at)), 0) * ?1
+)`
+
+func (r *takeRateLimitTokenRepositoryImpl) TakeRateLimitToken(
+	ctx context.Context, key string, rate float64, burst int,
+) (time.Duration, error) {
+	bucket := new(entities.RateLimitBucket)
+
+	if err := r.db.NewRaw(
+		takeRateLimitTokenQuery, key, rate, burst, bun.SafeQuery(refilledTokens, burst, rate),
+	).Scan(ctx, bucket); err != nil {
+		return 0, err
+	}
+
+	if bucket.Allowed {
+		return 0, nil
+	}
+
+	return time.Duration(math.Ceil((1 - bucket.Tokens) / rate * float64(time.Second))), nil
+}
+
+func NewTakeRateLimitTokenRepository(db bun.IDB) TakeRateLimitTokenRepository {
+	return &takeRateLimitTokenRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,96 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var takeRateLimitTokenFixtures = []*entities.RateLimitBucket{
+	{
+		Key:       "peer_ip:203.0.113.1",
+		Tokens:    0,
+		Allowed:   false,
+		UpdatedAt: lo.ToPtr(time.Now()),
+	},
+	{
+		Key:       "peer_ip:203.0.113.2",
+		Tokens:    0,
+		Allowed:   false,
+		UpdatedAt: lo.ToPtr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestTakeRateLimitToken(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name  string
+		key   string
+		rate  float64
+		burst int
+
+		expectAllowed bool
+		expectTokens  float64
+	}{
+		{
+			name:          "NewBucket",
+			key:           "peer_ip:203.0.113.3",
+			rate:          1,
+			burst:         5,
+			expectAllowed: true,
+			expectTokens:  4,
+		},
+		{
+			name:  "EmptyBucket",
+			key:   "peer_ip:203.0.113.1",
+			rate:  0.001,
+			burst: 5,
+		},
+		{
+			// The bucket refilled while idle, but never above its burst.
+			name:          "RefilledBucket",
+			key:           "peer_ip:203.0.113.2",
+			rate:          1,
+			burst:         5,
+			expectAllowed: true,
+			expectTokens:  4,
+		},
+	}
+
+	stx := BeginTX(db, takeRateLimitTokenFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewTakeRateLimitTokenRepository(tx)
+			retryAfter, err := repo.TakeRateLimitToken(context.TODO(), data.key, data.rate, data.burst)
+
+			require.NoError(t, err)
+
+			if data.expectAllowed {
+				require.Zero(t, retryAfter)
+			} else {
+				// A token refills every 1000 seconds.
+				require.Greater(t, retryAfter, time.Duration(0))
+				require.LessOrEqual(t, retryAfter, 1000*time.Second)
+			}
+
+			bucket := new(entities.RateLimitBucket)
+			require.NoError(t, tx.NewSelect().Model(bucket).Where("key = ?", data.key).Scan(context.TODO()))
+
+			require.Equal(t, data.expectAllowed, bucket.Allowed)
+			if data.expectAllowed {
+				require.Equal(t, data.expectTokens, bucket.Tokens)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UpdateInvitationCodeData struct {
+	CodeHash  string
+	ExpiresAt time.Time
+}
+
+type UpdateInvitationCodeRepository interface {
+	UpdateInvitationCode(
+		ctx context.Context, organizationID uuid.UUID, id uuid.UUID, data *UpdateInvitationCodeData,
+	) (*entities.OrganizationInvitation, error)
+}
+
+type updateInvitationCodeRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *updateInvitationCodeRepositoryImpl) UpdateInvitationCode(
+	ctx context.Context, organizationID uuid.UUID, id uuid.UUID, data *UpdateInvitationCodeData,
+) (*entities.OrganizationInvitation, error) {
+	invitation := new(entities.OrganizationInvitation)
+
+	res, err := r.db.NewUpdate().
+		Model(invitation).
+		Set("code_hash = ?", data.CodeHash).
+		Set("expires_at = ?", data.ExpiresAt).
+		Where("id = ?", id).
+		Where("organization_id = ?", organizationID).
+		Where("accepted_at IS NULL").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrInvitationNotFound
+	}
+
+	return invitation, nil
+}
+
+func NewUpdateInvitationCodeRepository(db bun.IDB) UpdateInvitationCodeRepository {
+	return &updateInvitationCodeRepositoryImpl{
+		db: db,
+	}
+}
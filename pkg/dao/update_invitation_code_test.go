@@ -0,0 +1,74 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUpdateInvitationCode(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		id             uuid.UUID
+		data           *dao.UpdateInvitationCodeData
+		expectErr      error
+	}{
+		{
+			name:           "UpdateInvitationCode",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			data: &dao.UpdateInvitationCodeData{
+				CodeHash:  "code-hash-4",
+				ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:           "AcceptedInvitation",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			data: &dao.UpdateInvitationCodeData{
+				CodeHash:  "code-hash-4",
+				ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectErr: dao.ErrInvitationNotFound,
+		},
+		{
+			name:           "WrongOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			id:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			data: &dao.UpdateInvitationCodeData{
+				CodeHash:  "code-hash-4",
+				ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectErr: dao.ErrInvitationNotFound,
+		},
+	}
+
+	stx := BeginTX(db, invitationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpdateInvitationCodeRepository(tx)
+			invitation, err := repo.UpdateInvitationCode(context.TODO(), data.organizationID, data.id, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expectErr == nil {
+				require.Equal(t, data.data.CodeHash, invitation.CodeHash)
+				require.Equal(t, data.data.ExpiresAt, *invitation.ExpiresAt)
+				require.Equal(t, "expired@gmail.com", invitation.Email)
+			}
+		})
+	}
+}
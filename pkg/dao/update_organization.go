@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type UpdateOrganizationData struct {
+	RequireMFA bool
+}
+
+type UpdateOrganizationRepository interface {
+	UpdateOrganization(
+		ctx context.Context, organizationID uuid.UUID, data *UpdateOrganizationData,
+	) (*entities.Organization, error)
+}
+
+type updateOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *updateOrganizationRepositoryImpl) UpdateOrganization(
+	ctx context.Context, organizationID uuid.UUID, data *UpdateOrganizationData,
+) (*entities.Organization, error) {
+	organization := &entities.Organization{
+		ID:         &organizationID,
+		RequireMFA: data.RequireMFA,
+	}
+
+	res, err := r.db.NewUpdate().
+		Model(organization).
+		Column("require_mfa").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+func NewUpdateOrganizationRepository(db bun.IDB) UpdateOrganizationRepository {
+	return &updateOrganizationRepositoryImpl{
+		db: db,
+	}
+}
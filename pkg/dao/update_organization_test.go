@@ -0,0 +1,59 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUpdateOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		data           *dao.UpdateOrganizationData
+		expect         *entities.Organization
+		expectErr      error
+	}{
+		{
+			name:           "RequireMFA",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			data:           &dao.UpdateOrganizationData{RequireMFA: true},
+			expect: &entities.Organization{
+				ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name:       "organization-1",
+				RequireMFA: true,
+				CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "OrganizationNotFound",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			data:           &dao.UpdateOrganizationData{RequireMFA: true},
+			expectErr:      dao.ErrOrganizationNotFound,
+		},
+	}
+
+	stx := BeginTX(db, organizationsFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpdateOrganizationRepository(tx)
+			organization, err := repo.UpdateOrganization(context.TODO(), data.organizationID, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, organization)
+		})
+	}
+}
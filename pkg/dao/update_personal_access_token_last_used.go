@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UpdatePersonalAccessTokenLastUsedRepository interface {
+	UpdatePersonalAccessTokenLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+}
+
+type updatePersonalAccessTokenLastUsedRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *updatePersonalAccessTokenLastUsedRepositoryImpl) UpdatePersonalAccessTokenLastUsed(
+	ctx context.Context, id uuid.UUID, lastUsedAt time.Time,
+) error {
+	res, err := r.db.NewUpdate().
+		Model((*entities.PersonalAccessToken)(nil)).
+		Set("last_used_at = ?", lastUsedAt).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrPersonalAccessTokenNotFound
+	}
+
+	return nil
+}
+
+func NewUpdatePersonalAccessTokenLastUsedRepository(db bun.IDB) UpdatePersonalAccessTokenLastUsedRepository {
+	return &updatePersonalAccessTokenLastUsedRepositoryImpl{
+		db: db,
+	}
+}
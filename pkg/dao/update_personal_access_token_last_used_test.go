@@ -0,0 +1,62 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var updatePersonalAccessTokenLastUsedFixtures = []*entities.PersonalAccessToken{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		FirebaseUID: "firebase-uid-1",
+		Name:        "token-1",
+		TokenHash:   "token-hash-1",
+		Scopes:      []string{"user:read"},
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestUpdatePersonalAccessTokenLastUsed(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name       string
+		id         uuid.UUID
+		lastUsedAt time.Time
+		expectErr  error
+	}{
+		{
+			name:       "UpdatePersonalAccessTokenLastUsed",
+			id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			lastUsedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "PersonalAccessTokenNotFound",
+			id:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			lastUsedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			expectErr:  dao.ErrPersonalAccessTokenNotFound,
+		},
+	}
+
+	stx := BeginTX(db, updatePersonalAccessTokenLastUsedFixtures)
+	defer RollbackTX(stx)
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpdatePersonalAccessTokenLastUsedRepository(tx)
+			err := repo.UpdatePersonalAccessTokenLastUsed(context.TODO(), data.id, data.lastUsedAt)
+
+			require.ErrorIs(t, err, data.expectErr)
+		})
+	}
+}
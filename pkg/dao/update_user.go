@@ -11,37 +11,48 @@ type UpdateUserData struct {
 }
 
 type UpdateUserRepository interface {
-	UpdateUser(ctx context.Context, firebaseUID string, data *UpdateUserData) (*entities.User, error)
+	UpdateUser(ctx context.Context, tenantID string, firebaseUID string, data *UpdateUserData) (*entities.User, error)
 }
 
 type updateUserRepositoryImpl struct {
 	db bun.IDB
 }
 
-func (r *updateUserRepositoryImpl) UpdateUser(ctx context.Context, firebaseUID string, data *UpdateUserData) (*entities.User, error) {
+func (r *updateUserRepositoryImpl) UpdateUser(
+	ctx context.Context, tenantID string, firebaseUID string, data *UpdateUserData,
+) (*entities.User, error) {
 	user := &entities.User{
 		PublicIdentifier: data.PublicIdentifier,
+		TenantID:         tenantID,
 		FirebaseUID:      firebaseUID,
 	}
 
-	res, err := r.db.NewUpdate().
-		Model(user).
-		Column("public_identifier").
-		Where("firebase_uid = ?", firebaseUID).
-		Returning("*").
-		Exec(ctx)
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(user).
+			Column("public_identifier").
+			Where("tenant_id = ?", tenantID).
+			Where("firebase_uid = ?", firebaseUID).
+			Returning("*").
+			Exec(ctx)
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return err
+		}
 
-	rowsAffected, err := res.RowsAffected()
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrUserNotFound
+		}
+
+		return insertUserEvent(ctx, tx, entities.OutboxEventUserUpdated, user)
+	})
 	if err != nil {
 		return nil, err
 	}
-	if rowsAffected == 0 {
-		return nil, ErrUserNotFound
-	}
 
 	return user, nil
 }
@@ -24,9 +24,11 @@ func TestUpdateUser(t *testing.T) {
 
 	testData := []struct {
 		name        string
+		tenantID    string
 		firebaseUID string
 		data        *dao.UpdateUserData
 		expect      *entities.User
+		expectEvent *entities.OutboxEvent
 		expectErr   error
 	}{
 		{
@@ -40,6 +42,23 @@ func TestUpdateUser(t *testing.T) {
 				PublicIdentifier: "public-identifier-2",
 				FirebaseUID:      "firebase-uid-1",
 			},
+			expectEvent: &entities.OutboxEvent{
+				AggregateID: "firebase-uid-1",
+				Type:        entities.OutboxEventUserUpdated,
+				Payload: &entities.UserEventPayload{
+					FirebaseUID:      "firebase-uid-1",
+					PublicIdentifier: "public-identifier-2",
+				},
+			},
+		},
+		{
+			name:        "UserNotFoundInTenant",
+			tenantID:    "tenant-1",
+			firebaseUID: "firebase-uid-1",
+			data: &dao.UpdateUserData{
+				PublicIdentifier: "public-identifier-2",
+			},
+			expectErr: dao.ErrUserNotFound,
 		},
 		{
 			name:        "UserNotFound",
@@ -60,10 +79,17 @@ func TestUpdateUser(t *testing.T) {
 			defer RollbackTX(tx)
 
 			repo := dao.NewUpdateUserRepository(tx)
-			user, err := repo.UpdateUser(context.TODO(), data.firebaseUID, data.data)
+			user, err := repo.UpdateUser(context.TODO(), data.tenantID, data.firebaseUID, data.data)
 
 			require.ErrorIs(t, err, data.expectErr)
 			require.Equal(t, data.expect, user)
+
+			expectEvents := make([]*entities.OutboxEvent, 0)
+			if data.expectEvent != nil {
+				expectEvents = append(expectEvents, data.expectEvent)
+			}
+
+			require.Equal(t, expectEvents, listOutboxEvents(tx))
 		})
 	}
 }
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+// insertUserAlias creates alias, and points the aliases of its alias UID to its canonical UID instead. It returns
+// the aliases that were pointed again.
+func insertUserAlias(ctx context.Context, tx bun.Tx, alias *entities.UserAlias) ([]*entities.UserAlias, error) {
+	// Concurrent links in opposite directions would otherwise both find no chain, and alias the users to each other.
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", "user_aliases/"+alias.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Otherwise, resolving a UID would take more than one lookup.
+	chained, err := tx.NewSelect().
+		Model((*entities.UserAlias)(nil)).
+		Where("tenant_id = ?", alias.TenantID).
+		Where("alias_uid = ?", alias.CanonicalUID).
+		Exists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if chained {
+		return nil, ErrUserAliasAlreadyExists
+	}
+
+	res, err := tx.NewInsert().
+		Model(alias).
+		On("CONFLICT (tenant_id, alias_uid) DO NOTHING").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrUserAliasAlreadyExists
+	}
+
+	repointed := make([]*entities.UserAlias, 0)
+
+	_, err = tx.NewUpdate().
+		Model(&repointed).
+		Set("canonical_uid = ?", alias.CanonicalUID).
+		Where("tenant_id = ?", alias.TenantID).
+		Where("canonical_uid = ?", alias.AliasUID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return repointed, nil
+}
@@ -0,0 +1,37 @@
+package dao_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"time"
+)
+
+var userAliasesFixtures = []interface{}{
+	&entities.User{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		PublicIdentifier: "public-identifier-1",
+		FirebaseUID:      "firebase-uid-1",
+	},
+	&entities.User{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		PublicIdentifier: "public-identifier-2",
+		FirebaseUID:      "firebase-uid-2",
+	},
+	&entities.User{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		PublicIdentifier: "public-identifier-3",
+		FirebaseUID:      "firebase-uid-3",
+	},
+	&entities.UserAlias{
+		AliasUID:     "firebase-uid-4",
+		CanonicalUID: "firebase-uid-3",
+		CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+	},
+	&entities.UserAlias{
+		TenantID:     "tenant-1",
+		AliasUID:     "firebase-uid-5",
+		CanonicalUID: "firebase-uid-1",
+		CreatedAt:    lo.ToPtr(time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)),
+	},
+}
@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+	"time"
+)
+
+// Payloads are written the way Postgres formats JSONB, so they can be compared after a round trip.
+var webhooksFixtures = []interface{}{
+	&entities.WebhookSubscription{
+		ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		URL:        "https://example.com/webhooks/1",
+		Secret:     "secret-1",
+		EventTypes: []string{entities.OutboxEventUserCreated, entities.OutboxEventUserUpdated},
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookSubscription{
+		ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		URL:        "https://example.com/webhooks/2",
+		Secret:     "secret-2",
+		EventTypes: []string{entities.OutboxEventUserDeleted},
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDelivery{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		EventID:        "1",
+		EventType:      entities.OutboxEventUserCreated,
+		Payload:        json.RawMessage(`{"id": "1"}`),
+		Status:         entities.WebhookDeliveryStatusPending,
+		NextAttemptAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDelivery{
+		// Waiting for a retry.
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		EventID:        "2",
+		EventType:      entities.OutboxEventUserUpdated,
+		Payload:        json.RawMessage(`{"id": "2"}`),
+		Status:         entities.WebhookDeliveryStatusPending,
+		Attempts:       1,
+		NextAttemptAt:  lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDelivery{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		EventID:        "3",
+		EventType:      entities.OutboxEventUserUpdated,
+		Payload:        json.RawMessage(`{"id": "3"}`),
+		Status:         entities.WebhookDeliveryStatusDelivered,
+		Attempts:       1,
+		NextAttemptAt:  lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+		DeliveredAt:    lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDelivery{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		EventID:        "4",
+		EventType:      entities.OutboxEventUserDeleted,
+		Payload:        json.RawMessage(`{"id": "4"}`),
+		Status:         entities.WebhookDeliveryStatusDead,
+		Attempts:       2,
+		NextAttemptAt:  lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDeliveryAttempt{
+		ID:         1,
+		DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		StatusCode: lo.ToPtr(200),
+		DurationMS: 10,
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDeliveryAttempt{
+		ID:         2,
+		DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		StatusCode: lo.ToPtr(500),
+		Error:      lo.ToPtr("receiver rejected the webhook with status 500"),
+		DurationMS: 10,
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.WebhookDeliveryAttempt{
+		ID:         3,
+		DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		Error:      lo.ToPtr("connection refused"),
+		DurationMS: 10,
+		CreatedAt:  lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+	},
+}
@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// AuditCheckpoint is a signed copy of the hash of an audit event. Since the signing key is not stored with the
+// audit trail, a checkpoint cannot be forged to match a rewritten chain. Checkpoints are numbered by a gapless
+// sequence, which is signed too, so deleting one is detected as well.
+type AuditCheckpoint struct {
+	bun.BaseModel `bun:"table:audit_checkpoints"`
+
+	ID int64 `bun:"id,pk,autoincrement"`
+
+	Sequence  int64  `bun:"sequence,notnull"`
+	EventID   int64  `bun:"event_id,notnull"`
+	EventHash string `bun:"event_hash,notnull"`
+	Signature string `bun:"signature,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditChange holds the value of a single field, before and after an audited operation.
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type AuditEvent struct {
+	bun.BaseModel `bun:"table:audit_events"`
+
+	ID int64 `bun:"id,pk,autoincrement"`
+
+	ActorUID   *string      `bun:"actor_uid"`
+	SubjectUID *string      `bun:"subject_uid"`
+	Target     *string      `bun:"target"`
+	Action     string       `bun:"action,notnull"`
+	Outcome    AuditOutcome `bun:"outcome,notnull"`
+	Reason     *string      `bun:"reason"`
+
+	PeerIP    *string `bun:"peer_ip"`
+	UserAgent *string `bun:"user_agent"`
+
+	Diff map[string]*AuditChange `bun:"diff,type:jsonb"`
+
+	// PreviousHash and Hash chain events together, so any edit breaks the chain from the edited event onward.
+	PreviousHash *string `bun:"previous_hash"`
+	Hash         *string `bun:"hash"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
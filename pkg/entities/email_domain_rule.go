@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type EmailDomainRuleKind string
+
+const (
+	EmailDomainRuleAllow EmailDomainRuleKind = "allow"
+	EmailDomainRuleBlock EmailDomainRuleKind = "block"
+)
+
+type EmailDomainRule struct {
+	bun.BaseModel `bun:"table:email_domain_rules"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	TenantID string              `bun:"tenant_id,notnull"`
+	Domain   string              `bun:"domain,notnull"`
+	Kind     EmailDomainRuleKind `bun:"kind,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type ImpersonationSession struct {
+	bun.BaseModel `bun:"table:impersonation_sessions"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	TenantID        string   `bun:"tenant_id,notnull"`
+	ImpersonatorUID string   `bun:"impersonator_uid,notnull"`
+	TargetUID       string   `bun:"target_uid,notnull"`
+	Reason          string   `bun:"reason,notnull"`
+	Scopes          []string `bun:"scopes,array"`
+
+	ExpiresAt *time.Time `bun:"expires_at,notnull"`
+	EndedAt   *time.Time `bun:"ended_at"`
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type MembershipRole string
+
+const (
+	MembershipRoleOwner  MembershipRole = "owner"
+	MembershipRoleAdmin  MembershipRole = "admin"
+	MembershipRoleMember MembershipRole = "member"
+)
+
+type Membership struct {
+	bun.BaseModel `bun:"table:memberships"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	OrganizationID uuid.UUID      `bun:"organization_id,type:uuid,notnull"`
+	FirebaseUID    string         `bun:"firebase_uid,notnull"`
+	Role           MembershipRole `bun:"role,notnull"`
+
+	// Organization is only loaded by repositories that explicitly join it.
+	Organization *Organization `bun:"rel:belongs-to,join:organization_id=id"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type Organization struct {
+	bun.BaseModel `bun:"table:organizations"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	Name       string `bun:"name,notnull"`
+	RequireMFA bool   `bun:"require_mfa,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type OrganizationInvitation struct {
+	bun.BaseModel `bun:"table:organization_invitations"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	OrganizationID uuid.UUID      `bun:"organization_id,type:uuid,notnull"`
+	Email          string         `bun:"email,notnull"`
+	Role           MembershipRole `bun:"role,notnull"`
+	CodeHash       string         `bun:"code_hash,unique,notnull"`
+	InvitedBy      string         `bun:"invited_by,notnull"`
+
+	// Organization is only loaded by repositories that explicitly join it.
+	Organization *Organization `bun:"rel:belongs-to,join:organization_id=id"`
+
+	ExpiresAt  *time.Time `bun:"expires_at,notnull"`
+	AcceptedAt *time.Time `bun:"accepted_at"`
+	AcceptedBy *string    `bun:"accepted_by"`
+	CreatedAt  *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+const (
+	OutboxEventUserCreated = "user.created"
+	OutboxEventUserUpdated = "user.updated"
+	OutboxEventUserDeleted = "user.deleted"
+	// OutboxEventUserMerged is emitted for each user merged into another one, in place of its deletion.
+	OutboxEventUserMerged = "user.merged"
+	// OutboxEventUserSuspiciousSignIn is emitted when a user signs in from an unusual device or location.
+	OutboxEventUserSuspiciousSignIn = "user.suspicious_sign_in"
+)
+
+// UserEventPayload is the state of the user after the event. For deletions, it is the last known state.
+type UserEventPayload struct {
+	TenantID         string `json:"tenantID"`
+	FirebaseUID      string `json:"firebaseUID"`
+	PublicIdentifier string `json:"publicIdentifier"`
+	// MergedUID is only set on merge events, with the UID of the user that was merged.
+	MergedUID string `json:"mergedUID,omitempty"`
+}
+
+type OutboxEvent struct {
+	bun.BaseModel `bun:"table:outbox_events"`
+
+	ID int64 `bun:"id,pk,autoincrement"`
+
+	// AggregateID is the Firebase UID of the user the event relates to.
+	AggregateID string            `bun:"aggregate_id,notnull"`
+	Type        string            `bun:"type,notnull"`
+	Payload     *UserEventPayload `bun:"payload,type:jsonb,notnull"`
+
+	Attempts      int        `bun:"attempts,notnull"`
+	NextAttemptAt *time.Time `bun:"next_attempt_at,nullzero,notnull,default:current_timestamp"`
+	LastError     *string    `bun:"last_error"`
+	PublishedAt   *time.Time `bun:"published_at"`
+	// DeadAt is set once the event failed too many times. It is never published.
+	DeadAt *time.Time `bun:"dead_at"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type PersonalAccessToken struct {
+	bun.BaseModel `bun:"table:personal_access_tokens"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	TenantID    string   `bun:"tenant_id,notnull"`
+	FirebaseUID string   `bun:"firebase_uid,notnull"`
+	Name        string   `bun:"name,notnull"`
+	TokenHash   string   `bun:"token_hash,unique,notnull"`
+	Scopes      []string `bun:"scopes,array"`
+
+	ExpiresAt  *time.Time `bun:"expires_at"`
+	LastUsedAt *time.Time `bun:"last_used_at"`
+	CreatedAt  *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
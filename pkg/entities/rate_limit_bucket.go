@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type RateLimitBucket struct {
+	bun.BaseModel `bun:"table:rate_limit_buckets"`
+
+	Key string `bun:"key,pk"`
+
+	Tokens float64 `bun:"tokens,notnull"`
+	// Allowed tells whether the last request taking a token from the bucket was allowed.
+	Allowed bool `bun:"allowed,notnull"`
+
+	UpdatedAt *time.Time `bun:"updated_at,notnull"`
+}
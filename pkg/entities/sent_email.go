@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type SentEmail struct {
+	bun.BaseModel `bun:"table:sent_emails"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	Recipient string `bun:"recipient,notnull"`
+	Kind      string `bun:"kind,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type SignInContext struct {
+	bun.BaseModel `bun:"table:sign_in_contexts"`
+
+	ID int64 `bun:"id,pk,autoincrement"`
+
+	TenantID    string `bun:"tenant_id,notnull"`
+	FirebaseUID string `bun:"firebase_uid,notnull"`
+	Device      string `bun:"device,notnull"`
+	Network     string `bun:"network,notnull"`
+
+	Country   *string  `bun:"country"`
+	Latitude  *float64 `bun:"latitude"`
+	Longitude *float64 `bun:"longitude"`
+
+	FirstSeenAt *time.Time `bun:"first_seen_at,notnull"`
+	LastSeenAt  *time.Time `bun:"last_seen_at,notnull"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UserActivity struct {
+	bun.BaseModel `bun:"table:user_activities"`
+
+	TenantID    string `bun:"tenant_id,pk"`
+	FirebaseUID string `bun:"firebase_uid,pk"`
+
+	LastSeenAt          *time.Time `bun:"last_seen_at,notnull"`
+	AuthenticationCount int64      `bun:"authentication_count,notnull"`
+	LastPeerIP          *string    `bun:"last_peer_ip"`
+	LastUserAgent       *string    `bun:"last_user_agent"`
+}
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UserAlias struct {
+	bun.BaseModel `bun:"table:user_aliases"`
+
+	TenantID string `bun:"tenant_id,pk"`
+	AliasUID string `bun:"alias_uid,pk"`
+
+	CanonicalUID string `bun:"canonical_uid,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
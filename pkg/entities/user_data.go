@@ -11,5 +11,6 @@ type User struct {
 	ID *uuid.UUID `bun:"id,pk,type:uuid"`
 
 	PublicIdentifier string `bun:"public_identifier,unique,notnull"`
-	FirebaseUID      string `bun:"firebase_uid,unique,notnull"`
+	TenantID         string `bun:"tenant_id,notnull"`
+	FirebaseUID      string `bun:"firebase_uid,notnull"`
 }
@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryStatusDead is set once a delivery runs out of attempts. It is only retried when replayed.
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "dead"
+)
+
+type WebhookSubscription struct {
+	bun.BaseModel `bun:"table:webhook_subscriptions"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	URL        string   `bun:"url,notnull"`
+	Secret     string   `bun:"secret,notnull"`
+	EventTypes []string `bun:"event_types,array,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type WebhookDelivery struct {
+	bun.BaseModel `bun:"table:webhook_deliveries"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	SubscriptionID uuid.UUID       `bun:"subscription_id,type:uuid,notnull"`
+	EventID        string          `bun:"event_id,notnull"`
+	EventType      string          `bun:"event_type,notnull"`
+	Payload        json.RawMessage `bun:"payload,type:jsonb,notnull"`
+
+	Status        WebhookDeliveryStatus `bun:"status,notnull,default:'pending'"`
+	Attempts      int                   `bun:"attempts,notnull"`
+	NextAttemptAt *time.Time            `bun:"next_attempt_at,nullzero,notnull,default:current_timestamp"`
+	DeliveredAt   *time.Time            `bun:"delivered_at"`
+
+	// Subscription and Log are only loaded by repositories that explicitly request them.
+	Subscription *WebhookSubscription      `bun:"rel:belongs-to,join:subscription_id=id"`
+	Log          []*WebhookDeliveryAttempt `bun:"rel:has-many,join:id=delivery_id"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type WebhookDeliveryAttempt struct {
+	bun.BaseModel `bun:"table:webhook_delivery_attempts"`
+
+	ID int64 `bun:"id,pk,autoincrement"`
+
+	DeliveryID uuid.UUID `bun:"delivery_id,type:uuid,notnull"`
+	StatusCode *int      `bun:"status_code"`
+	Error      *string   `bun:"error"`
+	DurationMS int64     `bun:"duration_ms,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -5,9 +5,13 @@ import (
 	"errors"
 	"github.com/in-rich/lib-go/monitor"
 	authentication_pb "github.com/in-rich/proto/proto-go/authentication"
+	"github.com/in-rich/uservice-authentication/pkg/models"
 	"github.com/in-rich/uservice-authentication/pkg/services"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"strings"
 )
 
 type AuthenticateHandler struct {
@@ -17,7 +21,10 @@ type AuthenticateHandler struct {
 }
 
 func (h *AuthenticateHandler) authenticate(ctx context.Context, in *authentication_pb.AuthenticateRequest) (*authentication_pb.User, error) {
-	user, err := h.service.Exec(ctx, in.Token)
+	user, err := h.service.Exec(ctx, &models.Authenticate{
+		Token:          in.Token,
+		OrganizationID: incomingHeader(ctx, HeaderOrganizationID),
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthenticated) {
 			return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate user: %v", err)
@@ -25,13 +32,70 @@ func (h *AuthenticateHandler) authenticate(ctx context.Context, in *authenticati
 		if errors.Is(err, services.ErrVerifyToken) {
 			return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate user: %v", err)
 		}
+		if errors.Is(err, services.ErrStepUpRequired) {
+			return nil, statusWithReason(codes.Unauthenticated, ReasonStepUpRequired, "failed to authenticate user: %v", err)
+		}
+		if errors.Is(err, services.ErrMFARequired) {
+			return nil, statusWithReason(codes.Unauthenticated, ReasonMFARequired, "failed to authenticate user: %v", err)
+		}
+		if errors.Is(err, services.ErrEmailVerificationGracePeriodExpired) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonEmailVerificationGracePeriodExpired, "failed to authenticate user: %v", err,
+			)
+		}
 		if errors.Is(err, services.ErrEmailNotVerified) {
-			return nil, status.Errorf(codes.PermissionDenied, "failed to authenticate user: %v", err)
+			return nil, statusWithReason(codes.PermissionDenied, ReasonEmailNotVerified, "failed to authenticate user: %v", err)
+		}
+		if errors.Is(err, services.ErrEmailDomainBlocked) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonEmailDomainBlocked, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrEmailDomainNotAllowed) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonEmailDomainNotAllowed, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrIdentityProviderRequired) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonIdentityProviderRequired, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrInvalidOrganizationID) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid organization id: %v", err)
+		}
+		if errors.Is(err, services.ErrNotOrganizationMember) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonNotOrganizationMember, "failed to authenticate user: %v", err,
+			)
 		}
 
 		return nil, status.Errorf(codes.Internal, "failed to authenticate user: %v", err)
 	}
 
+	// The response message has no room for the email verification decision, so it is sent as response headers.
+	if user.EmailVerification != nil {
+		_ = grpc.SetHeader(ctx, emailVerificationHeaders(user.EmailVerification))
+	}
+	if user.Membership != nil {
+		_ = grpc.SetHeader(ctx, membershipHeaders(user.Membership))
+	}
+	if user.TenantID != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderTenantID, user.TenantID))
+	}
+	if user.Scopes != nil {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderScopes, strings.Join(user.Scopes, " ")))
+	}
+	if user.Impersonator != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderImpersonator, user.Impersonator))
+	}
+	if user.MintedBy != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderMintedBy, user.MintedBy))
+	}
+	if user.IdentityProvider != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderIdentityProvider, user.IdentityProvider))
+	}
+
 	return &authentication_pb.User{
 		PublicIdentifier: user.PublicIdentifier,
 		FirebaseUid:      user.FirebaseUID,
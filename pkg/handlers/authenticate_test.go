@@ -9,9 +9,13 @@ import (
 	"github.com/in-rich/uservice-authentication/pkg/models"
 	"github.com/in-rich/uservice-authentication/pkg/services"
 	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"testing"
+	"time"
 )
 
 func TestAuthenticate(t *testing.T) {
@@ -23,8 +27,9 @@ func TestAuthenticate(t *testing.T) {
 		serviceResponse *models.User
 		serviceErr      error
 
-		expect     *authentication_pb.User
-		expectCode codes.Code
+		expect       *authentication_pb.User
+		expectCode   codes.Code
+		expectReason string
 	}{
 		{
 			name: "Authenticate",
@@ -63,8 +68,80 @@ func TestAuthenticate(t *testing.T) {
 			in: &authentication_pb.AuthenticateRequest{
 				Token: "foo-token",
 			},
-			serviceErr: services.ErrEmailNotVerified,
-			expectCode: codes.PermissionDenied,
+			serviceErr:   services.ErrEmailNotVerified,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailNotVerified,
+		},
+		{
+			name: "EmailVerificationGracePeriodExpired",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   errors.Join(services.ErrEmailNotVerified, services.ErrEmailVerificationGracePeriodExpired),
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailVerificationGracePeriodExpired,
+		},
+		{
+			name: "EmailDomainBlocked",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   services.ErrEmailDomainBlocked,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailDomainBlocked,
+		},
+		{
+			name: "EmailDomainNotAllowed",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   services.ErrEmailDomainNotAllowed,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailDomainNotAllowed,
+		},
+		{
+			name: "IdentityProviderRequired",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   services.ErrIdentityProviderRequired,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonIdentityProviderRequired,
+		},
+		{
+			name: "InvalidOrganizationID",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr: services.ErrInvalidOrganizationID,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "NotOrganizationMember",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   services.ErrNotOrganizationMember,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonNotOrganizationMember,
+		},
+		{
+			name: "StepUpRequired",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   services.ErrStepUpRequired,
+			expectCode:   codes.Unauthenticated,
+			expectReason: handlers.ReasonStepUpRequired,
+		},
+		{
+			name: "MFARequired",
+			in: &authentication_pb.AuthenticateRequest{
+				Token: "foo-token",
+			},
+			serviceErr:   services.ErrMFARequired,
+			expectCode:   codes.Unauthenticated,
+			expectReason: handlers.ReasonMFARequired,
 		},
 		{
 			name: "InternalError",
@@ -80,16 +157,236 @@ func TestAuthenticate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			service := servicesmocks.NewMockAuthenticateService(t)
 
-			service.On("Exec", context.TODO(), tt.in.Token).Return(tt.serviceResponse, tt.serviceErr)
+			service.On("Exec", context.TODO(), &models.Authenticate{Token: tt.in.Token}).Return(tt.serviceResponse, tt.serviceErr)
 
 			handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
 
 			resp, err := handler.Authenticate(context.TODO(), tt.in)
 
 			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			RequireGRPCReasonEqual(t, err, tt.expectReason)
 			require.Equal(t, tt.expect, resp)
 
 			service.AssertExpectations(t)
 		})
 	}
 }
+
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *fakeServerTransportStream) Method() string {
+	return "Authenticate"
+}
+
+func (s *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *fakeServerTransportStream) SetTrailer(_ metadata.MD) error {
+	return nil
+}
+
+func TestAuthenticateEmailVerificationHeaders(t *testing.T) {
+	deadline := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		emailVerification *models.EmailVerificationDecision
+
+		expect metadata.MD
+	}{
+		{
+			name: "Verified",
+			emailVerification: &models.EmailVerificationDecision{
+				Status: models.EmailVerificationStatusVerified,
+			},
+			expect: metadata.Pairs(handlers.HeaderEmailVerificationStatus, "verified"),
+		},
+		{
+			name: "Pending",
+			emailVerification: &models.EmailVerificationDecision{
+				Status:   models.EmailVerificationStatusPending,
+				Deadline: &deadline,
+			},
+			expect: metadata.Pairs(
+				handlers.HeaderEmailVerificationStatus, "pending",
+				handlers.HeaderEmailVerificationDeadline, "2021-01-01T00:00:00Z",
+			),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockAuthenticateService(t)
+			service.On("Exec", mock.Anything, &models.Authenticate{Token: "foo-token"}).Return(&models.User{
+				FirebaseUID:       "firebase-uid-1",
+				EmailVerification: tt.emailVerification,
+			}, nil)
+
+			stream := new(fakeServerTransportStream)
+			ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+
+			handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
+
+			_, err := handler.Authenticate(ctx, &authentication_pb.AuthenticateRequest{Token: "foo-token"})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, stream.header)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthenticateMembershipHeaders(t *testing.T) {
+	service := servicesmocks.NewMockAuthenticateService(t)
+	service.On("Exec", mock.Anything, &models.Authenticate{
+		Token:          "foo-token",
+		OrganizationID: "00000000-0000-0000-0000-000000000001",
+	}).Return(&models.User{
+		FirebaseUID: "firebase-uid-1",
+		Membership: &models.Membership{
+			Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+			FirebaseUID:  "firebase-uid-1",
+			Role:         models.MembershipRoleAdmin,
+		},
+	}, nil)
+
+	stream := new(fakeServerTransportStream)
+	ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		handlers.HeaderOrganizationID, "00000000-0000-0000-0000-000000000001",
+	))
+
+	handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
+
+	_, err := handler.Authenticate(ctx, &authentication_pb.AuthenticateRequest{Token: "foo-token"})
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Pairs(
+		handlers.HeaderOrganizationID, "00000000-0000-0000-0000-000000000001",
+		handlers.HeaderOrganizationRole, "admin",
+	), stream.header)
+
+	service.AssertExpectations(t)
+}
+
+func TestAuthenticateImpersonatorHeader(t *testing.T) {
+	service := servicesmocks.NewMockAuthenticateService(t)
+	service.On("Exec", mock.Anything, &models.Authenticate{Token: "foo-token"}).Return(&models.User{
+		FirebaseUID:  "firebase-uid-1",
+		Scopes:       models.ImpersonationScopes,
+		Impersonator: "staff-uid-1",
+	}, nil)
+
+	stream := new(fakeServerTransportStream)
+	ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+
+	handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
+
+	_, err := handler.Authenticate(ctx, &authentication_pb.AuthenticateRequest{Token: "foo-token"})
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Pairs(
+		handlers.HeaderScopes, "user:read",
+		handlers.HeaderImpersonator, "staff-uid-1",
+	), stream.header)
+
+	service.AssertExpectations(t)
+}
+
+func TestAuthenticateMintedByHeader(t *testing.T) {
+	service := servicesmocks.NewMockAuthenticateService(t)
+	service.On("Exec", mock.Anything, &models.Authenticate{Token: "foo-token"}).Return(&models.User{
+		FirebaseUID: "firebase-uid-1",
+		MintedBy:    "backend@project.iam.gserviceaccount.com",
+	}, nil)
+
+	stream := new(fakeServerTransportStream)
+	ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+
+	handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
+
+	_, err := handler.Authenticate(ctx, &authentication_pb.AuthenticateRequest{Token: "foo-token"})
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Pairs(
+		handlers.HeaderMintedBy, "backend@project.iam.gserviceaccount.com",
+	), stream.header)
+
+	service.AssertExpectations(t)
+}
+
+func TestAuthenticateScopesHeader(t *testing.T) {
+	testData := []struct {
+		name   string
+		scopes []string
+		expect metadata.MD
+	}{
+		{
+			name:   "FullAccess",
+			expect: nil,
+		},
+		{
+			name:   "PersonalAccessToken",
+			scopes: []string{models.ScopeUserRead, models.ScopeUserWrite},
+			expect: metadata.Pairs(handlers.HeaderScopes, "user:read user:write"),
+		},
+		{
+			// No scope is not the same as full access.
+			name:   "NoScopes",
+			scopes: []string{},
+			expect: metadata.Pairs(handlers.HeaderScopes, ""),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockAuthenticateService(t)
+			service.On("Exec", mock.Anything, &models.Authenticate{Token: "foo-token"}).Return(&models.User{
+				FirebaseUID: "firebase-uid-1",
+				Scopes:      tt.scopes,
+			}, nil)
+
+			stream := new(fakeServerTransportStream)
+			ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+
+			handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
+
+			_, err := handler.Authenticate(ctx, &authentication_pb.AuthenticateRequest{Token: "foo-token"})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, stream.header)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthenticateIdentityProviderHeader(t *testing.T) {
+	service := servicesmocks.NewMockAuthenticateService(t)
+	service.On("Exec", mock.Anything, &models.Authenticate{Token: "foo-token"}).Return(&models.User{
+		FirebaseUID:      "acme:subject-1",
+		IdentityProvider: "acme",
+	}, nil)
+
+	stream := new(fakeServerTransportStream)
+	ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+
+	handler := handlers.NewAuthenticateHandler(service, monitor.NewDummyGRPCLogger())
+
+	_, err := handler.Authenticate(ctx, &authentication_pb.AuthenticateRequest{Token: "foo-token"})
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Pairs(handlers.HeaderIdentityProvider, "acme"), stream.header)
+
+	service.AssertExpectations(t)
+}
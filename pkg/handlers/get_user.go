@@ -17,7 +17,7 @@ type GetUserHandler struct {
 }
 
 func (h *GetUserHandler) getUser(ctx context.Context, in *authentication_pb.GetUserRequest) (*authentication_pb.User, error) {
-	user, err := h.service.Exec(ctx, in.GetFirebaseUid())
+	user, err := h.service.Exec(ctx, incomingHeader(ctx, HeaderTenantID), in.GetFirebaseUid())
 	if err != nil {
 		if errors.Is(err, services.ErrUserNotFound) {
 			return nil, status.Errorf(codes.NotFound, "failed to get user: %v", err)
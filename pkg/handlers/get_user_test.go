@@ -64,7 +64,7 @@ func TestGetUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			service := servicesmocks.NewMockGetUserService(t)
 
-			service.On("Exec", context.TODO(), tt.in.FirebaseUid).Return(tt.serviceResponse, tt.serviceErr)
+			service.On("Exec", context.TODO(), "", tt.in.FirebaseUid).Return(tt.serviceResponse, tt.serviceErr)
 
 			handler := handlers.NewGetUserHandler(service, monitor.NewDummyGRPCLogger())
 
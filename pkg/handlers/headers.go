@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEmailVerificationStatus   = "x-email-verification-status"
+	HeaderEmailVerificationDeadline = "x-email-verification-deadline"
+
+	// HeaderOrganizationID is read from the request to select the active organization, and echoed in the response.
+	HeaderOrganizationID   = "x-organization-id"
+	HeaderOrganizationRole = "x-organization-role"
+
+	// HeaderTenantID is read from the request to scope user lookups to an Identity Platform tenant, and set on
+	// authentication responses for tenant users.
+	HeaderTenantID = "x-tenant-id"
+
+	// HeaderScopes is set on authentication responses with the space separated scopes of personal access tokens and
+	// impersonation sessions. Downstream services must restrict the user to these scopes whenever it is present, even
+	// when it is empty. It is not set when the user has full access.
+	HeaderScopes = "x-scopes"
+	// HeaderImpersonator is set on authentication responses with the UID of the staff member impersonating the user,
+	// so downstream services can refuse destructive actions.
+	HeaderImpersonator = "x-impersonator"
+	// HeaderMintedBy is set on authentication responses with the service account that minted the custom token the
+	// user signed in with, so downstream services can tell the user did not sign in themselves.
+	HeaderMintedBy = "x-minted-by"
+	// HeaderIdentityProvider is set on authentication responses with the name of the external identity provider the
+	// user signed in with. It is not set for Firebase users.
+	HeaderIdentityProvider = "x-identity-provider"
+)
+
+func incomingHeader(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// incomingServiceToken returns the identity token a backend service sent as a bearer token, if any.
+func incomingServiceToken(ctx context.Context) string {
+	token, found := strings.CutPrefix(incomingHeader(ctx, "authorization"), "Bearer ")
+	if !found {
+		return ""
+	}
+
+	return token
+}
+
+func emailVerificationHeaders(decision *models.EmailVerificationDecision) metadata.MD {
+	md := metadata.Pairs(HeaderEmailVerificationStatus, string(decision.Status))
+
+	if decision.Deadline != nil {
+		md.Set(HeaderEmailVerificationDeadline, decision.Deadline.UTC().Format(time.RFC3339))
+	}
+
+	return md
+}
+
+func membershipHeaders(membership *models.Membership) metadata.MD {
+	return metadata.Pairs(
+		HeaderOrganizationID, membership.Organization.ID,
+		HeaderOrganizationRole, string(membership.Role),
+	)
+}
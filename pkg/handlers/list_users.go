@@ -16,7 +16,7 @@ type ListUsersHandler struct {
 }
 
 func (h *ListUsersHandler) listUsers(ctx context.Context, in *authentication_pb.ListUsersRequest) (*authentication_pb.ListUsersResponse, error) {
-	users, err := h.service.Exec(ctx, in.GetFirebaseUids())
+	users, err := h.service.Exec(ctx, incomingHeader(ctx, HeaderTenantID), in.GetFirebaseUids())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
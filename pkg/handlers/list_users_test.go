@@ -71,7 +71,7 @@ func TestListUsers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			service := servicesmocks.NewMockListUsersService(t)
 
-			service.On("Exec", context.TODO(), tt.in.FirebaseUids).Return(tt.serviceResponse, tt.serviceErr)
+			service.On("Exec", context.TODO(), "", tt.in.FirebaseUids).Return(tt.serviceResponse, tt.serviceErr)
 
 			handler := handlers.NewListUsersHandler(service, monitor.NewDummyGRPCLogger())
 
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var rateLimitRejections, _ = otel.Meter("github.com/in-rich/uservice-authentication/pkg/handlers").Int64Counter(
+	"rate_limit.rejections",
+	metric.WithDescription("Number of calls rejected for exceeding a rate limit."),
+)
+
+type firebaseUIDResponse interface {
+	GetFirebaseUid() string
+}
+
+// NewRateLimitInterceptor rejects calls exceeding a rate limit with codes.ResourceExhausted. Client addresses and
+// calling services are limited before the call, so rejected calls cost no token verification. Users are only known
+// once their token is verified, so calls from a user over their limit are rejected after the fact.
+//
+// Client addresses are read behind trustedProxies proxies, see services.ClientIP. Calling services are identified by
+// the identity token they send as a bearer token. Calls without a valid one are only limited by address and user,
+// as are all calls when verifier is nil.
+//
+// The limits fail open: if the buckets cannot be reached, calls are allowed and the error is logged.
+func NewRateLimitInterceptor(
+	service services.CheckRateLimitService,
+	verifier clients.ServiceIdentityVerifier,
+	trustedProxies int,
+	logger monitor.GRPCLogger,
+) grpc.UnaryServerInterceptor {
+	check := func(ctx context.Context, method string, scope models.RateLimitScope, key string) error {
+		decision, err := service.Exec(ctx, &models.CheckRateLimit{Scope: scope, Key: key})
+		if err != nil {
+			logger.Error(err, "failed to check rate limit")
+			return nil
+		}
+		if decision.Allowed {
+			return nil
+		}
+
+		rateLimitRejections.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("method", method),
+			attribute.String("scope", string(scope)),
+		))
+
+		return statusWithRetry(
+			codes.ResourceExhausted, ReasonRateLimited, decision.RetryAfter, "rate limit exceeded for %s", scope,
+		)
+	}
+
+	callerService := func(ctx context.Context) string {
+		token := incomingServiceToken(ctx)
+		if verifier == nil || token == "" {
+			return ""
+		}
+
+		serviceAccount, err := verifier.VerifyServiceIdentity(ctx, token)
+		if err != nil {
+			return ""
+		}
+
+		return serviceAccount
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		clientIP := services.ClientIP(ctx, trustedProxies)
+		if err := check(ctx, info.FullMethod, models.RateLimitScopePeerIP, clientIP); err != nil {
+			return nil, err
+		}
+		if err := check(ctx, info.FullMethod, models.RateLimitScopeService, callerService(ctx)); err != nil {
+			return nil, err
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			return res, err
+		}
+
+		if user, ok := res.(firebaseUIDResponse); ok {
+			if err := check(ctx, info.FullMethod, models.RateLimitScopeUID, user.GetFirebaseUid()); err != nil {
+				return nil, err
+			}
+		}
+
+		return res, nil
+	}
+}
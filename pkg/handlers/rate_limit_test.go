@@ -0,0 +1,187 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	authentication_pb "github.com/in-rich/proto/proto-go/authentication"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/handlers"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	// The first entry is sent by the client, and must be ignored.
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "198.51.100.1, 203.0.113.1, 10.0.0.1",
+		"authorization", "Bearer service-token",
+	))
+
+	allowed := &models.RateLimitDecision{Allowed: true}
+	rejected := &models.RateLimitDecision{RetryAfter: 3 * time.Second}
+
+	testData := []struct {
+		name string
+
+		verifyServiceIdentityErr error
+
+		peerIPDecision  *models.RateLimitDecision
+		serviceKey      string
+		serviceDecision *models.RateLimitDecision
+		uidDecision     *models.RateLimitDecision
+		checkErr        error
+
+		shouldCallHandler bool
+		handlerErr        error
+
+		expect           *authentication_pb.User
+		expectCode       codes.Code
+		expectRetryAfter time.Duration
+	}{
+		{
+			name:              "Allowed",
+			peerIPDecision:    allowed,
+			serviceKey:        "uservice-foo@project.iam.gserviceaccount.com",
+			serviceDecision:   allowed,
+			uidDecision:       allowed,
+			shouldCallHandler: true,
+			expect:            &authentication_pb.User{FirebaseUid: "firebase-uid-1"},
+		},
+		{
+			name:             "PeerIPRejected",
+			peerIPDecision:   rejected,
+			expectCode:       codes.ResourceExhausted,
+			expectRetryAfter: 3 * time.Second,
+		},
+		{
+			name:             "ServiceRejected",
+			peerIPDecision:   allowed,
+			serviceKey:       "uservice-foo@project.iam.gserviceaccount.com",
+			serviceDecision:  rejected,
+			expectCode:       codes.ResourceExhausted,
+			expectRetryAfter: 3 * time.Second,
+		},
+		{
+			name:              "UIDRejected",
+			peerIPDecision:    allowed,
+			serviceKey:        "uservice-foo@project.iam.gserviceaccount.com",
+			serviceDecision:   allowed,
+			uidDecision:       rejected,
+			shouldCallHandler: true,
+			expectCode:        codes.ResourceExhausted,
+			expectRetryAfter:  3 * time.Second,
+		},
+		{
+			name:              "HandlerError",
+			peerIPDecision:    allowed,
+			serviceKey:        "uservice-foo@project.iam.gserviceaccount.com",
+			serviceDecision:   allowed,
+			shouldCallHandler: true,
+			handlerErr:        status.Error(codes.Unauthenticated, "failed to authenticate user"),
+			expectCode:        codes.Unauthenticated,
+		},
+		{
+			// Callers that cannot prove their identity share no service bucket, and are only limited by address and
+			// user.
+			name:                     "UnverifiedService",
+			verifyServiceIdentityErr: errors.New("invalid token"),
+			peerIPDecision:           allowed,
+			serviceDecision:          allowed,
+			uidDecision:              allowed,
+			shouldCallHandler:        true,
+			expect:                   &authentication_pb.User{FirebaseUid: "firebase-uid-1"},
+		},
+		{
+			// Calls are allowed when the buckets cannot be reached.
+			name:              "CheckError",
+			serviceKey:        "uservice-foo@project.iam.gserviceaccount.com",
+			checkErr:          errors.New("connection refused"),
+			shouldCallHandler: true,
+			expect:            &authentication_pb.User{FirebaseUid: "firebase-uid-1"},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			service := servicesmocks.NewMockCheckRateLimitService(t)
+			verifier := clientsmocks.NewMockServiceIdentityVerifier(t)
+
+			verifier.On("VerifyServiceIdentity", ctx, "service-token").
+				Return(data.serviceKey, data.verifyServiceIdentityErr).
+				Maybe()
+
+			serviceCheck := &models.CheckRateLimit{Scope: models.RateLimitScopeService, Key: data.serviceKey}
+
+			if data.checkErr != nil {
+				service.On("Exec", ctx, &models.CheckRateLimit{Scope: models.RateLimitScopePeerIP, Key: "203.0.113.1"}).
+					Return(nil, data.checkErr)
+				service.On("Exec", ctx, serviceCheck).
+					Return(nil, data.checkErr)
+				service.On("Exec", ctx, &models.CheckRateLimit{Scope: models.RateLimitScopeUID, Key: "firebase-uid-1"}).
+					Return(nil, data.checkErr)
+			}
+			if data.peerIPDecision != nil {
+				service.On("Exec", ctx, &models.CheckRateLimit{Scope: models.RateLimitScopePeerIP, Key: "203.0.113.1"}).
+					Return(data.peerIPDecision, nil)
+			}
+			if data.serviceDecision != nil {
+				service.On("Exec", ctx, serviceCheck).
+					Return(data.serviceDecision, nil)
+			}
+			if data.uidDecision != nil {
+				service.On("Exec", ctx, &models.CheckRateLimit{Scope: models.RateLimitScopeUID, Key: "firebase-uid-1"}).
+					Return(data.uidDecision, nil)
+			}
+
+			handlerCalled := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				handlerCalled = true
+				if data.handlerErr != nil {
+					return nil, data.handlerErr
+				}
+
+				return &authentication_pb.User{FirebaseUid: "firebase-uid-1"}, nil
+			}
+
+			interceptor := handlers.NewRateLimitInterceptor(service, verifier, 2, monitor.NewDummyGRPCLogger())
+
+			res, err := interceptor(
+				ctx,
+				&authentication_pb.AuthenticateRequest{Token: "foo-token"},
+				&grpc.UnaryServerInfo{FullMethod: "/authentication.Authenticate/Authenticate"},
+				handler,
+			)
+
+			RequireGRPCCodesEqual(t, err, data.expectCode)
+			require.Equal(t, data.shouldCallHandler, handlerCalled)
+
+			if data.expect != nil {
+				require.Equal(t, data.expect, res)
+			}
+
+			if data.expectRetryAfter > 0 {
+				RequireGRPCReasonEqual(t, err, handlers.ReasonRateLimited)
+
+				st, _ := status.FromError(err)
+				retryInfo, found := lo.Find(st.Details(), func(detail any) bool {
+					_, ok := detail.(*errdetails.RetryInfo)
+					return ok
+				})
+				require.True(t, found)
+				require.Equal(t, data.expectRetryAfter, retryInfo.(*errdetails.RetryInfo).GetRetryDelay().AsDuration())
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
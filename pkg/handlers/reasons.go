@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+// Reasons are attached to errors as an errdetails.ErrorInfo, so clients can tell apart errors sharing the same code.
+const (
+	ReasonEmailNotVerified                    = "email_not_verified"
+	ReasonEmailVerificationGracePeriodExpired = "email_verification_grace_period_expired"
+	ReasonEmailDomainBlocked                  = "email_domain_blocked"
+	ReasonEmailDomainNotAllowed               = "email_domain_not_allowed"
+	ReasonNotOrganizationMember               = "not_organization_member"
+	// ReasonStepUpRequired asks the client to sign the user in again, since the sign-in looks suspicious.
+	ReasonStepUpRequired = "step_up_required"
+	// ReasonMFARequired asks the client to sign the user in with a second factor, enrolling one first if needed.
+	ReasonMFARequired = "mfa_required"
+	// ReasonReauthenticationRequired asks the client to sign the user in again before a sensitive operation, since
+	// their last sign-in is too old.
+	ReasonReauthenticationRequired = "reauthentication_required"
+	ReasonRateLimited              = "rate_limited"
+	// ReasonIdentityProviderRequired asks the client to sign the user in with the identity provider of their
+	// organization rather than Firebase.
+	ReasonIdentityProviderRequired = "identity_provider_required"
+)
+
+const errorInfoDomain = "uservice-authentication"
+
+func statusWithReason(code codes.Code, reason string, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorInfoDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// statusWithRetry also tells clients how long to wait before retrying, as an errdetails.RetryInfo.
+func statusWithRetry(code codes.Code, reason string, retryAfter time.Duration, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: reason,
+			Domain: errorInfoDomain,
+		},
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryAfter),
+		},
+	)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
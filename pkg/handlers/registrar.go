@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"google.golang.org/grpc"
+)
+
+// interceptedRegistrar applies an interceptor to the services registered through it. The server is created by
+// deploy.StartGRPCServer, which takes no server options, so interceptors are applied to each service instead.
+type interceptedRegistrar struct {
+	grpc.ServiceRegistrar
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (r *interceptedRegistrar) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	intercepted := *desc
+	intercepted.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+
+	for i, method := range desc.Methods {
+		handler := method.Handler
+
+		intercepted.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(
+				srv any, ctx context.Context, dec func(any) error, serverInterceptor grpc.UnaryServerInterceptor,
+			) (any, error) {
+				if serverInterceptor == nil {
+					return handler(srv, ctx, dec, r.interceptor)
+				}
+
+				// The interceptor of the server, if any, still runs after this one.
+				return handler(srv, ctx, dec, func(
+					ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler,
+				) (any, error) {
+					return r.interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+						return serverInterceptor(ctx, req, info, next)
+					})
+				})
+			},
+		}
+	}
+
+	r.ServiceRegistrar.RegisterService(&intercepted, impl)
+}
+
+// NewInterceptedRegistrar returns a registrar applying interceptor to the unary methods of the services registered
+// through it.
+func NewInterceptedRegistrar(registrar grpc.ServiceRegistrar, interceptor grpc.UnaryServerInterceptor) grpc.ServiceRegistrar {
+	return &interceptedRegistrar{
+		ServiceRegistrar: registrar,
+		interceptor:      interceptor,
+	}
+}
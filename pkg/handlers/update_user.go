@@ -29,8 +29,42 @@ func (h *UpdateUserHandler) updateUser(ctx context.Context, in *authentication_p
 		if errors.Is(err, services.ErrVerifyToken) {
 			return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate user: %v", err)
 		}
+		if errors.Is(err, services.ErrReauthenticationRequired) {
+			return nil, statusWithReason(
+				codes.Unauthenticated, ReasonReauthenticationRequired, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrStepUpRequired) {
+			return nil, statusWithReason(codes.Unauthenticated, ReasonStepUpRequired, "failed to authenticate user: %v", err)
+		}
+		if errors.Is(err, services.ErrMFARequired) {
+			return nil, statusWithReason(codes.Unauthenticated, ReasonMFARequired, "failed to authenticate user: %v", err)
+		}
+		if errors.Is(err, services.ErrEmailVerificationGracePeriodExpired) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonEmailVerificationGracePeriodExpired, "failed to authenticate user: %v", err,
+			)
+		}
 		if errors.Is(err, services.ErrEmailNotVerified) {
-			return nil, status.Errorf(codes.PermissionDenied, "failed to authenticate user: %v", err)
+			return nil, statusWithReason(codes.PermissionDenied, ReasonEmailNotVerified, "failed to authenticate user: %v", err)
+		}
+		if errors.Is(err, services.ErrEmailDomainBlocked) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonEmailDomainBlocked, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrEmailDomainNotAllowed) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonEmailDomainNotAllowed, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrIdentityProviderRequired) {
+			return nil, statusWithReason(
+				codes.PermissionDenied, ReasonIdentityProviderRequired, "failed to authenticate user: %v", err,
+			)
+		}
+		if errors.Is(err, services.ErrInsufficientScope) {
+			return nil, status.Errorf(codes.PermissionDenied, "failed to update user: %v", err)
 		}
 		if errors.Is(err, services.ErrInvalidUpdateUser) {
 			return nil, status.Errorf(codes.InvalidArgument, "failed to update user: %v", err)
@@ -23,8 +23,9 @@ func TestUpdateUser(t *testing.T) {
 		serviceResponse *models.User
 		serviceErr      error
 
-		expect     *authentication_pb.User
-		expectCode codes.Code
+		expect       *authentication_pb.User
+		expectCode   codes.Code
+		expectReason string
 	}{
 		{
 			name: "UpdateUser",
@@ -58,8 +59,19 @@ func TestUpdateUser(t *testing.T) {
 				Token:            "foo-token",
 				PublicIdentifier: "public-identifier-2",
 			},
-			serviceErr: services.ErrEmailNotVerified,
-			expectCode: codes.PermissionDenied,
+			serviceErr:   services.ErrEmailNotVerified,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailNotVerified,
+		},
+		{
+			name: "EmailVerificationGracePeriodExpired",
+			in: &authentication_pb.UpdateUserRequest{
+				Token:            "foo-token",
+				PublicIdentifier: "public-identifier-2",
+			},
+			serviceErr:   errors.Join(services.ErrEmailNotVerified, services.ErrEmailVerificationGracePeriodExpired),
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailVerificationGracePeriodExpired,
 		},
 		{
 			name: "VerifyToken",
@@ -79,6 +91,25 @@ func TestUpdateUser(t *testing.T) {
 			serviceErr: services.ErrUnauthenticated,
 			expectCode: codes.Unauthenticated,
 		},
+		{
+			name: "ReauthenticationRequired",
+			in: &authentication_pb.UpdateUserRequest{
+				Token:            "foo-token",
+				PublicIdentifier: "public-identifier-2",
+			},
+			serviceErr:   services.ErrReauthenticationRequired,
+			expectCode:   codes.Unauthenticated,
+			expectReason: handlers.ReasonReauthenticationRequired,
+		},
+		{
+			name: "InsufficientScope",
+			in: &authentication_pb.UpdateUserRequest{
+				Token:            "foo-token",
+				PublicIdentifier: "public-identifier-2",
+			},
+			serviceErr: services.ErrInsufficientScope,
+			expectCode: codes.PermissionDenied,
+		},
 		{
 			name: "InvalidUpdateUser",
 			in: &authentication_pb.UpdateUserRequest{
@@ -88,6 +119,36 @@ func TestUpdateUser(t *testing.T) {
 			serviceErr: services.ErrInvalidUpdateUser,
 			expectCode: codes.InvalidArgument,
 		},
+		{
+			name: "EmailDomainBlocked",
+			in: &authentication_pb.UpdateUserRequest{
+				Token:            "foo-token",
+				PublicIdentifier: "public-identifier-2",
+			},
+			serviceErr:   services.ErrEmailDomainBlocked,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailDomainBlocked,
+		},
+		{
+			name: "EmailDomainNotAllowed",
+			in: &authentication_pb.UpdateUserRequest{
+				Token:            "foo-token",
+				PublicIdentifier: "public-identifier-2",
+			},
+			serviceErr:   services.ErrEmailDomainNotAllowed,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonEmailDomainNotAllowed,
+		},
+		{
+			name: "IdentityProviderRequired",
+			in: &authentication_pb.UpdateUserRequest{
+				Token:            "foo-token",
+				PublicIdentifier: "public-identifier-2",
+			},
+			serviceErr:   services.ErrIdentityProviderRequired,
+			expectCode:   codes.PermissionDenied,
+			expectReason: handlers.ReasonIdentityProviderRequired,
+		},
 		{
 			name: "InternalError",
 			in: &authentication_pb.UpdateUserRequest{
@@ -112,6 +173,7 @@ func TestUpdateUser(t *testing.T) {
 			resp, err := handler.UpdateUser(context.TODO(), tt.in)
 
 			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			RequireGRPCReasonEqual(t, err, tt.expectReason)
 			require.Equal(t, tt.expect, resp)
 
 			service.AssertExpectations(t)
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"testing"
@@ -17,3 +18,21 @@ func RequireGRPCCodesEqual(t *testing.T, err error, code codes.Code) {
 		require.NoError(t, err)
 	}
 }
+
+func RequireGRPCReasonEqual(t *testing.T, err error, reason string) {
+	if reason == "" {
+		return
+	}
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			require.Equal(t, reason, info.GetReason())
+			return
+		}
+	}
+
+	t.Fatalf("expected error to have reason %q, got none", reason)
+}
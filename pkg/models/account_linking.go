@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+type LinkAccounts struct {
+	// PrimaryToken is a credential of the account that is kept. Its public identifier is kept too, if it has one.
+	PrimaryToken string `json:"primaryToken" validate:"required"`
+	// SecondaryToken is a credential of the account that becomes an alias of the primary one.
+	SecondaryToken string `json:"secondaryToken" validate:"required"`
+}
+
+type LinkedAccounts struct {
+	TenantID     string `json:"tenantID,omitempty"`
+	CanonicalUID string `json:"canonicalUID"`
+	AliasUID     string `json:"aliasUID"`
+}
+
+// LinkCandidate is another account of the same person, found by their verified email.
+type LinkCandidate struct {
+	FirebaseUID string `json:"firebaseUID"`
+	Email       string `json:"email"`
+	// Providers are the sign-in methods of the account, such as "password" or "google.com".
+	Providers []string `json:"providers"`
+}
+
+type AccountLinkingConfig struct {
+	// MaxAuthAge is how recently the user must have signed in with both accounts.
+	MaxAuthAge time.Duration
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type UserActivity struct {
+	LastSeenAt          *time.Time `json:"lastSeenAt"`
+	AuthenticationCount int64      `json:"authenticationCount"`
+	LastPeerIP          string     `json:"lastPeerIP,omitempty"`
+	LastUserAgent       string     `json:"lastUserAgent,omitempty"`
+}
+
+// TrackUserActivity describes a successful authentication. Request metadata is read from the incoming context.
+type TrackUserActivity struct {
+	TenantID    string
+	FirebaseUID string
+}
+
+type CountActiveUsers struct {
+	TenantID string `json:"tenantID"`
+	// Window is how far back a user must have authenticated to count as active.
+	Window time.Duration `json:"window" validate:"gt=0"`
+}
@@ -0,0 +1,116 @@
+package models
+
+import "time"
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+const (
+	AuditActionAuthenticate     = "authenticate"
+	AuditActionSuspiciousSignIn = "security.suspicious_sign_in"
+	AuditActionUpdateUser       = "user.update"
+	AuditActionDeleteUser       = "user.delete"
+	AuditActionLinkAccounts     = "user.link"
+	AuditActionMergeUsers       = "user.merge"
+
+	AuditActionCreateSessionCookie = "session_cookie.create"
+	AuditActionMintCustomToken     = "custom_token.mint"
+
+	AuditActionStartImpersonation = "impersonation.start"
+	AuditActionEndImpersonation   = "impersonation.end"
+
+	AuditActionCreatePersonalAccessToken = "personal_access_token.create"
+	AuditActionRevokePersonalAccessToken = "personal_access_token.revoke"
+
+	AuditActionUpdateOrganization = "organization.update"
+
+	AuditActionAddOrganizationMember    = "organization.member.add"
+	AuditActionRemoveOrganizationMember = "organization.member.remove"
+
+	AuditActionInviteOrganizationMember = "organization.invitation.create"
+	AuditActionRevokeInvitation         = "organization.invitation.revoke"
+	AuditActionResendInvitation         = "organization.invitation.resend"
+	AuditActionAcceptInvitation         = "organization.invitation.accept"
+)
+
+// AuditChange holds the value of a single field, before and after an audited operation.
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type AuditEvent struct {
+	ID         string                  `json:"id"`
+	ActorUID   string                  `json:"actorUID,omitempty"`
+	SubjectUID string                  `json:"subjectUID,omitempty"`
+	Target     string                  `json:"target,omitempty"`
+	Action     string                  `json:"action"`
+	Outcome    AuditOutcome            `json:"outcome"`
+	Reason     string                  `json:"reason,omitempty"`
+	PeerIP     string                  `json:"peerIP,omitempty"`
+	UserAgent  string                  `json:"userAgent,omitempty"`
+	Diff       map[string]*AuditChange `json:"diff,omitempty"`
+	CreatedAt  *time.Time              `json:"createdAt"`
+}
+
+// RecordAuditEvent describes an audited operation. Request metadata is read from the incoming context.
+type RecordAuditEvent struct {
+	ActorUID   string
+	SubjectUID string
+	// Target is the resource the action applied to, when it is not the subject itself.
+	Target  string
+	Action  string
+	Outcome AuditOutcome
+	Reason  string
+	Diff    map[string]*AuditChange
+}
+
+type ListAuditEvents struct {
+	ActorUID   string `json:"actorUID"`
+	SubjectUID string `json:"subjectUID"`
+	Action     string `json:"action"`
+	PageSize   int    `json:"pageSize" validate:"min=0,max=100"`
+	PageToken  string `json:"pageToken"`
+}
+
+type AuditEventsPage struct {
+	Events []*AuditEvent `json:"events"`
+	// NextPageToken is empty when there are no more events.
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type AuditCheckpoint struct {
+	Sequence  int64      `json:"sequence"`
+	EventID   string     `json:"eventID"`
+	EventHash string     `json:"eventHash"`
+	CreatedAt *time.Time `json:"createdAt"`
+}
+
+type AuditChainBreakReason string
+
+const (
+	AuditChainBreakHashMismatch         AuditChainBreakReason = "hash_mismatch"
+	AuditChainBreakPreviousHashMismatch AuditChainBreakReason = "previous_hash_mismatch"
+	AuditChainBreakMissingHash          AuditChainBreakReason = "missing_hash"
+
+	AuditChainBreakCheckpointSignatureInvalid AuditChainBreakReason = "checkpoint_signature_invalid"
+	AuditChainBreakCheckpointHashMismatch     AuditChainBreakReason = "checkpoint_hash_mismatch"
+	AuditChainBreakCheckpointEventMissing     AuditChainBreakReason = "checkpoint_event_missing"
+	AuditChainBreakCheckpointMissing          AuditChainBreakReason = "checkpoint_missing"
+)
+
+type AuditChainBreak struct {
+	EventID string                `json:"eventID"`
+	Reason  AuditChainBreakReason `json:"reason"`
+}
+
+type AuditChainReport struct {
+	EventsChecked      int `json:"eventsChecked"`
+	CheckpointsChecked int `json:"checkpointsChecked"`
+	// FirstBrokenLink is nil when the chain is intact.
+	FirstBrokenLink *AuditChainBreak `json:"firstBrokenLink,omitempty"`
+}
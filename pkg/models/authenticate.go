@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type Authenticate struct {
+	Token string
+	// OrganizationID optionally selects the organization the user is acting for. When set, the user must be a member
+	// of this organization, and their membership is returned alongside the user.
+	OrganizationID string
+	// MaxAuthAge optionally requires the user to have signed in recently, for sensitive operations. Personal access
+	// tokens are not tied to a sign-in, and are always rejected when it is set.
+	MaxAuthAge time.Duration
+}
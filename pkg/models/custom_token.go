@@ -0,0 +1,33 @@
+package models
+
+type MintCustomToken struct {
+	// ServiceToken is the identity token of the calling service.
+	ServiceToken string `json:"-"`
+	// TenantID is the Identity Platform tenant of the user, or empty for project-level users.
+	TenantID    string `json:"tenantID" validate:"max=255"`
+	FirebaseUID string `json:"firebaseUID" validate:"required,max=128"`
+	// Claims are developer claims added to the token, and to the ID tokens it is exchanged for.
+	Claims map[string]interface{} `json:"claims"`
+}
+
+type MintedCustomToken struct {
+	Token string `json:"token"`
+}
+
+// CustomTokenAnyUser allows a service to mint tokens for every user of a tenant.
+const CustomTokenAnyUser = "*"
+
+// CustomTokenGrant allows a service to mint custom tokens for some users.
+type CustomTokenGrant struct {
+	// Service is the email of the service account of the caller.
+	Service  string
+	TenantID string
+	// FirebaseUIDs lists the users the service may sign in as, or CustomTokenAnyUser.
+	FirebaseUIDs []string
+	// Claims lists the developer claims the service may set.
+	Claims []string
+}
+
+type CustomTokenConfig struct {
+	Grants []CustomTokenGrant
+}
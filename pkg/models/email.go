@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+const (
+	EmailKindEmailVerification = "email_verification"
+	EmailKindPasswordReset     = "password_reset"
+
+	EmailKindOrganizationInvitation = "organization_invitation"
+)
+
+// EmailThrottle limits how many emails of the same kind a recipient can receive within Window.
+type EmailThrottle struct {
+	Window time.Duration
+	Max    int
+}
+
+type SendEmail struct {
+	Kind   string
+	To     string
+	Locale string
+	Data   *EmailLinkData
+}
+
+// EmailLinkData is passed to email templates.
+type EmailLinkData struct {
+	Email       string
+	DisplayName string
+	Link        string
+	// OrganizationName is only set for organization invitations.
+	OrganizationName string
+}
@@ -0,0 +1,9 @@
+package models
+
+type CheckEmailDomain struct {
+	Email string
+	// EmailVerified is required for the email to match an allow rule. Anyone can claim an address they do not own
+	// until it is verified.
+	EmailVerified bool
+	TenantID      string
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+type EmailVerificationPolicyKind string
+
+const (
+	// EmailVerificationPolicyRequired rejects users until they verify their email.
+	EmailVerificationPolicyRequired EmailVerificationPolicyKind = "required"
+	// EmailVerificationPolicyGracePeriod lets new users in without verifying their email, for a limited time after
+	// their account was created.
+	EmailVerificationPolicyGracePeriod EmailVerificationPolicyKind = "grace-period"
+	// EmailVerificationPolicyExempt never requires users to verify their email.
+	EmailVerificationPolicyExempt EmailVerificationPolicyKind = "exempt"
+)
+
+type EmailVerificationPolicy struct {
+	Default     EmailVerificationPolicyKind
+	GracePeriod time.Duration
+	// Providers overrides the default policy for the given sign-in providers. Keys are either an exact provider id
+	// (phone, google.com), or a prefix ending with ".*" (saml.*).
+	Providers map[string]EmailVerificationPolicyKind
+}
+
+type CheckEmailVerification struct {
+	EmailVerified  bool
+	SignInProvider string
+	CreatedAt      time.Time
+}
+
+type EmailVerificationStatus string
+
+const (
+	EmailVerificationStatusVerified EmailVerificationStatus = "verified"
+	EmailVerificationStatusExempt   EmailVerificationStatus = "exempt"
+	// EmailVerificationStatusPending means the user is let in, but must verify their email before Deadline.
+	EmailVerificationStatusPending EmailVerificationStatus = "pending"
+)
+
+type EmailVerificationDecision struct {
+	Status   EmailVerificationStatus `json:"status"`
+	Deadline *time.Time              `json:"deadline,omitempty"`
+}
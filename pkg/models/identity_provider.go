@@ -0,0 +1,11 @@
+package models
+
+// IdentityProviderConfig routes some users to an external identity provider instead of Firebase.
+type IdentityProviderConfig struct {
+	// Name prefixes the UID of the users of the provider, so they cannot collide with Firebase users.
+	Name string
+	// TenantID is the tenant the users of the provider belong to. When set, Firebase users of this tenant are refused.
+	TenantID string
+	// EmailDomains restricts the provider to users of these domains. Firebase users of these domains are refused.
+	EmailDomains []string
+}
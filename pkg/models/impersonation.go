@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// ImpersonationScopes are granted to every impersonation session, so staff can look but not touch.
+var ImpersonationScopes = []string{ScopeUserRead}
+
+type Impersonate struct {
+	// TenantID is the Identity Platform tenant of the impersonated user, or empty for project-level users.
+	TenantID  string `json:"tenantID" validate:"max=255"`
+	TargetUID string `json:"targetUID" validate:"required,max=255"`
+	// Reason is kept in the audit log, and should point to the support ticket being worked on.
+	Reason string `json:"reason" validate:"required,max=1024"`
+}
+
+type ImpersonationSession struct {
+	ID              string     `json:"id"`
+	TenantID        string     `json:"tenantID,omitempty"`
+	ImpersonatorUID string     `json:"impersonatorUID"`
+	TargetUID       string     `json:"targetUID"`
+	Reason          string     `json:"reason"`
+	Scopes          []string   `json:"scopes"`
+	ExpiresAt       *time.Time `json:"expiresAt"`
+	EndedAt         *time.Time `json:"endedAt,omitempty"`
+	CreatedAt       *time.Time `json:"createdAt"`
+}
+
+// StartedImpersonationSession is only returned once, when the session starts. The custom token is exchanged by the
+// client for an ID token of the impersonated user.
+type StartedImpersonationSession struct {
+	ImpersonationSession
+	CustomToken string `json:"customToken"`
+}
+
+type ImpersonationConfig struct {
+	// TTL is how long a session lasts, unless it is ended earlier.
+	TTL time.Duration
+	// MaxAuthAge is how recently staff must have signed in to start a session.
+	MaxAuthAge time.Duration
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+type Invitation struct {
+	ID             string         `json:"id"`
+	OrganizationID string         `json:"organizationID"`
+	Email          string         `json:"email"`
+	Role           MembershipRole `json:"role"`
+	InvitedBy      string         `json:"invitedBy"`
+	ExpiresAt      *time.Time     `json:"expiresAt"`
+	CreatedAt      *time.Time     `json:"createdAt"`
+}
+
+type InviteOrganizationMember struct {
+	OrganizationID string         `json:"organizationID" validate:"required,uuid"`
+	Email          string         `json:"email" validate:"required,email,max=255"`
+	Role           MembershipRole `json:"role" validate:"required,oneof=owner admin member"`
+	Locale         string         `json:"locale"`
+}
+
+type ResendInvitation struct {
+	OrganizationID string `json:"organizationID" validate:"required,uuid"`
+	InvitationID   string `json:"invitationID" validate:"required,uuid"`
+	Locale         string `json:"locale"`
+}
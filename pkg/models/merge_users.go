@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// MergePrecedence decides which account data is kept from, when several merged accounts have some.
+type MergePrecedence string
+
+const (
+	// MergePrecedenceSurvivor prefers the data of the survivor, then of the merged users in the given order.
+	MergePrecedenceSurvivor MergePrecedence = "survivor"
+	// MergePrecedenceOldest prefers the data of the account created first.
+	MergePrecedenceOldest MergePrecedence = "oldest"
+	// MergePrecedenceNewest prefers the data of the account created last.
+	MergePrecedenceNewest MergePrecedence = "newest"
+)
+
+type MergeUsers struct {
+	// TenantID is the Identity Platform tenant of the users, or empty for project-level users.
+	TenantID string `json:"tenantID" validate:"max=255"`
+	// SurvivorUID is the account that is kept. The merged users become aliases of it.
+	SurvivorUID string   `json:"survivorUID" validate:"required,max=255"`
+	MergedUIDs  []string `json:"mergedUIDs" validate:"required,min=1,max=50,dive,required,max=255"`
+	// Precedence defaults to the configured one.
+	Precedence MergePrecedence `json:"precedence" validate:"omitempty,oneof=survivor oldest newest"`
+	// Reason is kept in the audit log, and should point to the support ticket being worked on.
+	Reason string `json:"reason" validate:"required,max=1024"`
+	// DryRun computes the merge without applying it.
+	DryRun bool `json:"dryRun"`
+}
+
+type UsersMerge struct {
+	TenantID    string `json:"tenantID,omitempty"`
+	SurvivorUID string `json:"survivorUID"`
+	DryRun      bool   `json:"dryRun"`
+	// Changes are the fields of the survivor that are updated, keyed by field name.
+	Changes map[string]*AuditChange `json:"changes"`
+	// DeletedUsers maps the UID of each deleted user row to its public identifier.
+	DeletedUsers map[string]string `json:"deletedUsers"`
+	// Aliases are the UIDs that now resolve to the survivor, including former aliases of the merged users.
+	Aliases []string `json:"aliases"`
+}
+
+type MergeUsersConfig struct {
+	// Precedence applies when the request does not set one.
+	Precedence MergePrecedence
+	// MaxAuthAge is how recently the staff member must have signed in.
+	MaxAuthAge time.Duration
+}
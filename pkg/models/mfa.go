@@ -0,0 +1,19 @@
+package models
+
+type MFAPolicy struct {
+	// Required requires every user to sign in with a second factor.
+	Required bool
+	// Roles requires a second factor from the members acting for an organization with one of these roles.
+	Roles []MembershipRole
+}
+
+type CheckMFA struct {
+	// SecondFactor is the second factor the user signed in with, such as "phone" or "totp". It is empty for single
+	// factor sign-ins.
+	SecondFactor string
+	// Membership is the membership of the organization the user is acting for, if any.
+	Membership *Membership
+	// Memberships are all the memberships of the user, when they act for no organization. A second factor is then
+	// required if any of them requires one.
+	Memberships []*Membership
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+type MembershipRole string
+
+const (
+	MembershipRoleOwner  MembershipRole = "owner"
+	MembershipRoleAdmin  MembershipRole = "admin"
+	MembershipRoleMember MembershipRole = "member"
+)
+
+type Organization struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	RequireMFA bool       `json:"requireMFA"`
+	CreatedAt  *time.Time `json:"createdAt"`
+}
+
+type Membership struct {
+	Organization *Organization  `json:"organization"`
+	FirebaseUID  string         `json:"firebaseUID"`
+	Role         MembershipRole `json:"role"`
+	CreatedAt    *time.Time     `json:"createdAt"`
+}
+
+type CreateOrganization struct {
+	Name string `json:"name" validate:"required,max=255"`
+	// RequireMFA requires members to sign in with a second factor to act for the organization.
+	RequireMFA bool `json:"requireMFA"`
+}
+
+type UpdateOrganization struct {
+	OrganizationID string `json:"organizationID" validate:"required,uuid"`
+	// RequireMFA requires members to sign in with a second factor to act for the organization.
+	RequireMFA bool `json:"requireMFA"`
+}
+
+type AddOrganizationMember struct {
+	OrganizationID string         `json:"organizationID" validate:"required,uuid"`
+	FirebaseUID    string         `json:"firebaseUID" validate:"required,max=255"`
+	Role           MembershipRole `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type RemoveOrganizationMember struct {
+	OrganizationID string `json:"organizationID" validate:"required,uuid"`
+	FirebaseUID    string `json:"firebaseUID" validate:"required,max=255"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type OutboxRelayConfig struct {
+	BatchSize int
+	// Lease is how long a claimed event is hidden from other relays. It must be longer than the time needed to
+	// publish a batch, or events may be published twice.
+	Lease time.Duration
+	// MinBackoff is the delay before the first retry of a failed event. It doubles with each attempt, up to
+	// MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxAttempts is how many times an event is published before it is marked dead.
+	MaxAttempts int
+	// Retention is how long published and dead events are kept.
+	Retention time.Duration
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+const (
+	ScopeUserRead  = "user:read"
+	ScopeUserWrite = "user:write"
+)
+
+type PersonalAccessToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	CreatedAt  *time.Time `json:"createdAt"`
+}
+
+type CreatePersonalAccessToken struct {
+	Name      string     `json:"name" validate:"required,max=255"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,oneof=user:read user:write"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreatedPersonalAccessToken is only returned once, on creation. The clear token is never stored, and cannot be
+// retrieved afterward.
+type CreatedPersonalAccessToken struct {
+	PersonalAccessToken
+	Token string `json:"token"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+type RateLimitScope string
+
+const (
+	RateLimitScopePeerIP RateLimitScope = "peer_ip"
+	RateLimitScopeUID    RateLimitScope = "uid"
+	// RateLimitScopeService limits the calling service, as identified by its verified service account.
+	RateLimitScopeService RateLimitScope = "service"
+)
+
+// RateLimit is a token bucket, refilling at Rate tokens per second up to Burst. A zero Rate disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type RateLimitConfig struct {
+	Limits map[RateLimitScope]RateLimit
+	// IdleTTL is how long a bucket is kept once it stops being used.
+	IdleTTL time.Duration
+}
+
+type CheckRateLimit struct {
+	Scope RateLimitScope
+	Key   string
+}
+
+type RateLimitDecision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
@@ -0,0 +1,25 @@
+package models
+
+type ReconcileUsers struct {
+	// DryRun reports the differences without fixing them.
+	DryRun bool
+}
+
+// UsersReconciliationReport lists the differences between Firebase and the users table, for a single tenant.
+type UsersReconciliationReport struct {
+	// TenantID is the Identity Platform tenant that was checked, or empty for project-level users.
+	TenantID string `json:"tenantID,omitempty"`
+	DryRun   bool   `json:"dryRun"`
+
+	FirebaseUsersChecked int `json:"firebaseUsersChecked"`
+	RowsChecked          int `json:"rowsChecked"`
+
+	// Orphans are the UIDs of rows whose Firebase account no longer exists. They are deleted, unless DryRun is set.
+	Orphans []string `json:"orphans"`
+	// MissingRows are the UIDs of Firebase accounts that were never provisioned in the users table. They are only
+	// reported: with provisioning enabled, the row is created on the next sign in of the user.
+	MissingRows []string `json:"missingRows"`
+	// Disabled are the UIDs of disabled Firebase accounts that still have a row. They are only reported, since the
+	// account may be enabled again.
+	Disabled []string `json:"disabled"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type SignInRiskSignal string
+
+const (
+	// SignInRiskNewDevice is raised when the user agent was never seen for the user.
+	SignInRiskNewDevice SignInRiskSignal = "new_device"
+	// SignInRiskNewNetwork is raised when the address is outside every network seen for the user. It is too common
+	// to be suspicious on its own.
+	SignInRiskNewNetwork SignInRiskSignal = "new_network"
+	// SignInRiskNewCountry is raised when the address is located in a country never seen for the user.
+	SignInRiskNewCountry SignInRiskSignal = "new_country"
+	// SignInRiskImpossibleTravel is raised when the user would have travelled faster than allowed since their last
+	// authentication.
+	SignInRiskImpossibleTravel SignInRiskSignal = "impossible_travel"
+)
+
+type AssessSignInRisk struct {
+	TenantID         string
+	FirebaseUID      string
+	PublicIdentifier string
+	// AuthTime is when the user last entered their credentials. A recent sign-in satisfies step-up.
+	AuthTime time.Time
+}
+
+type SignInRisk struct {
+	Signals []SignInRiskSignal `json:"signals"`
+	// Suspicious is set when the signals are worth alerting the user.
+	Suspicious bool `json:"suspicious"`
+}
+
+type SignInRiskConfig struct {
+	// StepUp rejects suspicious authentications from a new country or an impossible travel, unless the user signed
+	// in again within StepUpMaxAuthAge.
+	StepUp           bool
+	StepUpMaxAuthAge time.Duration
+	// MaxTravelSpeed is the speed, in km/h, above which a travel between two authentications is impossible.
+	MaxTravelSpeed float64
+	// CacheTTL is how long a known context is trusted without checking the database again.
+	CacheTTL time.Duration
+	// ContextRetention is how long a context is kept once unused.
+	ContextRetention time.Duration
+}
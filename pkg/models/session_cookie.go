@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type SessionCookie struct {
+	Value     string     `json:"value"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type SessionCookieConfig struct {
+	// TTL is the lifetime of session cookies, between 5 minutes and 2 weeks.
+	TTL time.Duration
+	// MaxAuthAge is how recently the user must have signed in to get a session cookie.
+	MaxAuthAge time.Duration
+}
@@ -1,7 +1,38 @@
 package models
 
+import "time"
+
 type User struct {
 	PublicIdentifier string `json:"publicIdentifier"`
-	FirebaseUID      string `json:"firebaseUID"`
-	Email            string `json:"email"`
+	// TenantID is the Identity Platform tenant of the user, or empty for project-level users.
+	TenantID    string `json:"tenantID,omitempty"`
+	FirebaseUID string `json:"firebaseUID"`
+	Email       string `json:"email"`
+	// Scopes is only set when the user authenticated with a personal access token. A nil value grants full access.
+	Scopes []string `json:"scopes,omitempty"`
+	// AuthTime is only set by authentication, with when the user last entered their credentials. It is zero for
+	// personal access tokens.
+	AuthTime time.Time `json:"-"`
+	// EmailVerification is only set by authentication, and explains why the user was let in.
+	EmailVerification *EmailVerificationDecision `json:"emailVerification,omitempty"`
+	// Membership is only set by authentication, when an active organization was requested.
+	Membership *Membership `json:"membership,omitempty"`
+	// IdentityProvider is the name of the external identity provider the user signs in with. It is empty for Firebase
+	// users. External users have no Firebase account, so their email is only known by authentication.
+	IdentityProvider string `json:"identityProvider,omitempty"`
+	// Staff is only set by authentication, for users holding the staff custom claim.
+	Staff bool `json:"staff,omitempty"`
+	// Impersonator is only set by authentication, with the UID of the staff member impersonating the user. Downstream
+	// services should refuse destructive actions when it is set.
+	Impersonator string `json:"impersonator,omitempty"`
+	// MintedBy is only set by authentication, with the service account that minted the custom token the user signed
+	// in with. The user did not sign in themselves, so downstream services should refuse destructive actions.
+	MintedBy string `json:"mintedBy,omitempty"`
+	// Activity is only set by GetUser, for internal tooling. It lags behind by up to the activity flush interval.
+	Activity *UserActivity `json:"activity,omitempty"`
+}
+
+type UserProvisioningConfig struct {
+	// Enabled creates the row of a user on their first successful authentication, with a default public identifier.
+	Enabled bool
 }
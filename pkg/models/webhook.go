@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+type WebhookSubscription struct {
+	ID         string   `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"eventTypes"`
+	// Secret is only returned on creation. Receivers use it to verify the signature of deliveries.
+	Secret    string     `json:"secret,omitempty"`
+	CreatedAt *time.Time `json:"createdAt"`
+}
+
+type CreateWebhookSubscription struct {
+	URL        string   `json:"url" validate:"required,http_url,startswith=https://"`
+	EventTypes []string `json:"eventTypes" validate:"required,min=1,dive,oneof=user.created user.updated user.deleted user.merged user.suspicious_sign_in"`
+}
+
+type WebhookDeliveryAttempt struct {
+	// StatusCode is 0 when the receiver could not be reached.
+	StatusCode int           `json:"statusCode,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Duration   time.Duration `json:"duration"`
+	CreatedAt  *time.Time    `json:"createdAt"`
+}
+
+type WebhookDelivery struct {
+	ID             string                    `json:"id"`
+	SubscriptionID string                    `json:"subscriptionID"`
+	EventID        string                    `json:"eventID"`
+	EventType      string                    `json:"eventType"`
+	Status         WebhookDeliveryStatus     `json:"status"`
+	Attempts       int                       `json:"attempts"`
+	NextAttemptAt  *time.Time                `json:"nextAttemptAt,omitempty"`
+	DeliveredAt    *time.Time                `json:"deliveredAt,omitempty"`
+	Log            []*WebhookDeliveryAttempt `json:"log,omitempty"`
+	CreatedAt      *time.Time                `json:"createdAt"`
+}
+
+type ListWebhookDeliveries struct {
+	SubscriptionID string                `json:"subscriptionID" validate:"required,uuid"`
+	Status         WebhookDeliveryStatus `json:"status" validate:"omitempty,oneof=pending delivered dead"`
+	PageSize       int                   `json:"pageSize" validate:"min=0,max=100"`
+}
+
+// WebhookPayload is the body posted to webhook receivers.
+type WebhookPayload struct {
+	ID         string           `json:"id"`
+	Type       string           `json:"type"`
+	OccurredAt time.Time        `json:"occurredAt"`
+	Data       *WebhookUserData `json:"data"`
+}
+
+type WebhookUserData struct {
+	TenantID         string `json:"tenantID,omitempty"`
+	FirebaseUID      string `json:"firebaseUID"`
+	PublicIdentifier string `json:"publicIdentifier"`
+	MergedUID        string `json:"mergedUID,omitempty"`
+}
+
+type WebhookDeliveryConfig struct {
+	BatchSize int
+	// Lease is how long a claimed batch is hidden from other workers. Deliveries are sent one after another, so it
+	// must be at least BatchSize times Timeout. Deliveries that could outlive the lease are left for a later batch.
+	Lease   time.Duration
+	Timeout time.Duration
+	// MinBackoff is the delay before the first retry of a failed delivery. It doubles with each attempt, up to
+	// MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxAttempts is the number of attempts after which a delivery is dead.
+	MaxAttempts int
+}
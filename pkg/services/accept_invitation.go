@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+type AcceptInvitationService interface {
+	Exec(ctx context.Context, token string, code string) (*models.Membership, error)
+}
+
+type acceptInvitationServiceImpl struct {
+	auth                          AuthenticateService
+	getInvitationByCodeRepository dao.GetInvitationByCodeRepository
+	acceptInvitationRepository    dao.AcceptInvitationRepository
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *acceptInvitationServiceImpl) Exec(ctx context.Context, token string, code string) (*models.Membership, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invitations are bound to an email, which is only proven by an interactive sign-in.
+	if user.Scopes != nil {
+		return nil, ErrInsufficientScope
+	}
+
+	invitation, err := s.getInvitationByCodeRepository.GetInvitationByCode(ctx, hashSecret(code))
+	if err != nil {
+		if errors.Is(err, dao.ErrInvitationNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, err
+	}
+
+	// Codes are single-use.
+	if invitation.AcceptedAt != nil {
+		return nil, ErrInvitationNotFound
+	}
+
+	if !invitation.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvitationExpired
+	}
+
+	if normalizeEmail(user.Email) != invitation.Email {
+		return nil, ErrInvitationEmailMismatch
+	}
+
+	// Users in their verification grace period are let in, but they must not join an organization with an email
+	// they might not own.
+	if user.EmailVerification == nil || user.EmailVerification.Status != models.EmailVerificationStatusVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	membership, err := s.acceptInvitationRepository.AcceptInvitation(ctx, *invitation.ID, user.FirebaseUID)
+	if err != nil {
+		if errors.Is(err, dao.ErrInvitationNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		if errors.Is(err, dao.ErrMembershipAlreadyExists) {
+			return nil, ErrOrganizationMemberAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: user.FirebaseUID,
+		Target:     "organizations/" + invitation.OrganizationID.String() + "/invitations/" + invitation.ID.String(),
+		Action:     models.AuditActionAcceptInvitation,
+		Outcome:    models.AuditOutcomeSuccess,
+		Diff: map[string]*models.AuditChange{
+			"role": {After: models.MembershipRole(membership.Role)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	membership.Organization = invitation.Organization
+
+	return membershipToModel(membership), nil
+}
+
+func NewAcceptInvitationService(
+	auth AuthenticateService,
+	getInvitationByCodeRepository dao.GetInvitationByCodeRepository,
+	acceptInvitationRepository dao.AcceptInvitationRepository,
+	recordAuditEvent RecordAuditEventService,
+) AcceptInvitationService {
+	return &acceptInvitationServiceImpl{
+		auth:                          auth,
+		getInvitationByCodeRepository: getInvitationByCodeRepository,
+		acceptInvitationRepository:    acceptInvitationRepository,
+		recordAuditEvent:              recordAuditEvent,
+	}
+}
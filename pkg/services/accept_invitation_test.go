@@ -0,0 +1,241 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestAcceptInvitation(t *testing.T) {
+	organization := &entities.Organization{
+		ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: "organization-1",
+	}
+
+	verifiedUser := &models.User{
+		FirebaseUID: "user-two-uid",
+		Email:       "Invitee@gmail.com",
+		EmailVerification: &models.EmailVerificationDecision{
+			Status: models.EmailVerificationStatusVerified,
+		},
+	}
+
+	pendingInvitation := &entities.OrganizationInvitation{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		OrganizationID: *organization.ID,
+		Email:          "invitee@gmail.com",
+		Role:           entities.MembershipRoleAdmin,
+		Organization:   organization,
+		ExpiresAt:      lo.ToPtr(time.Now().Add(time.Hour)),
+	}
+
+	testData := []struct {
+		name string
+
+		token string
+		code  string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetInvitation bool
+		getInvitationResponse   *entities.OrganizationInvitation
+		getInvitationErr        error
+
+		shouldCallAcceptInvitation bool
+		acceptInvitationResponse   *entities.Membership
+		acceptInvitationErr        error
+
+		expect    *models.Membership
+		expectErr error
+	}{
+		{
+			name:                       "AcceptInvitation",
+			token:                      "foo-token",
+			code:                       "inr_inv_foo",
+			authResponse:               verifiedUser,
+			shouldCallGetInvitation:    true,
+			getInvitationResponse:      pendingInvitation,
+			shouldCallAcceptInvitation: true,
+			acceptInvitationResponse: &entities.Membership{
+				OrganizationID: *organization.ID,
+				FirebaseUID:    "user-two-uid",
+				Role:           entities.MembershipRoleAdmin,
+			},
+			expect: &models.Membership{
+				Organization: &models.Organization{
+					ID:   organization.ID.String(),
+					Name: "organization-1",
+				},
+				FirebaseUID: "user-two-uid",
+				Role:        models.MembershipRoleAdmin,
+			},
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			code:      "inr_inv_foo",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "PersonalAccessToken",
+			token: "inr_pat_foo",
+			code:  "inr_inv_foo",
+			authResponse: &models.User{
+				FirebaseUID: "user-two-uid",
+				Email:       "invitee@gmail.com",
+				Scopes:      []string{models.ScopeUserWrite},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:                    "InvitationNotFound",
+			token:                   "foo-token",
+			code:                    "inr_inv_foo",
+			authResponse:            verifiedUser,
+			shouldCallGetInvitation: true,
+			getInvitationErr:        dao.ErrInvitationNotFound,
+			expectErr:               services.ErrInvitationNotFound,
+		},
+		{
+			name:                    "AlreadyAccepted",
+			token:                   "foo-token",
+			code:                    "inr_inv_foo",
+			authResponse:            verifiedUser,
+			shouldCallGetInvitation: true,
+			getInvitationResponse: &entities.OrganizationInvitation{
+				ID:             pendingInvitation.ID,
+				OrganizationID: *organization.ID,
+				Email:          "invitee@gmail.com",
+				Role:           entities.MembershipRoleAdmin,
+				ExpiresAt:      pendingInvitation.ExpiresAt,
+				AcceptedAt:     lo.ToPtr(time.Now().Add(-time.Hour)),
+			},
+			expectErr: services.ErrInvitationNotFound,
+		},
+		{
+			name:                    "Expired",
+			token:                   "foo-token",
+			code:                    "inr_inv_foo",
+			authResponse:            verifiedUser,
+			shouldCallGetInvitation: true,
+			getInvitationResponse: &entities.OrganizationInvitation{
+				ID:             pendingInvitation.ID,
+				OrganizationID: *organization.ID,
+				Email:          "invitee@gmail.com",
+				Role:           entities.MembershipRoleAdmin,
+				ExpiresAt:      lo.ToPtr(time.Now().Add(-time.Hour)),
+			},
+			expectErr: services.ErrInvitationExpired,
+		},
+		{
+			name:  "EmailMismatch",
+			token: "foo-token",
+			code:  "inr_inv_foo",
+			authResponse: &models.User{
+				FirebaseUID: "user-three-uid",
+				Email:       "someone-else@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+			},
+			shouldCallGetInvitation: true,
+			getInvitationResponse:   pendingInvitation,
+			expectErr:               services.ErrInvitationEmailMismatch,
+		},
+		{
+			name:  "EmailPendingVerification",
+			token: "foo-token",
+			code:  "inr_inv_foo",
+			authResponse: &models.User{
+				FirebaseUID: "user-two-uid",
+				Email:       "invitee@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusPending,
+				},
+			},
+			shouldCallGetInvitation: true,
+			getInvitationResponse:   pendingInvitation,
+			expectErr:               services.ErrEmailNotVerified,
+		},
+		{
+			name:                       "AlreadyMember",
+			token:                      "foo-token",
+			code:                       "inr_inv_foo",
+			authResponse:               verifiedUser,
+			shouldCallGetInvitation:    true,
+			getInvitationResponse:      pendingInvitation,
+			shouldCallAcceptInvitation: true,
+			acceptInvitationErr:        dao.ErrMembershipAlreadyExists,
+			expectErr:                  services.ErrOrganizationMemberAlreadyExists,
+		},
+		{
+			// Another request used the code in the meantime.
+			name:                       "ConcurrentAcceptance",
+			token:                      "foo-token",
+			code:                       "inr_inv_foo",
+			authResponse:               verifiedUser,
+			shouldCallGetInvitation:    true,
+			getInvitationResponse:      pendingInvitation,
+			shouldCallAcceptInvitation: true,
+			acceptInvitationErr:        dao.ErrInvitationNotFound,
+			expectErr:                  services.ErrInvitationNotFound,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getInvitationByCodeRepository := daomocks.NewMockGetInvitationByCodeRepository(t)
+			acceptInvitationRepository := daomocks.NewMockAcceptInvitationRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetInvitation {
+				getInvitationByCodeRepository.
+					On("GetInvitationByCode", context.TODO(), hashToken(data.code)).
+					Return(data.getInvitationResponse, data.getInvitationErr)
+			}
+
+			if data.shouldCallAcceptInvitation {
+				acceptInvitationRepository.
+					On("AcceptInvitation", context.TODO(), *data.getInvitationResponse.ID, data.authResponse.FirebaseUID).
+					Return(data.acceptInvitationResponse, data.acceptInvitationErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionAcceptInvitation && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewAcceptInvitationService(
+				authService, getInvitationByCodeRepository, acceptInvitationRepository, recordAuditEventService,
+			)
+
+			membership, err := service.Exec(context.TODO(), data.token, data.code)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, membership)
+
+			authService.AssertExpectations(t)
+			getInvitationByCodeRepository.AssertExpectations(t)
+			acceptInvitationRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
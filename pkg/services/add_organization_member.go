@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type AddOrganizationMemberService interface {
+	Exec(ctx context.Context, token string, data *models.AddOrganizationMember) (*models.Membership, error)
+}
+
+type addOrganizationMemberServiceImpl struct {
+	auth                       AuthenticateService
+	getMembershipRepository    dao.GetMembershipRepository
+	createMembershipRepository dao.CreateMembershipRepository
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *addOrganizationMemberServiceImpl) Exec(
+	ctx context.Context, token string, data *models.AddOrganizationMember,
+) (*models.Membership, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil && !lo.Contains(user.Scopes, models.ScopeUserWrite) {
+		return nil, ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidAddOrganizationMember, err)
+	}
+
+	organizationID, err := uuid.Parse(data.OrganizationID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	actor, err := getManagerMembership(ctx, s.getMembershipRepository, organizationID, user.FirebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	role := entities.MembershipRole(data.Role)
+
+	// Admins can add members and other admins, but only owners can appoint new owners.
+	if !outranks(actor.Role, role) {
+		return nil, ErrInsufficientOrganizationRole
+	}
+
+	membership, err := s.createMembershipRepository.CreateMembership(ctx, organizationID, data.FirebaseUID, &dao.CreateMembershipData{
+		Role: role,
+	})
+	if err != nil {
+		if errors.Is(err, dao.ErrMembershipAlreadyExists) {
+			return nil, ErrOrganizationMemberAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: data.FirebaseUID,
+		Target:     "organizations/" + organizationID.String(),
+		Action:     models.AuditActionAddOrganizationMember,
+		Outcome:    models.AuditOutcomeSuccess,
+		Diff: map[string]*models.AuditChange{
+			"role": {After: data.Role},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	membership.Organization = actor.Organization
+
+	return membershipToModel(membership), nil
+}
+
+func NewAddOrganizationMemberService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	createMembershipRepository dao.CreateMembershipRepository,
+	recordAuditEvent RecordAuditEventService,
+) AddOrganizationMemberService {
+	return &addOrganizationMemberServiceImpl{
+		auth:                       auth,
+		getMembershipRepository:    getMembershipRepository,
+		createMembershipRepository: createMembershipRepository,
+		recordAuditEvent:           recordAuditEvent,
+	}
+}
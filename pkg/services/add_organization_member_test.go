@@ -0,0 +1,247 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddOrganizationMember(t *testing.T) {
+	organization := &entities.Organization{
+		ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: "organization-1",
+	}
+
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.AddOrganizationMember
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		shouldCallCreateMembership bool
+		createMembershipResponse   *entities.Membership
+		createMembershipErr        error
+
+		expect    *models.Membership
+		expectErr error
+	}{
+		{
+			name:  "AddOrganizationMember",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			shouldCallCreateMembership: true,
+			createMembershipResponse: &entities.Membership{
+				OrganizationID: *organization.ID,
+				FirebaseUID:    "user-two-uid",
+				Role:           entities.MembershipRoleMember,
+			},
+			expect: &models.Membership{
+				Organization: &models.Organization{
+					ID:   "00000000-0000-0000-0000-000000000001",
+					Name: "organization-1",
+				},
+				FirebaseUID: "user-two-uid",
+				Role:        models.MembershipRoleMember,
+			},
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleMember,
+			},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "InsufficientScope",
+			token: "inr_pat_foo",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidRole",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           "superuser",
+			},
+			authResponse: &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:    services.ErrInvalidAddOrganizationMember,
+		},
+		{
+			name:  "NotOrganizationMember",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipErr:        dao.ErrMembershipNotFound,
+			expectErr:               services.ErrNotOrganizationMember,
+		},
+		{
+			name:  "MemberCannotAddMembers",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleMember,
+				Organization: organization,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "AdminCannotAddOwners",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleOwner,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "MemberAlreadyExists",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleOwner,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallCreateMembership: true,
+			createMembershipErr:        dao.ErrMembershipAlreadyExists,
+			expectErr:                  services.ErrOrganizationMemberAlreadyExists,
+		},
+		{
+			name:  "CreateMembershipError",
+			token: "foo-token",
+			data: &models.AddOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				FirebaseUID:    "user-two-uid",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallCreateMembership: true,
+			createMembershipErr:        FooErr,
+			expectErr:                  FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			createMembershipRepository := daomocks.NewMockCreateMembershipRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), uuid.MustParse(data.data.OrganizationID), data.authResponse.FirebaseUID).
+					Return(data.getMembershipResponse, data.getMembershipErr)
+			}
+
+			if data.shouldCallCreateMembership {
+				createMembershipRepository.
+					On(
+						"CreateMembership",
+						context.TODO(),
+						uuid.MustParse(data.data.OrganizationID),
+						data.data.FirebaseUID,
+						&dao.CreateMembershipData{Role: entities.MembershipRole(data.data.Role)},
+					).
+					Return(data.createMembershipResponse, data.createMembershipErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionAddOrganizationMember && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewAddOrganizationMemberService(
+				authService, getMembershipRepository, createMembershipRepository, recordAuditEventService,
+			)
+
+			membership, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, membership)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			createMembershipRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
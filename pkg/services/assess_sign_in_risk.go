@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// signInContextsHistory is the number of recent contexts a sign-in is compared to.
+	signInContextsHistory = 100
+	// impossibleTravelMinDistance ignores short distances, since GeoIP coordinates are approximate.
+	impossibleTravelMinDistance = 500
+	earthRadius                 = 6371
+	// maxKnownSignInContexts bounds the memory used by the cache of known contexts.
+	maxKnownSignInContexts = 10000
+)
+
+// AssessSignInRiskService compares the device and location of an authentication with the ones previously seen for
+// the user. Suspicious authentications are audited, and emit a user.suspicious_sign_in event. When step-up is
+// enabled, authentications from a new country or after an impossible travel fail with ErrStepUpRequired, until the
+// user signs in again.
+type AssessSignInRiskService interface {
+	Exec(ctx context.Context, data *models.AssessSignInRisk) (*models.SignInRisk, error)
+}
+
+type assessSignInRiskServiceImpl struct {
+	locator                       clients.GeoIPLocator
+	listSignInContextsRepository  dao.ListSignInContextsRepository
+	recordSignInContextRepository dao.RecordSignInContextRepository
+	recordAuditEvent              RecordAuditEventService
+	trustedProxies                int
+	config                        models.SignInRiskConfig
+
+	// known holds the expiration of the contexts recently checked, so a user making many requests from the same
+	// place does not cost a database round trip each time.
+	mu    sync.Mutex
+	known map[string]time.Time
+}
+
+func (s *assessSignInRiskServiceImpl) isKnown(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return time.Now().Before(s.known[key])
+}
+
+func (s *assessSignInRiskServiceImpl) remember(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	if len(s.known) >= maxKnownSignInContexts {
+		for knownKey, expiresAt := range s.known {
+			if !now.Before(expiresAt) {
+				delete(s.known, knownKey)
+			}
+		}
+	}
+	if len(s.known) >= maxKnownSignInContexts {
+		s.known = make(map[string]time.Time)
+	}
+
+	s.known[key] = now.Add(s.config.CacheTTL)
+}
+
+// deviceFingerprint identifies a device by its user agent, without storing the user agent itself.
+func deviceFingerprint(userAgent string) string {
+	sum := sha256.Sum256([]byte(userAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+// ipNetwork returns the range an address most likely shares with the other addresses of the same connection.
+func ipNetwork(ip net.IP) string {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return (&net.IPNet{IP: ipv4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}).String()
+}
+
+// distance returns the great-circle distance between two coordinates, in km.
+func distance(latitudeA, longitudeA, latitudeB, longitudeB float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+
+	deltaLatitude := toRadians(latitudeB - latitudeA)
+	deltaLongitude := toRadians(longitudeB - longitudeA)
+
+	a := math.Sin(deltaLatitude/2)*math.Sin(deltaLatitude/2) +
+		math.Cos(toRadians(latitudeA))*math.Cos(toRadians(latitudeB))*math.Sin(deltaLongitude/2)*math.Sin(deltaLongitude/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+func (s *assessSignInRiskServiceImpl) signals(
+	signInContexts []*entities.SignInContext, device string, network string, location *clients.GeoLocation, now time.Time,
+) []models.SignInRiskSignal {
+	signals := make([]models.SignInRiskSignal, 0)
+
+	// Nothing is known about the first sign-in of a user.
+	if len(signInContexts) == 0 {
+		return signals
+	}
+
+	knownDevice, knownNetwork, knownCountry, locatedBefore := false, false, false, false
+	for _, signInContext := range signInContexts {
+		knownDevice = knownDevice || signInContext.Device == device
+		knownNetwork = knownNetwork || signInContext.Network == network
+
+		if signInContext.Country != nil {
+			locatedBefore = true
+			knownCountry = knownCountry || (location != nil && *signInContext.Country == location.Country)
+		}
+	}
+
+	if !knownDevice {
+		signals = append(signals, models.SignInRiskNewDevice)
+	}
+	if !knownNetwork {
+		signals = append(signals, models.SignInRiskNewNetwork)
+	}
+	if location != nil && locatedBefore && !knownCountry {
+		signals = append(signals, models.SignInRiskNewCountry)
+	}
+
+	if location == nil || !location.HasCoordinates {
+		return signals
+	}
+
+	// Contexts are sorted from the most recent, so this is the last place the user was seen at.
+	last, found := lo.Find(signInContexts, func(signInContext *entities.SignInContext) bool {
+		return signInContext.Latitude != nil && signInContext.Longitude != nil
+	})
+	if !found {
+		return signals
+	}
+
+	travelled := distance(*last.Latitude, *last.Longitude, location.Latitude, location.Longitude)
+	elapsed := now.Sub(*last.LastSeenAt).Hours()
+
+	if travelled > impossibleTravelMinDistance && (elapsed <= 0 || travelled/elapsed > s.config.MaxTravelSpeed) {
+		signals = append(signals, models.SignInRiskImpossibleTravel)
+	}
+
+	return signals
+}
+
+func (s *assessSignInRiskServiceImpl) Exec(ctx context.Context, data *models.AssessSignInRisk) (*models.SignInRisk, error) {
+	risk := &models.SignInRisk{Signals: []models.SignInRiskSignal{}}
+
+	peerIP, userAgent := requestMetadata(ctx, s.trustedProxies)
+
+	// Calls from within the cluster may carry no address. There is nothing to compare in this case.
+	ip := net.ParseIP(peerIP)
+	if ip == nil {
+		return risk, nil
+	}
+
+	device := deviceFingerprint(userAgent)
+	network := ipNetwork(ip)
+	key := strings.Join([]string{data.TenantID, data.FirebaseUID, device, network}, "\x00")
+
+	if s.isKnown(key) {
+		return risk, nil
+	}
+
+	location, err := s.locator.Locate(ip)
+	if err != nil {
+		return nil, err
+	}
+
+	signInContexts, err := s.listSignInContextsRepository.ListSignInContexts(
+		ctx, data.TenantID, data.FirebaseUID, signInContextsHistory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	risk.Signals = s.signals(signInContexts, device, network, location, now)
+	risk.Suspicious = lo.SomeBy(risk.Signals, func(signal models.SignInRiskSignal) bool {
+		return signal != models.SignInRiskNewNetwork
+	})
+
+	if risk.Suspicious {
+		stepUp := s.config.StepUp &&
+			lo.Some(risk.Signals, []models.SignInRiskSignal{models.SignInRiskNewCountry, models.SignInRiskImpossibleTravel}) &&
+			now.Sub(data.AuthTime) > s.config.StepUpMaxAuthAge
+
+		outcome := models.AuditOutcomeSuccess
+		if stepUp {
+			outcome = models.AuditOutcomeFailure
+		}
+
+		reasons := lo.Map(risk.Signals, func(signal models.SignInRiskSignal, _ int) string {
+			return string(signal)
+		})
+
+		err := s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+			ActorUID:   data.FirebaseUID,
+			SubjectUID: data.FirebaseUID,
+			Action:     models.AuditActionSuspiciousSignIn,
+			Outcome:    outcome,
+			Reason:     strings.Join(reasons, ","),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// The context is not recorded, so it is still unknown when the user comes back after signing in again.
+		if stepUp {
+			return risk, ErrStepUpRequired
+		}
+	}
+
+	recordData := &dao.RecordSignInContextData{
+		TenantID:         data.TenantID,
+		FirebaseUID:      data.FirebaseUID,
+		Device:           device,
+		Network:          network,
+		SeenAt:           now,
+		Suspicious:       risk.Suspicious,
+		PublicIdentifier: data.PublicIdentifier,
+	}
+	if location != nil {
+		recordData.Country = &location.Country
+		if location.HasCoordinates {
+			recordData.Latitude = &location.Latitude
+			recordData.Longitude = &location.Longitude
+		}
+	}
+
+	if err := s.recordSignInContextRepository.RecordSignInContext(ctx, recordData); err != nil {
+		return nil, err
+	}
+
+	s.remember(key)
+
+	return risk, nil
+}
+
+// NewAssessSignInRiskService reads the address of the caller behind trustedProxies proxies. See ClientIP.
+func NewAssessSignInRiskService(
+	locator clients.GeoIPLocator,
+	listSignInContextsRepository dao.ListSignInContextsRepository,
+	recordSignInContextRepository dao.RecordSignInContextRepository,
+	recordAuditEvent RecordAuditEventService,
+	trustedProxies int,
+	config models.SignInRiskConfig,
+) AssessSignInRiskService {
+	return &assessSignInRiskServiceImpl{
+		locator:                       locator,
+		listSignInContextsRepository:  listSignInContextsRepository,
+		recordSignInContextRepository: recordSignInContextRepository,
+		recordAuditEvent:              recordAuditEvent,
+		trustedProxies:                trustedProxies,
+		config:                        config,
+		known:                         make(map[string]time.Time),
+	}
+}
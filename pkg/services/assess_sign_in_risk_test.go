@@ -0,0 +1,306 @@
+package services_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"testing"
+	"time"
+)
+
+func deviceFingerprint(userAgent string) string {
+	sum := sha256.Sum256([]byte(userAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAssessSignInRisk(t *testing.T) {
+	locator, err := clients.NewStaticGeoIPLocator(map[string]*clients.GeoLocation{
+		"203.0.113.0/24":  {Country: "FR", HasCoordinates: true, Latitude: 48.8566, Longitude: 2.3522},
+		"198.51.100.0/24": {Country: "FR", HasCoordinates: true, Latitude: 43.2965, Longitude: 5.3698},
+		"192.0.2.0/24":    {Country: "US", HasCoordinates: true, Latitude: 40.7128, Longitude: -74.0060},
+	})
+	require.NoError(t, err)
+
+	parisCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "203.0.113.7", "user-agent", "grpc-go/1.64.0",
+	))
+	newDeviceCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "203.0.113.7", "user-agent", "grpc-go/1.67.0",
+	))
+	marseilleCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "198.51.100.7", "user-agent", "grpc-go/1.64.0",
+	))
+	newYorkCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "192.0.2.7", "user-agent", "grpc-go/1.64.0",
+	))
+	// The client claims to be in Paris, but the load balancer saw it in New York.
+	spoofedCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "203.0.113.7, 192.0.2.7", "user-agent", "grpc-go/1.64.0",
+	))
+
+	parisContext := &entities.SignInContext{
+		FirebaseUID: "firebase-uid-1",
+		Device:      deviceFingerprint("grpc-go/1.64.0"),
+		Network:     "203.0.113.0/24",
+		Country:     lo.ToPtr("FR"),
+		Latitude:    lo.ToPtr(48.8566),
+		Longitude:   lo.ToPtr(2.3522),
+		LastSeenAt:  lo.ToPtr(time.Now().Add(-10 * time.Minute)),
+	}
+
+	testData := []struct {
+		name string
+
+		ctx    context.Context
+		data   *models.AssessSignInRisk
+		stepUp bool
+		// calls is the number of times the same sign-in is assessed.
+		calls int
+
+		shouldCallListSignInContexts bool
+		listSignInContextsResponse   []*entities.SignInContext
+		listSignInContextsErr        error
+
+		expectAudit   *models.AuditOutcome
+		expectRecord  bool
+		expectNetwork string
+
+		expect    *models.SignInRisk
+		expectErr error
+	}{
+		{
+			name:                         "FirstSignIn",
+			ctx:                          parisCtx,
+			data:                         &models.AssessSignInRisk{FirebaseUID: "firebase-uid-1"},
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse:   []*entities.SignInContext{},
+			expectRecord:                 true,
+			expectNetwork:                "203.0.113.0/24",
+			expect:                       &models.SignInRisk{Signals: []models.SignInRiskSignal{}},
+		},
+		{
+			name:                         "KnownContext",
+			ctx:                          parisCtx,
+			data:                         &models.AssessSignInRisk{FirebaseUID: "firebase-uid-1"},
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse:   []*entities.SignInContext{parisContext},
+			expectRecord:                 true,
+			expectNetwork:                "203.0.113.0/24",
+			expect:                       &models.SignInRisk{Signals: []models.SignInRiskSignal{}},
+		},
+		{
+			// Known contexts are only checked once in a while.
+			name:                         "CachedContext",
+			ctx:                          parisCtx,
+			data:                         &models.AssessSignInRisk{FirebaseUID: "firebase-uid-1"},
+			calls:                        3,
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse:   []*entities.SignInContext{parisContext},
+			expectRecord:                 true,
+			expectNetwork:                "203.0.113.0/24",
+			expect:                       &models.SignInRisk{Signals: []models.SignInRiskSignal{}},
+		},
+		{
+			name:                         "NewDevice",
+			ctx:                          newDeviceCtx,
+			data:                         &models.AssessSignInRisk{FirebaseUID: "firebase-uid-1"},
+			stepUp:                       true,
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse:   []*entities.SignInContext{parisContext},
+			expectAudit:                  lo.ToPtr(models.AuditOutcomeSuccess),
+			expectRecord:                 true,
+			expectNetwork:                "203.0.113.0/24",
+			expect: &models.SignInRisk{
+				Signals:    []models.SignInRiskSignal{models.SignInRiskNewDevice},
+				Suspicious: true,
+			},
+		},
+		{
+			name: "ImpossibleTravel",
+			ctx:  marseilleCtx,
+			data: &models.AssessSignInRisk{
+				FirebaseUID: "firebase-uid-1",
+				AuthTime:    time.Now().Add(-time.Hour),
+			},
+			stepUp:                       true,
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse:   []*entities.SignInContext{parisContext},
+			expectAudit:                  lo.ToPtr(models.AuditOutcomeFailure),
+			expect: &models.SignInRisk{
+				Signals:    []models.SignInRiskSignal{models.SignInRiskNewNetwork, models.SignInRiskImpossibleTravel},
+				Suspicious: true,
+			},
+			expectErr: services.ErrStepUpRequired,
+		},
+		{
+			name: "NewCountry",
+			ctx:  newYorkCtx,
+			data: &models.AssessSignInRisk{
+				FirebaseUID: "firebase-uid-1",
+				AuthTime:    time.Now().Add(-time.Hour),
+			},
+			stepUp:                       true,
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse: []*entities.SignInContext{
+				{
+					FirebaseUID: "firebase-uid-1",
+					Device:      deviceFingerprint("grpc-go/1.64.0"),
+					Network:     "203.0.113.0/24",
+					Country:     lo.ToPtr("FR"),
+					Latitude:    lo.ToPtr(48.8566),
+					Longitude:   lo.ToPtr(2.3522),
+					LastSeenAt:  lo.ToPtr(time.Now().Add(-72 * time.Hour)),
+				},
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeFailure),
+			expect: &models.SignInRisk{
+				Signals:    []models.SignInRiskSignal{models.SignInRiskNewNetwork, models.SignInRiskNewCountry},
+				Suspicious: true,
+			},
+			expectErr: services.ErrStepUpRequired,
+		},
+		{
+			name: "SpoofedForwardedFor",
+			ctx:  spoofedCtx,
+			data: &models.AssessSignInRisk{
+				FirebaseUID: "firebase-uid-1",
+				AuthTime:    time.Now().Add(-time.Hour),
+			},
+			stepUp:                       true,
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse: []*entities.SignInContext{
+				{
+					FirebaseUID: "firebase-uid-1",
+					Device:      deviceFingerprint("grpc-go/1.64.0"),
+					Network:     "203.0.113.0/24",
+					Country:     lo.ToPtr("FR"),
+					Latitude:    lo.ToPtr(48.8566),
+					Longitude:   lo.ToPtr(2.3522),
+					LastSeenAt:  lo.ToPtr(time.Now().Add(-72 * time.Hour)),
+				},
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeFailure),
+			expect: &models.SignInRisk{
+				Signals:    []models.SignInRiskSignal{models.SignInRiskNewNetwork, models.SignInRiskNewCountry},
+				Suspicious: true,
+			},
+			expectErr: services.ErrStepUpRequired,
+		},
+		{
+			// The user signed in again after being asked to.
+			name: "StepUpSatisfied",
+			ctx:  newYorkCtx,
+			data: &models.AssessSignInRisk{
+				FirebaseUID: "firebase-uid-1",
+				AuthTime:    time.Now().Add(-time.Minute),
+			},
+			stepUp:                       true,
+			shouldCallListSignInContexts: true,
+			listSignInContextsResponse: []*entities.SignInContext{
+				{
+					FirebaseUID: "firebase-uid-1",
+					Device:      deviceFingerprint("grpc-go/1.64.0"),
+					Network:     "203.0.113.0/24",
+					Country:     lo.ToPtr("FR"),
+					Latitude:    lo.ToPtr(48.8566),
+					Longitude:   lo.ToPtr(2.3522),
+					LastSeenAt:  lo.ToPtr(time.Now().Add(-72 * time.Hour)),
+				},
+			},
+			expectAudit:   lo.ToPtr(models.AuditOutcomeSuccess),
+			expectRecord:  true,
+			expectNetwork: "192.0.2.0/24",
+			expect: &models.SignInRisk{
+				Signals:    []models.SignInRiskSignal{models.SignInRiskNewNetwork, models.SignInRiskNewCountry},
+				Suspicious: true,
+			},
+		},
+		{
+			name:   "NoPeer",
+			ctx:    context.TODO(),
+			data:   &models.AssessSignInRisk{FirebaseUID: "firebase-uid-1"},
+			expect: &models.SignInRisk{Signals: []models.SignInRiskSignal{}},
+		},
+		{
+			name:                         "ListSignInContextsError",
+			ctx:                          parisCtx,
+			data:                         &models.AssessSignInRisk{FirebaseUID: "firebase-uid-1"},
+			shouldCallListSignInContexts: true,
+			listSignInContextsErr:        FooErr,
+			expectErr:                    FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			listSignInContextsRepository := daomocks.NewMockListSignInContextsRepository(t)
+			recordSignInContextRepository := daomocks.NewMockRecordSignInContextRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			if data.shouldCallListSignInContexts {
+				listSignInContextsRepository.
+					On("ListSignInContexts", data.ctx, "", "firebase-uid-1", 100).
+					Return(data.listSignInContextsResponse, data.listSignInContextsErr).
+					Once()
+			}
+
+			if data.expectAudit != nil {
+				recordAuditEventService.
+					On("Exec", data.ctx, mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionSuspiciousSignIn && in.Outcome == *data.expectAudit
+					})).
+					Return(nil)
+			}
+
+			if data.expectRecord {
+				recordSignInContextRepository.
+					On("RecordSignInContext", data.ctx, mock.MatchedBy(func(in *dao.RecordSignInContextData) bool {
+						return in.Network == data.expectNetwork && in.Suspicious == data.expect.Suspicious
+					})).
+					Return(nil).
+					Once()
+			}
+
+			service := services.NewAssessSignInRiskService(
+				locator,
+				listSignInContextsRepository,
+				recordSignInContextRepository,
+				recordAuditEventService,
+				1,
+				models.SignInRiskConfig{
+					StepUp:           data.stepUp,
+					StepUpMaxAuthAge: 5 * time.Minute,
+					MaxTravelSpeed:   900,
+					CacheTTL:         time.Hour,
+				},
+			)
+
+			risk, err := service.Exec(data.ctx, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, risk)
+
+			for range data.calls - 1 {
+				risk, err = service.Exec(data.ctx, data.data)
+
+				require.NoError(t, err)
+				require.Equal(t, &models.SignInRisk{Signals: []models.SignInRiskSignal{}}, risk)
+			}
+
+			listSignInContextsRepository.AssertExpectations(t)
+			recordSignInContextRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
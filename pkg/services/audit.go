@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const defaultAuditEventsPageSize = 50
+
+// ClientIP returns the address of the client. Each of the trustedProxies proxies in front of the service appends the
+// address it received the request from to the x-forwarded-for header, so the client is the entry appended by the
+// outermost one. Entries on its left are sent by the client itself, and can be forged. Without trusted proxies, or
+// when the header has fewer entries than there are proxies, the address of the peer is used.
+func ClientIP(ctx context.Context, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var forwarded []string
+		for _, value := range metadata.ValueFromIncomingContext(ctx, "x-forwarded-for") {
+			forwarded = append(forwarded, strings.Split(value, ",")...)
+		}
+
+		if len(forwarded) >= trustedProxies {
+			return strings.TrimSpace(forwarded[len(forwarded)-trustedProxies])
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+
+	return p.Addr.String()
+}
+
+// requestMetadata returns the address and user agent of the caller.
+func requestMetadata(ctx context.Context, trustedProxies int) (peerIP string, userAgent string) {
+	if values := metadata.ValueFromIncomingContext(ctx, "user-agent"); len(values) > 0 {
+		userAgent = values[0]
+	}
+
+	return ClientIP(ctx, trustedProxies), userAgent
+}
+
+// auditEventData prepares an audit event for the audit trail, with the address and user agent of the caller.
+func auditEventData(ctx context.Context, trustedProxies int, data *models.RecordAuditEvent) *dao.CreateAuditEventData {
+	peerIP, userAgent := requestMetadata(ctx, trustedProxies)
+
+	var diff map[string]*entities.AuditChange
+	if len(data.Diff) > 0 {
+		diff = lo.MapValues(data.Diff, func(change *models.AuditChange, _ string) *entities.AuditChange {
+			return &entities.AuditChange{Before: change.Before, After: change.After}
+		})
+	}
+
+	return &dao.CreateAuditEventData{
+		ActorUID:   lo.EmptyableToPtr(data.ActorUID),
+		SubjectUID: lo.EmptyableToPtr(data.SubjectUID),
+		Target:     lo.EmptyableToPtr(data.Target),
+		Action:     data.Action,
+		Outcome:    entities.AuditOutcome(data.Outcome),
+		Reason:     lo.EmptyableToPtr(data.Reason),
+		PeerIP:     lo.EmptyableToPtr(peerIP),
+		UserAgent:  lo.EmptyableToPtr(userAgent),
+		Diff:       diff,
+	}
+}
+
+// AuditEventBuffer holds the audit events that were not written to the audit trail yet. Every write to the trail
+// locks the whole chain, so events recorded on hot paths are written in batches instead.
+type AuditEventBuffer struct {
+	mu      sync.Mutex
+	pending []*dao.CreateAuditEventData
+}
+
+func (b *AuditEventBuffer) add(event *dao.CreateAuditEventData) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, event)
+}
+
+// take empties the buffer, and returns its content in the order it was added.
+func (b *AuditEventBuffer) take() []*dao.CreateAuditEventData {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := b.pending
+	b.pending = nil
+
+	return events
+}
+
+// restore puts back events taken from the buffer, ahead of the events added since.
+func (b *AuditEventBuffer) restore(events []*dao.CreateAuditEventData) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(events, b.pending...)
+}
+
+func NewAuditEventBuffer() *AuditEventBuffer {
+	return new(AuditEventBuffer)
+}
+
+// auditDiff only keeps the fields whose value changed.
+func auditDiff(changes map[string]*models.AuditChange) map[string]*models.AuditChange {
+	return lo.PickBy(changes, func(_ string, change *models.AuditChange) bool {
+		return !reflect.DeepEqual(change.Before, change.After)
+	})
+}
+
+func auditEventToModel(event *entities.AuditEvent) *models.AuditEvent {
+	return &models.AuditEvent{
+		ID:         strconv.FormatInt(event.ID, 10),
+		ActorUID:   lo.FromPtr(event.ActorUID),
+		SubjectUID: lo.FromPtr(event.SubjectUID),
+		Target:     lo.FromPtr(event.Target),
+		Action:     event.Action,
+		Outcome:    models.AuditOutcome(event.Outcome),
+		Reason:     lo.FromPtr(event.Reason),
+		PeerIP:     lo.FromPtr(event.PeerIP),
+		UserAgent:  lo.FromPtr(event.UserAgent),
+		Diff: lo.MapValues(event.Diff, func(change *entities.AuditChange, _ string) *models.AuditChange {
+			return &models.AuditChange{Before: change.Before, After: change.After}
+		}),
+		CreatedAt: event.CreatedAt,
+	}
+}
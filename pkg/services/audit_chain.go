@@ -0,0 +1,58 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"strconv"
+)
+
+const auditChainPageSize = 500
+
+func signAuditCheckpoint(key []byte, sequence int64, eventID int64, eventHash string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(sequence, 10) + ":" + strconv.FormatInt(eventID, 10) + ":" + eventHash))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkAuditEvent returns why the event breaks the chain, if it does. previousHash is nil until the first chained
+// event is found, as events recorded before chaining was introduced have no hash.
+func checkAuditEvent(event *entities.AuditEvent, previousHash *string) models.AuditChainBreakReason {
+	if event.Hash == nil {
+		if previousHash == nil {
+			return ""
+		}
+
+		return models.AuditChainBreakMissingHash
+	}
+
+	if lo.FromPtr(event.PreviousHash) != lo.FromPtr(previousHash) {
+		return models.AuditChainBreakPreviousHashMismatch
+	}
+
+	if dao.HashAuditEvent(event) != *event.Hash {
+		return models.AuditChainBreakHashMismatch
+	}
+
+	return ""
+}
+
+func checkAuditCheckpoint(
+	key []byte, checkpoint *entities.AuditCheckpoint, event *entities.AuditEvent,
+) models.AuditChainBreakReason {
+	expectedSignature := signAuditCheckpoint(key, checkpoint.Sequence, checkpoint.EventID, checkpoint.EventHash)
+	if !hmac.Equal([]byte(expectedSignature), []byte(checkpoint.Signature)) {
+		return models.AuditChainBreakCheckpointSignatureInvalid
+	}
+
+	if lo.FromPtr(event.Hash) != checkpoint.EventHash {
+		return models.AuditChainBreakCheckpointHashMismatch
+	}
+
+	return ""
+}
@@ -4,60 +4,404 @@ import (
 	"context"
 	"errors"
 	"firebase.google.com/go/v4/auth"
+	"github.com/google/uuid"
 	"github.com/in-rich/uservice-authentication/pkg/dao"
 	"github.com/in-rich/uservice-authentication/pkg/entities"
 	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
 )
 
 type AuthenticateService interface {
-	Exec(ctx context.Context, token string) (*models.User, error)
+	Exec(ctx context.Context, data *models.Authenticate) (*models.User, error)
 }
 
 type authenticateServiceImpl struct {
-	client            *auth.Client
-	getUserRepository dao.GetUserRepository
+	client                                      *auth.Client
+	getUserRepository                           dao.GetUserRepository
+	getPersonalAccessTokenRepository            dao.GetPersonalAccessTokenRepository
+	updatePersonalAccessTokenLastUsedRepository dao.UpdatePersonalAccessTokenLastUsedRepository
+	checkEmailDomainService                     CheckEmailDomainService
+	checkEmailVerificationService               CheckEmailVerificationService
+	getMembershipRepository                     dao.GetMembershipRepository
+	listMembershipsRepository                   dao.ListMembershipsRepository
+	recordAuditEvent                            RecordAuditEventService
+	provisionUserRepository                     dao.ProvisionUserRepository
+	provisioning                                models.UserProvisioningConfig
+	trackUserActivity                           TrackUserActivityService
+	assessSignInRisk                            AssessSignInRiskService
+	checkMFA                                    CheckMFAService
+	getImpersonationSessionRepository           dao.GetImpersonationSessionRepository
+	identityProviders                           []ExternalIdentityProvider
+	getUserAliasRepository                      dao.GetUserAliasRepository
 }
 
-func (s *authenticateServiceImpl) Exec(ctx context.Context, token string) (*models.User, error) {
-	if token == "" {
-		return nil, ErrUnauthenticated
+func (s *authenticateServiceImpl) verifyPersonalAccessToken(
+	ctx context.Context, token string,
+) (*entities.PersonalAccessToken, error) {
+	pat, err := s.getPersonalAccessTokenRepository.GetPersonalAccessToken(ctx, hashSecret(token))
+	if err != nil {
+		if errors.Is(err, dao.ErrPersonalAccessTokenNotFound) {
+			return nil, errors.Join(ErrVerifyToken, ErrPersonalAccessTokenNotFound)
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+	if pat.ExpiresAt != nil && !pat.ExpiresAt.After(now) {
+		return nil, errors.Join(ErrVerifyToken, ErrPersonalAccessTokenExpired)
+	}
+
+	if err := s.updatePersonalAccessTokenLastUsedRepository.UpdatePersonalAccessTokenLastUsed(ctx, *pat.ID, now); err != nil {
+		return nil, err
 	}
 
-	authToken, err := s.client.VerifyIDToken(ctx, token)
+	return pat, nil
+}
+
+func (s *authenticateServiceImpl) getMembership(
+	ctx context.Context, organizationID string, firebaseUID string,
+) (*models.Membership, error) {
+	parsedOrganizationID, err := uuid.Parse(organizationID)
 	if err != nil {
-		return nil, errors.Join(ErrVerifyToken, err)
+		return nil, errors.Join(ErrInvalidOrganizationID, err)
 	}
 
-	user, err := s.client.GetUser(ctx, authToken.UID)
+	membership, err := s.getMembershipRepository.GetMembership(ctx, parsedOrganizationID, firebaseUID)
 	if err != nil {
+		if errors.Is(err, dao.ErrMembershipNotFound) {
+			return nil, ErrNotOrganizationMember
+		}
+
 		return nil, err
 	}
 
-	// Force users to verify their email to use the service.
-	if user.EmailVerified == false {
-		return nil, ErrEmailNotVerified
+	return membershipToModel(membership), nil
+}
+
+func (s *authenticateServiceImpl) listMemberships(
+	ctx context.Context, firebaseUID string,
+) ([]*models.Membership, error) {
+	memberships, err := s.listMembershipsRepository.ListMemberships(ctx, firebaseUID)
+	if err != nil {
+		return nil, err
 	}
 
-	extra, err := s.getUserRepository.GetUser(ctx, user.UID)
+	return lo.Map(memberships, func(item *entities.Membership, _ int) *models.Membership {
+		return membershipToModel(item)
+	}), nil
+}
+
+// verifyImpersonationSession checks that the impersonation session of an ID token is still running, for the user the
+// token was issued to.
+func (s *authenticateServiceImpl) verifyImpersonationSession(
+	ctx context.Context, id string, tenantID string, uid string,
+) (*entities.ImpersonationSession, error) {
+	sessionID, err := uuid.Parse(id)
 	if err != nil {
-		if !errors.Is(err, dao.ErrUserNotFound) {
-			return nil, err
+		return nil, errors.Join(ErrVerifyToken, ErrInvalidImpersonationSessionID, err)
+	}
+
+	session, err := s.getImpersonationSessionRepository.GetImpersonationSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, dao.ErrImpersonationSessionNotFound) {
+			return nil, errors.Join(ErrVerifyToken, ErrImpersonationSessionNotFound)
 		}
 
-		// If no extra information found, just return the firebase user with their default value.
-		extra = new(entities.User)
+		return nil, err
+	}
+
+	if session.TenantID != tenantID || session.TargetUID != uid {
+		return nil, errors.Join(ErrVerifyToken, ErrImpersonationSessionNotFound)
+	}
+
+	// ID tokens are refreshed for as long as the client wants, so the session is what bounds their lifetime.
+	if session.EndedAt != nil || !session.ExpiresAt.After(time.Now()) {
+		return nil, errors.Join(ErrVerifyToken, ErrImpersonationSessionEnded)
+	}
+
+	return session, nil
+}
+
+// signInSecondFactor returns the second factor an ID token was obtained with, or an empty string for single factor
+// sign-ins.
+func signInSecondFactor(token *auth.Token) string {
+	firebase, _ := token.Claims["firebase"].(map[string]interface{})
+	secondFactor, _ := firebase["sign_in_second_factor"].(string)
+
+	return secondFactor
+}
+
+// provisionUser creates the missing row of a user, when provisioning is enabled. Otherwise, the user is returned
+// with their default values.
+func (s *authenticateServiceImpl) provisionUser(
+	ctx context.Context, user *identity,
+) (*entities.User, error) {
+	if !s.provisioning.Enabled {
+		return new(entities.User), nil
 	}
 
-	return &models.User{
-		PublicIdentifier: extra.PublicIdentifier,
-		FirebaseUID:      user.UID,
-		Email:            user.Email,
+	candidates, err := defaultPublicIdentifiers(user.displayName, user.email)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.provisionUserRepository.ProvisionUser(ctx, user.tenantID, user.uid, candidates)
+}
+
+// authenticate also returns the UID of the user, as soon as it is known, so failures can be audited.
+func (s *authenticateServiceImpl) authenticate(ctx context.Context, data *models.Authenticate) (string, *models.User, error) {
+	if data.Token == "" {
+		return "", nil, ErrUnauthenticated
+	}
+
+	var uid, provider, tenantID, secondFactor, impersonator, identityProvider, mintedBy string
+	var scopes []string
+	var staff bool
+	var authTime time.Time
+	// user is only set here for external providers. Firebase users are looked up afterward.
+	var user *identity
+
+	if isPersonalAccessToken(data.Token) {
+		pat, err := s.verifyPersonalAccessToken(ctx, data.Token)
+		if err != nil {
+			return "", nil, err
+		}
+
+		uid, tenantID, scopes = pat.FirebaseUID, pat.TenantID, pat.Scopes
+
+		// A token created weeks ago says nothing about who holds it now.
+		if data.MaxAuthAge > 0 {
+			return uid, nil, ErrReauthenticationRequired
+		}
+	} else if external := identityProviderFor(s.identityProviders, data.Token); external != nil {
+		var err error
+		if user, authTime, secondFactor, err = verifyExternalIDToken(ctx, external, data.Token); err != nil {
+			return "", nil, err
+		}
+
+		uid, tenantID, identityProvider = user.uid, user.tenantID, external.Config.Name
+		// Firebase names the providers of its own OIDC integration the same way.
+		provider = "oidc." + external.Config.Name
+
+		if data.MaxAuthAge > 0 && time.Since(authTime) > data.MaxAuthAge {
+			return uid, nil, ErrReauthenticationRequired
+		}
+	} else {
+		// Session cookies are issued from ID tokens, and carry the same claims. They last for weeks, so unlike ID
+		// tokens, they are checked against revocations and disabled accounts on every use.
+		verify := s.client.VerifyIDToken
+		if isSessionCookie(data.Token) {
+			verify = s.client.VerifySessionCookieAndCheckRevoked
+		}
+
+		authToken, err := verify(ctx, data.Token)
+		if err != nil {
+			return "", nil, errors.Join(ErrVerifyToken, err)
+		}
+
+		uid, provider, tenantID = authToken.UID, authToken.Firebase.SignInProvider, authToken.Firebase.Tenant
+		authTime = time.Unix(authToken.AuthTime, 0)
+		secondFactor = signInSecondFactor(authToken)
+		mintedBy, _ = authToken.Claims[mintedByClaim].(string)
+
+		// A stolen token stays valid for up to an hour, and is silently refreshed by the client afterward. Sensitive
+		// operations require the user to have entered their credentials recently instead. Tokens minted by a backend
+		// get a fresh auth time without the user entering anything, so they never count as a recent sign-in.
+		if data.MaxAuthAge > 0 && (mintedBy != "" || time.Since(authTime) > data.MaxAuthAge) {
+			return uid, nil, ErrReauthenticationRequired
+		}
+
+		if sessionID, ok := authToken.Claims[impersonationSessionClaim].(string); ok {
+			session, err := s.verifyImpersonationSession(ctx, sessionID, tenantID, uid)
+			if err != nil {
+				return uid, nil, err
+			}
+
+			impersonator, scopes = session.ImpersonatorUID, session.Scopes
+		} else {
+			staff = isStaff(authToken.Claims)
+		}
+	}
+
+	if user == nil {
+		client, err := firebaseUsersForTenant(s.client, tenantID)
+		if err != nil {
+			return uid, nil, err
+		}
+
+		record, err := client.GetUser(ctx, uid)
+		if err != nil {
+			return uid, nil, err
+		}
+
+		user = firebaseIdentity(record)
+
+		if requiresExternalIdentityProvider(s.identityProviders, user) {
+			return uid, nil, ErrIdentityProviderRequired
+		}
+	}
+
+	// Personal access tokens outlive Firebase sessions, so they must not survive the account being disabled.
+	if scopes != nil && user.disabled {
+		return uid, nil, errors.Join(ErrVerifyToken, ErrUserDisabled)
+	}
+
+	emailVerification, err := s.checkEmailVerificationService.Exec(ctx, &models.CheckEmailVerification{
+		EmailVerified:  user.emailVerified,
+		SignInProvider: provider,
+		CreatedAt:      user.createdAt,
+	})
+	if err != nil {
+		return uid, nil, err
+	}
+
+	// Users let in before verifying their email must not pass allow rules with an address they may not own.
+	err = s.checkEmailDomainService.Exec(ctx, &models.CheckEmailDomain{
+		Email:         user.email,
+		EmailVerified: user.emailVerified,
+		TenantID:      user.tenantID,
+	})
+	if err != nil {
+		return uid, nil, err
+	}
+
+	// Linked accounts act as the user they were linked to.
+	if uid, err = resolveUserAlias(ctx, s.getUserAliasRepository, tenantID, user.uid); err != nil {
+		return user.uid, nil, err
+	}
+	user.uid = uid
+
+	extra, err := s.getUserRepository.GetUser(ctx, tenantID, user.uid)
+	if err != nil && !errors.Is(err, dao.ErrUserNotFound) {
+		return uid, nil, err
+	}
+
+	var membership *models.Membership
+	if data.OrganizationID != "" {
+		if membership, err = s.getMembership(ctx, data.OrganizationID, user.uid); err != nil {
+			return uid, nil, err
+		}
+	}
+
+	// Personal access tokens are used by scripts, which have no second factor, and whose location says nothing about
+	// the user. The same goes for staff impersonating the user.
+	if scopes == nil {
+		// Leaving the organization out must not skip its policy, so users acting for none are held to the policies of
+		// all their organizations. Users who signed in with a second factor satisfy all of them anyway.
+		var memberships []*models.Membership
+		if membership == nil && secondFactor == "" {
+			if memberships, err = s.listMemberships(ctx, user.uid); err != nil {
+				return uid, nil, err
+			}
+		}
+
+		err := s.checkMFA.Exec(ctx, &models.CheckMFA{
+			SecondFactor: secondFactor,
+			Membership:   membership,
+			Memberships:  memberships,
+		})
+		if err != nil {
+			return uid, nil, err
+		}
+
+		_, err = s.assessSignInRisk.Exec(ctx, &models.AssessSignInRisk{
+			TenantID:         tenantID,
+			FirebaseUID:      user.uid,
+			PublicIdentifier: lo.FromPtr(extra).PublicIdentifier,
+			AuthTime:         authTime,
+		})
+		if err != nil {
+			return uid, nil, err
+		}
+	}
+
+	// Users are only provisioned once they are let in.
+	if extra == nil {
+		if extra, err = s.provisionUser(ctx, user); err != nil {
+			return uid, nil, err
+		}
+	}
+
+	return uid, &models.User{
+		PublicIdentifier:  extra.PublicIdentifier,
+		TenantID:          tenantID,
+		FirebaseUID:       user.uid,
+		Email:             user.email,
+		Scopes:            scopes,
+		AuthTime:          authTime,
+		EmailVerification: emailVerification,
+		Membership:        membership,
+		Staff:             staff,
+		Impersonator:      impersonator,
+		IdentityProvider:  identityProvider,
+		MintedBy:          mintedBy,
 	}, nil
 }
 
-func NewAuthenticateService(client *auth.Client, getUserRepository dao.GetUserRepository) AuthenticateService {
+func (s *authenticateServiceImpl) Exec(ctx context.Context, data *models.Authenticate) (*models.User, error) {
+	uid, user, err := s.authenticate(ctx, data)
+	if err != nil {
+		// Missing, malformed or forged tokens belong to no one, and anyone can send as many as they like.
+		if uid == "" {
+			return nil, err
+		}
+
+		auditErr := s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+			SubjectUID: uid,
+			Action:     models.AuditActionAuthenticate,
+			Outcome:    models.AuditOutcomeFailure,
+			Reason:     err.Error(),
+		})
+
+		return nil, errors.Join(err, auditErr)
+	}
+
+	// Staff looking around on behalf of the user must not make them look active.
+	if user.Impersonator == "" {
+		s.trackUserActivity.Exec(ctx, &models.TrackUserActivity{TenantID: user.TenantID, FirebaseUID: user.FirebaseUID})
+	}
+
+	return user, nil
+}
+
+func NewAuthenticateService(
+	client *auth.Client,
+	getUserRepository dao.GetUserRepository,
+	getPersonalAccessTokenRepository dao.GetPersonalAccessTokenRepository,
+	updatePersonalAccessTokenLastUsedRepository dao.UpdatePersonalAccessTokenLastUsedRepository,
+	checkEmailDomainService CheckEmailDomainService,
+	checkEmailVerificationService CheckEmailVerificationService,
+	getMembershipRepository dao.GetMembershipRepository,
+	listMembershipsRepository dao.ListMembershipsRepository,
+	recordAuditEvent RecordAuditEventService,
+	provisionUserRepository dao.ProvisionUserRepository,
+	provisioning models.UserProvisioningConfig,
+	trackUserActivity TrackUserActivityService,
+	assessSignInRisk AssessSignInRiskService,
+	checkMFA CheckMFAService,
+	getImpersonationSessionRepository dao.GetImpersonationSessionRepository,
+	identityProviders []ExternalIdentityProvider,
+	getUserAliasRepository dao.GetUserAliasRepository,
+) AuthenticateService {
 	return &authenticateServiceImpl{
-		client:            client,
-		getUserRepository: getUserRepository,
+		client:                           client,
+		getUserRepository:                getUserRepository,
+		getPersonalAccessTokenRepository: getPersonalAccessTokenRepository,
+		updatePersonalAccessTokenLastUsedRepository: updatePersonalAccessTokenLastUsedRepository,
+		checkEmailDomainService:                     checkEmailDomainService,
+		checkEmailVerificationService:               checkEmailVerificationService,
+		getMembershipRepository:                     getMembershipRepository,
+		listMembershipsRepository:                   listMembershipsRepository,
+		recordAuditEvent:                            recordAuditEvent,
+		provisionUserRepository:                     provisionUserRepository,
+		provisioning:                                provisioning,
+		trackUserActivity:                           trackUserActivity,
+		assessSignInRisk:                            assessSignInRisk,
+		checkMFA:                                    checkMFA,
+		getImpersonationSessionRepository:           getImpersonationSessionRepository,
+		identityProviders:                           identityProviders,
+		getUserAliasRepository:                      getUserAliasRepository,
 	}
 }
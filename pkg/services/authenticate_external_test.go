@@ -0,0 +1,313 @@
+package services_test
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// externalIDToken only carries the issuer, which is all Authenticate reads before handing the token to the provider.
+var externalIDToken = "eyJhbGciOiJSUzI1NiJ9." +
+	base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://idp.acme.com","sub":"subject-1"}`)) +
+	".signature"
+
+func TestAuthenticateExternalIdentityProvider(t *testing.T) {
+	testData := []struct {
+		name string
+
+		config     models.IdentityProviderConfig
+		maxAuthAge time.Duration
+
+		verifyIDTokenResponse *clients.IdentityClaims
+		verifyIDTokenErr      error
+
+		shouldCallCheckEmailVerification bool
+		getUserAliasResponse             *entities.UserAlias
+		shouldCallGetUser                bool
+		getUserResponse                  *entities.User
+		getUserErr                       error
+
+		expectSecondFactor string
+
+		expect           *models.User
+		expectErr        error
+		anonymousFailure bool
+	}{
+		{
+			name:   "SignIn",
+			config: models.IdentityProviderConfig{Name: "acme", EmailDomains: []string{"acme.com"}},
+			verifyIDTokenResponse: &clients.IdentityClaims{
+				Subject:       "subject-1",
+				Email:         "user@acme.com",
+				EmailVerified: true,
+				AuthTime:      time.Now(),
+			},
+			shouldCallCheckEmailVerification: true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "acme:subject-1",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "acme:subject-1",
+				Email:            "user@acme.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				IdentityProvider: "acme",
+			},
+		},
+		{
+			name:   "TenantProvider",
+			config: models.IdentityProviderConfig{Name: "acme", TenantID: "tenant-1"},
+			verifyIDTokenResponse: &clients.IdentityClaims{
+				Subject:       "subject-1",
+				Email:         "user@gmail.com",
+				EmailVerified: true,
+				AuthTime:      time.Now(),
+			},
+			shouldCallCheckEmailVerification: true,
+			shouldCallGetUser:                true,
+			getUserErr:                       dao.ErrUserNotFound,
+			expect: &models.User{
+				TenantID:    "tenant-1",
+				FirebaseUID: "acme:subject-1",
+				Email:       "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				IdentityProvider: "acme",
+			},
+		},
+		{
+			name:   "MultiFactor",
+			config: models.IdentityProviderConfig{Name: "acme"},
+			verifyIDTokenResponse: &clients.IdentityClaims{
+				Subject:       "subject-1",
+				Email:         "user@acme.com",
+				EmailVerified: true,
+				AuthTime:      time.Now(),
+				AuthMethods:   []string{"pwd", "mfa"},
+			},
+			shouldCallCheckEmailVerification: true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "acme:subject-1",
+			},
+			expectSecondFactor: "mfa",
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "acme:subject-1",
+				Email:            "user@acme.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				IdentityProvider: "acme",
+			},
+		},
+		{
+			name:   "LinkedAccount",
+			config: models.IdentityProviderConfig{Name: "acme"},
+			verifyIDTokenResponse: &clients.IdentityClaims{
+				Subject:       "subject-1",
+				Email:         "user@acme.com",
+				EmailVerified: true,
+				AuthTime:      time.Now(),
+			},
+			shouldCallCheckEmailVerification: true,
+			getUserAliasResponse: &entities.UserAlias{
+				AliasUID:     "acme:subject-1",
+				CanonicalUID: "firebase-uid-1",
+			},
+			shouldCallGetUser: true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "firebase-uid-1",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "firebase-uid-1",
+				Email:            "user@acme.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				IdentityProvider: "acme",
+			},
+		},
+		{
+			name:   "DomainMismatch",
+			config: models.IdentityProviderConfig{Name: "acme", EmailDomains: []string{"acme.com"}},
+			verifyIDTokenResponse: &clients.IdentityClaims{
+				Subject:  "subject-1",
+				Email:    "user@gmail.com",
+				AuthTime: time.Now(),
+			},
+			expectErr:        services.ErrIdentityProviderDomainMismatch,
+			anonymousFailure: true,
+		},
+		{
+			name:             "VerifyIDTokenError",
+			config:           models.IdentityProviderConfig{Name: "acme"},
+			verifyIDTokenErr: clients.ErrOIDCInvalidToken,
+			expectErr:        services.ErrVerifyToken,
+			anonymousFailure: true,
+		},
+		{
+			name:       "ReauthenticationRequired",
+			config:     models.IdentityProviderConfig{Name: "acme"},
+			maxAuthAge: 5 * time.Minute,
+			verifyIDTokenResponse: &clients.IdentityClaims{
+				Subject:  "subject-1",
+				Email:    "user@acme.com",
+				AuthTime: time.Now().Add(-time.Hour),
+			},
+			expectErr: services.ErrReauthenticationRequired,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			identityProvider := clientsmocks.NewMockIdentityProvider(t)
+			getUserRepository := daomocks.NewMockGetUserRepository(t)
+			checkEmailDomainService := servicesmocks.NewMockCheckEmailDomainService(t)
+			listMembershipsRepository := daomocks.NewMockListMembershipsRepository(t)
+			checkEmailVerificationService := servicesmocks.NewMockCheckEmailVerificationService(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+			trackUserActivityService := servicesmocks.NewMockTrackUserActivityService(t)
+			assessSignInRiskService := servicesmocks.NewMockAssessSignInRiskService(t)
+			checkMFAService := servicesmocks.NewMockCheckMFAService(t)
+			getUserAliasRepository := daomocks.NewMockGetUserAliasRepository(t)
+
+			identityProvider.On("Issuer").Return("https://idp.acme.com")
+			identityProvider.
+				On("VerifyIDToken", context.TODO(), externalIDToken).
+				Return(tt.verifyIDTokenResponse, tt.verifyIDTokenErr)
+
+			if tt.shouldCallCheckEmailVerification {
+				checkEmailVerificationService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.CheckEmailVerification) bool {
+						return in.SignInProvider == "oidc.acme"
+					})).
+					Return(&models.EmailVerificationDecision{Status: models.EmailVerificationStatusVerified}, nil)
+				checkEmailDomainService.
+					On("Exec", context.TODO(), &models.CheckEmailDomain{
+						Email:         tt.verifyIDTokenResponse.Email,
+						EmailVerified: tt.verifyIDTokenResponse.EmailVerified,
+						TenantID:      tt.config.TenantID,
+					}).
+					Return(nil)
+			}
+
+			uid := "acme:subject-1"
+			if tt.getUserAliasResponse != nil {
+				uid = tt.getUserAliasResponse.CanonicalUID
+			}
+
+			if tt.shouldCallGetUser {
+				if tt.getUserAliasResponse != nil {
+					getUserAliasRepository.
+						On("GetUserAlias", context.TODO(), tt.config.TenantID, "acme:subject-1").
+						Return(tt.getUserAliasResponse, nil)
+				} else {
+					getUserAliasRepository.
+						On("GetUserAlias", context.TODO(), tt.config.TenantID, "acme:subject-1").
+						Return(nil, dao.ErrUserAliasNotFound)
+				}
+
+				getUserRepository.
+					On("GetUser", context.TODO(), tt.config.TenantID, uid).
+					Return(tt.getUserResponse, tt.getUserErr)
+			}
+
+			if tt.expectErr == nil {
+				// Users who signed in with a second factor satisfy the policies of all their organizations.
+				if tt.expectSecondFactor == "" {
+					listMembershipsRepository.
+						On("ListMemberships", context.TODO(), uid).
+						Return([]*entities.Membership{}, nil)
+				}
+
+				checkMFAService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.CheckMFA) bool {
+						return in.SecondFactor == tt.expectSecondFactor
+					})).
+					Return(nil)
+				assessSignInRiskService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.AssessSignInRisk) bool {
+						return in.FirebaseUID == uid
+					})).
+					Return(&models.SignInRisk{}, nil)
+				trackUserActivityService.
+					On("Exec", context.TODO(), &models.TrackUserActivity{
+						TenantID:    tt.config.TenantID,
+						FirebaseUID: uid,
+					}).
+					Return()
+			} else if !tt.anonymousFailure {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionAuthenticate && in.Outcome == models.AuditOutcomeFailure
+					})).
+					Return(nil)
+			}
+
+			service := services.NewAuthenticateService(
+				nil,
+				getUserRepository,
+				daomocks.NewMockGetPersonalAccessTokenRepository(t),
+				daomocks.NewMockUpdatePersonalAccessTokenLastUsedRepository(t),
+				checkEmailDomainService,
+				checkEmailVerificationService,
+				daomocks.NewMockGetMembershipRepository(t),
+				listMembershipsRepository,
+				recordAuditEventService,
+				daomocks.NewMockProvisionUserRepository(t),
+				models.UserProvisioningConfig{},
+				trackUserActivityService,
+				assessSignInRiskService,
+				checkMFAService,
+				daomocks.NewMockGetImpersonationSessionRepository(t),
+				[]services.ExternalIdentityProvider{{Config: tt.config, Provider: identityProvider}},
+				getUserAliasRepository,
+			)
+
+			user, err := service.Exec(context.TODO(), &models.Authenticate{
+				Token:      externalIDToken,
+				MaxAuthAge: tt.maxAuthAge,
+			})
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			if user != nil {
+				require.Equal(t, tt.verifyIDTokenResponse.AuthTime, user.AuthTime)
+				user.AuthTime = time.Time{}
+			}
+
+			require.Equal(t, tt.expect, user)
+
+			identityProvider.AssertExpectations(t)
+			getUserRepository.AssertExpectations(t)
+			checkEmailDomainService.AssertExpectations(t)
+			listMembershipsRepository.AssertExpectations(t)
+			checkEmailVerificationService.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+			trackUserActivityService.AssertExpectations(t)
+			assessSignInRiskService.AssertExpectations(t)
+			checkMFAService.AssertExpectations(t)
+			getUserAliasRepository.AssertExpectations(t)
+		})
+	}
+}
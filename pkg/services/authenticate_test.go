@@ -3,17 +3,27 @@ package services_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"firebase.google.com/go/v4/auth"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/in-rich/uservice-authentication/config"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
 	"github.com/in-rich/uservice-authentication/pkg/dao"
 	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
 	"github.com/in-rich/uservice-authentication/pkg/entities"
 	"github.com/in-rich/uservice-authentication/pkg/models"
 	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 var authenticateFixtures = []*FixtureUser{
@@ -33,13 +43,26 @@ var authenticateFixtures = []*FixtureUser{
 		Password:      "password",
 		PhotoURL:      "https://image.png",
 	},
+	{
+		Email:         "user3@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user three",
+		UID:           "user-three-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
 }
 
 func getIDToken(t *testing.T, uid string) string {
+	return getIDTokenWithClaims(t, uid, nil)
+}
+
+// getIDTokenWithClaims signs the user in with a custom token carrying the given claims, which end up in the ID token.
+func getIDTokenWithClaims(t *testing.T, uid string, claims map[string]interface{}) string {
 	const verifyTokenURL = "http://127.0.0.1:1151/identitytoolkit.googleapis.com/v1/accounts:signInWithCustomToken"
 
 	// https://stackoverflow.com/questions/48268478/in-firebase-how-to-generate-an-idtoken-on-the-server-for-testing-purposes
-	customToken, err := config.AuthClient.CustomToken(context.TODO(), uid)
+	customToken, err := config.AuthClient.CustomTokenWithClaims(context.TODO(), uid, claims)
 	require.NoError(t, err)
 
 	jsonData, err := json.Marshal(map[string]interface{}{
@@ -80,29 +103,111 @@ func getIDToken(t *testing.T, uid string) string {
 	return responseData.IDToken
 }
 
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 func TestAuthenticate(t *testing.T) {
 	require.NoError(t, CreateUsersFixtures(authenticateFixtures))
 	defer CleanUsersFixtures(authenticateFixtures)
 
 	validIDToken := getIDToken(t, "user-one-uid")
 	emailNotValidatedIDToken := getIDToken(t, "user-two-uid")
+	sessionCookie, err := config.AuthClient.SessionCookie(context.TODO(), validIDToken, time.Hour)
+	require.NoError(t, err)
+	// The account is disabled after the session cookie was issued.
+	disabledSessionCookie, err := config.AuthClient.SessionCookie(
+		context.TODO(), getIDToken(t, "user-three-uid"), time.Hour,
+	)
+	require.NoError(t, err)
+	_, err = config.AuthClient.UpdateUser(context.TODO(), "user-three-uid", (&auth.UserToUpdate{}).Disabled(true))
+	require.NoError(t, err)
+	staffIDToken := getIDTokenWithClaims(t, "user-one-uid", map[string]interface{}{"staff": true})
+	impersonationIDToken := getIDTokenWithClaims(t, "user-one-uid", map[string]interface{}{
+		"impersonator":          "staff-uid-1",
+		"impersonation_session": "00000000-0000-0000-0000-000000000001",
+	})
+	mintedIDToken := getIDTokenWithClaims(t, "user-one-uid", map[string]interface{}{
+		"minted_by": "backend@project.iam.gserviceaccount.com",
+	})
 
 	testData := []struct {
 		name string
 
-		token string
+		token          string
+		organizationID string
+		maxAuthAge     time.Duration
+
+		shouldCallGetPersonalAccessToken bool
+		getPersonalAccessTokenResponse   *entities.PersonalAccessToken
+		getPersonalAccessTokenErr        error
+
+		shouldCallUpdatePersonalAccessTokenLastUsed bool
+
+		shouldCallCheckEmailVerification bool
+		checkEmailVerificationErr        error
+
+		shouldCallCheckEmailDomain bool
+		checkEmailDomainErr        error
 
 		shouldCallGetUser bool
 		getUserResponse   *entities.User
 		getUserErr        error
 
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		listMembershipsResponse []*entities.Membership
+		listMembershipsErr      error
+
+		provisioning            bool
+		shouldCallProvisionUser bool
+		provisionUserResponse   *entities.User
+		provisionUserErr        error
+
+		shouldCallGetImpersonationSession bool
+		getImpersonationSessionResponse   *entities.ImpersonationSession
+		getImpersonationSessionErr        error
+
+		checkMFAErr error
+
+		assessSignInRiskErr error
+
+		identityProviders []models.IdentityProviderConfig
+
 		expect    *models.User
 		expectErr error
+		// anonymousFailure is set when the token fails before its user is known, and is not audited.
+		anonymousFailure bool
 	}{
 		{
-			name:              "ValidToken",
-			token:             validIDToken,
-			shouldCallGetUser: true,
+			name:                             "ValidToken",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+			},
+		},
+		{
+			name:                             "RecentAuthentication",
+			token:                            validIDToken,
+			maxAuthAge:                       time.Hour,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
 			getUserResponse: &entities.User{
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
@@ -111,59 +216,683 @@ func TestAuthenticate(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
 			},
 		},
 		{
-			name:              "NoExtraData",
-			token:             validIDToken,
-			shouldCallGetUser: true,
-			getUserErr:        dao.ErrUserNotFound,
+			name:                             "SessionCookie",
+			token:                            sessionCookie,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
 			expect: &models.User{
-				PublicIdentifier: "",
+				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
 			},
 		},
 		{
-			name:              "GetUserError",
-			token:             validIDToken,
-			shouldCallGetUser: true,
-			getUserErr:        FooErr,
-			expectErr:         FooErr,
+			name:                             "Staff",
+			token:                            staffIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				Staff: true,
+			},
+		},
+		{
+			name:             "DisabledUserSessionCookie",
+			token:            disabledSessionCookie,
+			expectErr:        services.ErrVerifyToken,
+			anonymousFailure: true,
+		},
+		{
+			name:                              "Impersonation",
+			token:                             impersonationIDToken,
+			shouldCallGetImpersonationSession: true,
+			getImpersonationSessionResponse: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "user-one-uid",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Now().Add(time.Hour)),
+			},
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				Scopes:           []string{models.ScopeUserRead},
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				Impersonator: "staff-uid-1",
+			},
 		},
 		{
-			name:      "EmptyToken",
-			token:     "",
-			expectErr: services.ErrUnauthenticated,
+			name:                              "ImpersonationSessionEnded",
+			token:                             impersonationIDToken,
+			shouldCallGetImpersonationSession: true,
+			getImpersonationSessionResponse: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "user-one-uid",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Now().Add(time.Hour)),
+				EndedAt:         lo.ToPtr(time.Now().Add(-time.Minute)),
+			},
+			expectErr: services.ErrImpersonationSessionEnded,
 		},
 		{
-			name:      "InvalidToken",
-			token:     "invalid-token",
+			name:                              "ImpersonationSessionExpired",
+			token:                             impersonationIDToken,
+			shouldCallGetImpersonationSession: true,
+			getImpersonationSessionResponse: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "user-one-uid",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Now().Add(-time.Minute)),
+			},
 			expectErr: services.ErrVerifyToken,
 		},
 		{
-			name:      "EmailNotVerified",
-			token:     emailNotValidatedIDToken,
-			expectErr: services.ErrEmailNotVerified,
+			name:                              "ImpersonationSessionOfAnotherUser",
+			token:                             impersonationIDToken,
+			shouldCallGetImpersonationSession: true,
+			getImpersonationSessionResponse: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "user-two-uid",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Now().Add(time.Hour)),
+			},
+			expectErr: services.ErrImpersonationSessionNotFound,
+		},
+		{
+			name:                              "ImpersonationSessionNotFound",
+			token:                             impersonationIDToken,
+			shouldCallGetImpersonationSession: true,
+			getImpersonationSessionErr:        dao.ErrImpersonationSessionNotFound,
+			expectErr:                         services.ErrVerifyToken,
+		},
+		{
+			name:       "ReauthenticationRequired",
+			token:      validIDToken,
+			maxAuthAge: time.Nanosecond,
+			expectErr:  services.ErrReauthenticationRequired,
+		},
+		{
+			name:                             "MintedToken",
+			token:                            mintedIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				MintedBy: "backend@project.iam.gserviceaccount.com",
+			},
+		},
+		{
+			// The backend signed in on behalf of the user, who never entered their credentials.
+			name:       "MintedTokenReauthenticationRequired",
+			token:      mintedIDToken,
+			maxAuthAge: time.Hour,
+			expectErr:  services.ErrReauthenticationRequired,
+		},
+		{
+			name:                             "NoExtraData",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserErr:                       dao.ErrUserNotFound,
+			expect: &models.User{
+				PublicIdentifier: "",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+			},
+		},
+		{
+			name:                             "ProvisionUser",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserErr:                       dao.ErrUserNotFound,
+			provisioning:                     true,
+			shouldCallProvisionUser:          true,
+			provisionUserResponse: &entities.User{
+				PublicIdentifier: "user-one",
+				FirebaseUID:      "user-one-uid",
+			},
+			expect: &models.User{
+				PublicIdentifier: "user-one",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+			},
+		},
+		{
+			name:                             "AlreadyProvisioned",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			provisioning: true,
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+			},
+		},
+		{
+			name:                             "ProvisionUserError",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserErr:                       dao.ErrUserNotFound,
+			provisioning:                     true,
+			shouldCallProvisionUser:          true,
+			provisionUserErr:                 FooErr,
+			expectErr:                        FooErr,
+		},
+		{
+			name:                             "StepUpRequired",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			assessSignInRiskErr: services.ErrStepUpRequired,
+			expectErr:           services.ErrStepUpRequired,
+		},
+		{
+			// Users turned away are not provisioned.
+			name:                             "StepUpRequiredNotProvisioned",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserErr:                       dao.ErrUserNotFound,
+			provisioning:                     true,
+			assessSignInRiskErr:              services.ErrStepUpRequired,
+			expectErr:                        services.ErrStepUpRequired,
+		},
+		{
+			name:                             "MFARequiredNotProvisioned",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserErr:                       dao.ErrUserNotFound,
+			provisioning:                     true,
+			checkMFAErr:                      services.ErrMFARequired,
+			expectErr:                        services.ErrMFARequired,
+		},
+		{
+			name:                             "MFARequired",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			checkMFAErr: services.ErrMFARequired,
+			expectErr:   services.ErrMFARequired,
+		},
+		{
+			// Users acting for no organization are held to the policies of all their organizations.
+			name:                             "MFARequiredByMembership",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			listMembershipsResponse: []*entities.Membership{
+				{
+					OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Organization: &entities.Organization{
+						ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Name:       "organization-1",
+						RequireMFA: true,
+					},
+					FirebaseUID: "user-one-uid",
+					Role:        entities.MembershipRoleMember,
+				},
+			},
+			checkMFAErr: services.ErrMFARequired,
+			expectErr:   services.ErrMFARequired,
+		},
+		{
+			name:                             "ListMembershipsError",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			listMembershipsErr: FooErr,
+			expectErr:          FooErr,
+		},
+		{
+			name:                             "ActiveOrganization",
+			token:                            validIDToken,
+			organizationID:                   "00000000-0000-0000-0000-000000000001",
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				FirebaseUID:    "user-one-uid",
+				Role:           entities.MembershipRoleAdmin,
+				Organization: &entities.Organization{
+					ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Name: "organization-1",
+				},
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+				Membership: &models.Membership{
+					Organization: &models.Organization{
+						ID:   "00000000-0000-0000-0000-000000000001",
+						Name: "organization-1",
+					},
+					FirebaseUID: "user-one-uid",
+					Role:        models.MembershipRoleAdmin,
+				},
+			},
+		},
+		{
+			name:                             "NotOrganizationMember",
+			token:                            validIDToken,
+			organizationID:                   "00000000-0000-0000-0000-000000000001",
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			shouldCallGetMembership: true,
+			getMembershipErr:        dao.ErrMembershipNotFound,
+			expectErr:               services.ErrNotOrganizationMember,
+		},
+		{
+			name:                             "InvalidOrganizationID",
+			token:                            validIDToken,
+			organizationID:                   "not-a-uuid",
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			expectErr: services.ErrInvalidOrganizationID,
+		},
+		{
+			name:                             "GetUserError",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			shouldCallGetUser:                true,
+			getUserErr:                       FooErr,
+			expectErr:                        FooErr,
+		},
+		{
+			name:             "EmptyToken",
+			token:            "",
+			expectErr:        services.ErrUnauthenticated,
+			anonymousFailure: true,
+		},
+		{
+			name:             "InvalidToken",
+			token:            "invalid-token",
+			expectErr:        services.ErrVerifyToken,
+			anonymousFailure: true,
+		},
+		{
+			name:                             "EmailNotVerified",
+			token:                            emailNotValidatedIDToken,
+			shouldCallCheckEmailVerification: true,
+			checkEmailVerificationErr:        services.ErrEmailNotVerified,
+			expectErr:                        services.ErrEmailNotVerified,
+		},
+		{
+			name:                             "EmailDomainBlocked",
+			token:                            validIDToken,
+			shouldCallCheckEmailVerification: true,
+			shouldCallCheckEmailDomain:       true,
+			checkEmailDomainErr:              services.ErrEmailDomainBlocked,
+			expectErr:                        services.ErrEmailDomainBlocked,
+		},
+		{
+			name:                             "PersonalAccessToken",
+			token:                            "inr_pat_foo",
+			shouldCallGetPersonalAccessToken: true,
+			getPersonalAccessTokenResponse: &entities.PersonalAccessToken{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+				ExpiresAt:   lo.ToPtr(time.Now().Add(time.Hour)),
+			},
+			shouldCallUpdatePersonalAccessTokenLastUsed: true,
+			shouldCallCheckEmailVerification:            true,
+			shouldCallCheckEmailDomain:                  true,
+			shouldCallGetUser:                           true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				Scopes:           []string{models.ScopeUserRead},
+				EmailVerification: &models.EmailVerificationDecision{
+					Status: models.EmailVerificationStatusVerified,
+				},
+			},
+		},
+		{
+			// Personal access tokens have no sign-in time, and cannot perform sensitive operations.
+			name:                             "PersonalAccessTokenReauthenticationRequired",
+			token:                            "inr_pat_foo",
+			maxAuthAge:                       time.Hour,
+			shouldCallGetPersonalAccessToken: true,
+			getPersonalAccessTokenResponse: &entities.PersonalAccessToken{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserWrite},
+				ExpiresAt:   lo.ToPtr(time.Now().Add(time.Hour)),
+			},
+			shouldCallUpdatePersonalAccessTokenLastUsed: true,
+			expectErr: services.ErrReauthenticationRequired,
+		},
+		{
+			name:                             "PersonalAccessTokenNotFound",
+			token:                            "inr_pat_foo",
+			shouldCallGetPersonalAccessToken: true,
+			getPersonalAccessTokenErr:        dao.ErrPersonalAccessTokenNotFound,
+			expectErr:                        services.ErrVerifyToken,
+			anonymousFailure:                 true,
+		},
+		{
+			name:                             "PersonalAccessTokenExpired",
+			token:                            "inr_pat_foo",
+			shouldCallGetPersonalAccessToken: true,
+			getPersonalAccessTokenResponse: &entities.PersonalAccessToken{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+				ExpiresAt:   lo.ToPtr(time.Now().Add(-time.Hour)),
+			},
+			expectErr:        services.ErrVerifyToken,
+			anonymousFailure: true,
+		},
+		{
+			name:  "IdentityProviderRequired",
+			token: validIDToken,
+			identityProviders: []models.IdentityProviderConfig{
+				{Name: "acme", EmailDomains: []string{"gmail.com"}},
+			},
+			expectErr: services.ErrIdentityProviderRequired,
+		},
+		{
+			name:                             "GetPersonalAccessTokenError",
+			token:                            "inr_pat_foo",
+			shouldCallGetPersonalAccessToken: true,
+			getPersonalAccessTokenErr:        FooErr,
+			expectErr:                        FooErr,
+			anonymousFailure:                 true,
 		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			getUserRepository := daomocks.NewMockGetUserRepository(t)
+			getPersonalAccessTokenRepository := daomocks.NewMockGetPersonalAccessTokenRepository(t)
+			updatePersonalAccessTokenLastUsedRepository := daomocks.NewMockUpdatePersonalAccessTokenLastUsedRepository(t)
+			checkEmailDomainService := servicesmocks.NewMockCheckEmailDomainService(t)
+			checkEmailVerificationService := servicesmocks.NewMockCheckEmailVerificationService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			listMembershipsRepository := daomocks.NewMockListMembershipsRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+			provisionUserRepository := daomocks.NewMockProvisionUserRepository(t)
+			trackUserActivityService := servicesmocks.NewMockTrackUserActivityService(t)
+			assessSignInRiskService := servicesmocks.NewMockAssessSignInRiskService(t)
+			checkMFAService := servicesmocks.NewMockCheckMFAService(t)
+			getImpersonationSessionRepository := daomocks.NewMockGetImpersonationSessionRepository(t)
+			getUserAliasRepository := daomocks.NewMockGetUserAliasRepository(t)
+
+			if tt.shouldCallGetPersonalAccessToken {
+				getPersonalAccessTokenRepository.
+					On("GetPersonalAccessToken", context.TODO(), hashToken(tt.token)).
+					Return(tt.getPersonalAccessTokenResponse, tt.getPersonalAccessTokenErr)
+			}
+
+			if tt.shouldCallUpdatePersonalAccessTokenLastUsed {
+				updatePersonalAccessTokenLastUsedRepository.
+					On("UpdatePersonalAccessTokenLastUsed", context.TODO(), *tt.getPersonalAccessTokenResponse.ID, mock.Anything).
+					Return(nil)
+			}
+
+			if tt.shouldCallGetImpersonationSession {
+				getImpersonationSessionRepository.
+					On("GetImpersonationSession", context.TODO(), uuid.MustParse("00000000-0000-0000-0000-000000000001")).
+					Return(tt.getImpersonationSessionResponse, tt.getImpersonationSessionErr)
+			}
+
+			if tt.shouldCallCheckEmailVerification {
+				var decision *models.EmailVerificationDecision
+				if tt.checkEmailVerificationErr == nil {
+					decision = &models.EmailVerificationDecision{Status: models.EmailVerificationStatusVerified}
+				}
+
+				checkEmailVerificationService.
+					On("Exec", context.TODO(), mock.AnythingOfType("*models.CheckEmailVerification")).
+					Return(decision, tt.checkEmailVerificationErr)
+			}
+
+			if tt.shouldCallCheckEmailDomain {
+				checkEmailDomainService.
+					On("Exec", context.TODO(), &models.CheckEmailDomain{Email: "user@gmail.com", EmailVerified: true}).
+					Return(tt.checkEmailDomainErr)
+			}
 
 			if tt.shouldCallGetUser {
-				getUserRepository.On("GetUser", context.TODO(), "user-one-uid").Return(tt.getUserResponse, tt.getUserErr)
+				getUserAliasRepository.
+					On("GetUserAlias", context.TODO(), "", "user-one-uid").
+					Return(nil, dao.ErrUserAliasNotFound)
+				getUserRepository.On("GetUser", context.TODO(), "", "user-one-uid").Return(tt.getUserResponse, tt.getUserErr)
+			}
+
+			if tt.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), uuid.MustParse(tt.organizationID), "user-one-uid").
+					Return(tt.getMembershipResponse, tt.getMembershipErr)
+			}
+
+			if tt.shouldCallProvisionUser {
+				provisionUserRepository.
+					On("ProvisionUser", context.TODO(), "", "user-one-uid", mock.MatchedBy(func(candidates []string) bool {
+						return len(candidates) > 1 && candidates[0] == "user-one"
+					})).
+					Return(tt.provisionUserResponse, tt.provisionUserErr)
 			}
 
-			service := services.NewAuthenticateService(config.AuthClient, getUserRepository)
+			// Personal access tokens and impersonation sessions are neither checked for MFA, nor assessed for risk.
+			scoped := strings.HasPrefix(tt.token, "inr_pat_") || tt.shouldCallGetImpersonationSession
 
-			user, err := service.Exec(context.TODO(), tt.token)
+			checksMFA := (tt.expectErr == nil && !scoped) ||
+				tt.checkMFAErr != nil || tt.assessSignInRiskErr != nil || tt.provisionUserErr != nil
+
+			// Emulator tokens never carry a second factor, so users acting for no organization have their memberships
+			// checked.
+			if (checksMFA || tt.listMembershipsErr != nil) && tt.organizationID == "" {
+				listMembershipsRepository.
+					On("ListMemberships", context.TODO(), "user-one-uid").
+					Return(tt.listMembershipsResponse, tt.listMembershipsErr)
+			}
+			if checksMFA {
+				checkMFAService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.CheckMFA) bool {
+						return in.SecondFactor == "" && len(in.Memberships) == len(tt.listMembershipsResponse)
+					})).
+					Return(tt.checkMFAErr)
+			}
+			if (tt.expectErr == nil && !scoped) || tt.assessSignInRiskErr != nil || tt.provisionUserErr != nil {
+				assessSignInRiskService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.AssessSignInRisk) bool {
+						return in.FirebaseUID == "user-one-uid"
+					})).
+					Return(&models.SignInRisk{}, tt.assessSignInRiskErr)
+			}
+
+			// Successful authentications are tracked, unless made by an impersonator. Failed ones are audited, once
+			// their user is known.
+			if tt.expectErr == nil && tt.expect.Impersonator == "" {
+				trackUserActivityService.
+					On("Exec", context.TODO(), &models.TrackUserActivity{FirebaseUID: "user-one-uid"}).
+					Return()
+			}
+			if tt.expectErr != nil && !tt.anonymousFailure {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionAuthenticate && in.Outcome == models.AuditOutcomeFailure
+					})).
+					Return(nil)
+			}
+
+			identityProviders := lo.Map(
+				tt.identityProviders,
+				func(item models.IdentityProviderConfig, _ int) services.ExternalIdentityProvider {
+					provider := clientsmocks.NewMockIdentityProvider(t)
+					provider.On("Issuer").Return("https://idp.acme.com").Maybe()
+
+					return services.ExternalIdentityProvider{Config: item, Provider: provider}
+				},
+			)
+
+			service := services.NewAuthenticateService(
+				config.AuthClient,
+				getUserRepository,
+				getPersonalAccessTokenRepository,
+				updatePersonalAccessTokenLastUsedRepository,
+				checkEmailDomainService,
+				checkEmailVerificationService,
+				getMembershipRepository,
+				listMembershipsRepository,
+				recordAuditEventService,
+				provisionUserRepository,
+				models.UserProvisioningConfig{Enabled: tt.provisioning},
+				trackUserActivityService,
+				assessSignInRiskService,
+				checkMFAService,
+				getImpersonationSessionRepository,
+				identityProviders,
+				getUserAliasRepository,
+			)
+
+			user, err := service.Exec(context.TODO(), &models.Authenticate{
+				Token:          tt.token,
+				OrganizationID: tt.organizationID,
+				MaxAuthAge:     tt.maxAuthAge,
+			})
 
 			require.ErrorIs(t, err, tt.expectErr)
+
+			// ID tokens carry the time their fixture signed in. Personal access tokens have none.
+			if user != nil && !strings.HasPrefix(tt.token, "inr_pat_") {
+				require.False(t, user.AuthTime.IsZero())
+				user.AuthTime = time.Time{}
+			}
+
 			require.Equal(t, tt.expect, user)
 
 			getUserRepository.AssertExpectations(t)
+			getPersonalAccessTokenRepository.AssertExpectations(t)
+			updatePersonalAccessTokenLastUsedRepository.AssertExpectations(t)
+			checkEmailDomainService.AssertExpectations(t)
+			checkEmailVerificationService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			listMembershipsRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+			provisionUserRepository.AssertExpectations(t)
+			trackUserActivityService.AssertExpectations(t)
+			assessSignInRiskService.AssertExpectations(t)
+			checkMFAService.AssertExpectations(t)
+			getImpersonationSessionRepository.AssertExpectations(t)
+			getUserAliasRepository.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,13 @@
+package services
+
+import "time"
+
+// exponentialBackoff returns the delay before retrying an operation that already failed the given number of times.
+func exponentialBackoff(minBackoff time.Duration, maxBackoff time.Duration, attempts int) time.Duration {
+	backoff := minBackoff
+	for i := 0; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// bufferAuditEventServiceImpl records audit events in memory. They are written to the audit trail by
+// FlushAuditEventsService, so recording them never fails.
+type bufferAuditEventServiceImpl struct {
+	buffer         *AuditEventBuffer
+	trustedProxies int
+}
+
+func (s *bufferAuditEventServiceImpl) Exec(ctx context.Context, data *models.RecordAuditEvent) error {
+	event := auditEventData(ctx, s.trustedProxies, data)
+	// Events are written later, but dated from when they happened.
+	event.OccurredAt = time.Now()
+
+	s.buffer.add(event)
+	return nil
+}
+
+// NewBufferAuditEventService returns a RecordAuditEventService for hot paths, which does not wait for the audit
+// chain. It reads the address of the caller behind trustedProxies proxies. See ClientIP.
+func NewBufferAuditEventService(buffer *AuditEventBuffer, trustedProxies int) RecordAuditEventService {
+	return &bufferAuditEventServiceImpl{
+		buffer:         buffer,
+		trustedProxies: trustedProxies,
+	}
+}
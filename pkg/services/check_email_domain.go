@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CheckEmailDomainService enforces the email domain policy. Block rules without a tenant apply to every user, while
+// allow rules restrict the domains of the tenant they belong to. Only verified emails match allow rules, so users
+// without an email, such as phone or anonymous users, are refused by tenants with an allow-list. Rules are cached in
+// memory, and refreshed once they are older than the configured TTL.
+type CheckEmailDomainService interface {
+	Exec(ctx context.Context, data *models.CheckEmailDomain) error
+}
+
+type checkEmailDomainServiceImpl struct {
+	dao dao.ListEmailDomainRulesRepository
+	ttl time.Duration
+
+	mu        sync.RWMutex
+	rules     []*entities.EmailDomainRule
+	expiresAt time.Time
+}
+
+func (s *checkEmailDomainServiceImpl) listRules(ctx context.Context) ([]*entities.EmailDomainRule, error) {
+	s.mu.RLock()
+	if time.Now().Before(s.expiresAt) {
+		defer s.mu.RUnlock()
+		return s.rules, nil
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another caller may have refreshed the cache while we were waiting for the lock.
+	if time.Now().Before(s.expiresAt) {
+		return s.rules, nil
+	}
+
+	rules, err := s.dao.ListEmailDomainRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules = rules
+	s.expiresAt = time.Now().Add(s.ttl)
+
+	return rules, nil
+}
+
+// matchEmailDomain returns true if domain is matched by pattern. A pattern starting with "*." matches any subdomain
+// of the remaining domain, but not the domain itself.
+func matchEmailDomain(pattern string, domain string) bool {
+	pattern = strings.ToLower(pattern)
+
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return strings.HasSuffix(domain, suffix)
+	}
+
+	return domain == pattern
+}
+
+func (s *checkEmailDomainServiceImpl) Exec(ctx context.Context, data *models.CheckEmailDomain) error {
+	rules, err := s.listRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	domain := ""
+	if at := strings.LastIndex(data.Email, "@"); at >= 0 {
+		domain = strings.ToLower(data.Email[at+1:])
+	}
+
+	hasAllowList := false
+	allowed := false
+
+	for _, rule := range rules {
+		switch rule.Kind {
+		case entities.EmailDomainRuleBlock:
+			// Users without an email have no domain to block.
+			if data.Email == "" {
+				continue
+			}
+
+			if (rule.TenantID == "" || rule.TenantID == data.TenantID) && matchEmailDomain(rule.Domain, domain) {
+				return ErrEmailDomainBlocked
+			}
+		case entities.EmailDomainRuleAllow:
+			if rule.TenantID == data.TenantID {
+				hasAllowList = true
+				allowed = allowed || (data.EmailVerified && matchEmailDomain(rule.Domain, domain))
+			}
+		}
+	}
+
+	if hasAllowList && !allowed {
+		return ErrEmailDomainNotAllowed
+	}
+
+	return nil
+}
+
+func NewCheckEmailDomainService(dao dao.ListEmailDomainRulesRepository, ttl time.Duration) CheckEmailDomainService {
+	return &checkEmailDomainServiceImpl{
+		dao: dao,
+		ttl: ttl,
+	}
+}
@@ -0,0 +1,201 @@
+package services_test
+
+import (
+	"context"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var checkEmailDomainRules = []*entities.EmailDomainRule{
+	{
+		Domain: "*.disposable.com",
+		Kind:   entities.EmailDomainRuleBlock,
+	},
+	{
+		Domain: "spam.com",
+		Kind:   entities.EmailDomainRuleBlock,
+	},
+	{
+		TenantID: "tenant-1",
+		Domain:   "company.com",
+		Kind:     entities.EmailDomainRuleAllow,
+	},
+	{
+		TenantID: "tenant-1",
+		Domain:   "*.company.com",
+		Kind:     entities.EmailDomainRuleAllow,
+	},
+	{
+		TenantID: "tenant-1",
+		Domain:   "contractors.company.com",
+		Kind:     entities.EmailDomainRuleBlock,
+	},
+}
+
+func TestCheckEmailDomain(t *testing.T) {
+	testData := []struct {
+		name string
+
+		email         string
+		emailVerified bool
+		tenantID      string
+
+		listRulesResponse []*entities.EmailDomainRule
+		listRulesErr      error
+
+		expectErr error
+	}{
+		{
+			name:              "NoRestriction",
+			email:             "user@gmail.com",
+			emailVerified:     true,
+			listRulesResponse: checkEmailDomainRules,
+		},
+		{
+			name:              "GlobalBlock",
+			email:             "user@spam.com",
+			emailVerified:     true,
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainBlocked,
+		},
+		{
+			name:              "GlobalBlockCaseInsensitive",
+			email:             "user@SPAM.com",
+			emailVerified:     true,
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainBlocked,
+		},
+		{
+			name:              "WildcardBlock",
+			email:             "user@mail.disposable.com",
+			emailVerified:     true,
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainBlocked,
+		},
+		{
+			name:              "WildcardDoesNotMatchApex",
+			email:             "user@disposable.com",
+			emailVerified:     true,
+			listRulesResponse: checkEmailDomainRules,
+		},
+		{
+			name:              "GlobalBlockAppliesToTenants",
+			email:             "user@spam.com",
+			emailVerified:     true,
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainBlocked,
+		},
+		{
+			name:              "TenantAllowed",
+			email:             "user@company.com",
+			emailVerified:     true,
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+		},
+		{
+			name:              "TenantAllowedSubdomain",
+			email:             "user@eu.company.com",
+			emailVerified:     true,
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+		},
+		{
+			name:              "TenantNotAllowed",
+			email:             "user@gmail.com",
+			emailVerified:     true,
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainNotAllowed,
+		},
+		{
+			name:              "TenantBlockOverridesAllow",
+			email:             "user@contractors.company.com",
+			emailVerified:     true,
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainBlocked,
+		},
+		{
+			name:              "TenantBlockDoesNotLeak",
+			email:             "user@contractors.company.com",
+			emailVerified:     true,
+			tenantID:          "tenant-2",
+			listRulesResponse: checkEmailDomainRules,
+		},
+		{
+			// Phone and anonymous users cannot prove they belong to an allowed domain.
+			name:              "NoEmailWithAllowList",
+			email:             "",
+			emailVerified:     true,
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainNotAllowed,
+		},
+		{
+			// Block rules need a domain to match, even the ones matching every domain.
+			name:              "NoEmail",
+			email:             "",
+			listRulesResponse: []*entities.EmailDomainRule{{Domain: "*", Kind: entities.EmailDomainRuleBlock}},
+		},
+		{
+			// Unverified emails may belong to someone else.
+			name:              "TenantUnverifiedEmail",
+			email:             "user@company.com",
+			tenantID:          "tenant-1",
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainNotAllowed,
+		},
+		{
+			name:              "GlobalBlockUnverifiedEmail",
+			email:             "user@spam.com",
+			listRulesResponse: checkEmailDomainRules,
+			expectErr:         services.ErrEmailDomainBlocked,
+		},
+		{
+			name:          "ListRulesError",
+			email:         "user@gmail.com",
+			emailVerified: true,
+			listRulesErr:  FooErr,
+			expectErr:     FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			listRulesRepository := daomocks.NewMockListEmailDomainRulesRepository(t)
+			listRulesRepository.On("ListEmailDomainRules", context.TODO()).Return(data.listRulesResponse, data.listRulesErr)
+
+			service := services.NewCheckEmailDomainService(listRulesRepository, time.Minute)
+
+			err := service.Exec(context.TODO(), &models.CheckEmailDomain{
+				Email:         data.email,
+				EmailVerified: data.emailVerified,
+				TenantID:      data.tenantID,
+			})
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			listRulesRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCheckEmailDomainCache(t *testing.T) {
+	listRulesRepository := daomocks.NewMockListEmailDomainRulesRepository(t)
+	listRulesRepository.On("ListEmailDomainRules", context.TODO()).Return(checkEmailDomainRules, nil).Once()
+
+	service := services.NewCheckEmailDomainService(listRulesRepository, time.Minute)
+
+	require.NoError(t, service.Exec(context.TODO(), &models.CheckEmailDomain{Email: "user@gmail.com"}))
+	require.ErrorIs(
+		t, service.Exec(context.TODO(), &models.CheckEmailDomain{Email: "user@spam.com"}), services.ErrEmailDomainBlocked,
+	)
+
+	listRulesRepository.AssertExpectations(t)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"strings"
+	"time"
+)
+
+// CheckEmailVerificationService decides whether a user with an unverified email is allowed to authenticate, based on
+// the provider they signed in with.
+type CheckEmailVerificationService interface {
+	Exec(ctx context.Context, data *models.CheckEmailVerification) (*models.EmailVerificationDecision, error)
+}
+
+type checkEmailVerificationServiceImpl struct {
+	policy models.EmailVerificationPolicy
+}
+
+func (s *checkEmailVerificationServiceImpl) policyFor(provider string) models.EmailVerificationPolicyKind {
+	if kind, ok := s.policy.Providers[provider]; ok {
+		return kind
+	}
+
+	for pattern, kind := range s.policy.Providers {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(provider, prefix) {
+			return kind
+		}
+	}
+
+	if s.policy.Default == "" {
+		return models.EmailVerificationPolicyRequired
+	}
+
+	return s.policy.Default
+}
+
+func (s *checkEmailVerificationServiceImpl) Exec(
+	_ context.Context, data *models.CheckEmailVerification,
+) (*models.EmailVerificationDecision, error) {
+	if data.EmailVerified {
+		return &models.EmailVerificationDecision{Status: models.EmailVerificationStatusVerified}, nil
+	}
+
+	switch s.policyFor(data.SignInProvider) {
+	case models.EmailVerificationPolicyExempt:
+		return &models.EmailVerificationDecision{Status: models.EmailVerificationStatusExempt}, nil
+	case models.EmailVerificationPolicyGracePeriod:
+		deadline := data.CreatedAt.Add(s.policy.GracePeriod)
+		if time.Now().Before(deadline) {
+			return &models.EmailVerificationDecision{
+				Status:   models.EmailVerificationStatusPending,
+				Deadline: &deadline,
+			}, nil
+		}
+
+		return nil, errors.Join(ErrEmailNotVerified, ErrEmailVerificationGracePeriodExpired)
+	default:
+		return nil, ErrEmailNotVerified
+	}
+}
+
+func NewCheckEmailVerificationService(policy models.EmailVerificationPolicy) CheckEmailVerificationService {
+	return &checkEmailVerificationServiceImpl{
+		policy: policy,
+	}
+}
@@ -0,0 +1,119 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCheckEmailVerification(t *testing.T) {
+	policy := models.EmailVerificationPolicy{
+		Default:     models.EmailVerificationPolicyRequired,
+		GracePeriod: 72 * time.Hour,
+		Providers: map[string]models.EmailVerificationPolicyKind{
+			"phone":    models.EmailVerificationPolicyExempt,
+			"saml.*":   models.EmailVerificationPolicyExempt,
+			"password": models.EmailVerificationPolicyGracePeriod,
+		},
+	}
+
+	recently := time.Now().Add(-time.Hour)
+	longAgo := time.Now().Add(-100 * time.Hour)
+
+	testData := []struct {
+		name string
+
+		policy models.EmailVerificationPolicy
+		data   *models.CheckEmailVerification
+
+		expect    *models.EmailVerificationDecision
+		expectErr error
+	}{
+		{
+			name:   "Verified",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				EmailVerified:  true,
+				SignInProvider: "google.com",
+			},
+			expect: &models.EmailVerificationDecision{Status: models.EmailVerificationStatusVerified},
+		},
+		{
+			name:   "Required",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				SignInProvider: "google.com",
+			},
+			expectErr: services.ErrEmailNotVerified,
+		},
+		{
+			name:   "ExemptProvider",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				SignInProvider: "phone",
+			},
+			expect: &models.EmailVerificationDecision{Status: models.EmailVerificationStatusExempt},
+		},
+		{
+			name:   "ExemptProviderPrefix",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				SignInProvider: "saml.okta",
+			},
+			expect: &models.EmailVerificationDecision{Status: models.EmailVerificationStatusExempt},
+		},
+		{
+			name:   "GracePeriod",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				SignInProvider: "password",
+				CreatedAt:      recently,
+			},
+			expect: &models.EmailVerificationDecision{
+				Status:   models.EmailVerificationStatusPending,
+				Deadline: lo.ToPtr(recently.Add(72 * time.Hour)),
+			},
+		},
+		{
+			name:   "GracePeriodExpired",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				SignInProvider: "password",
+				CreatedAt:      longAgo,
+			},
+			expectErr: services.ErrEmailVerificationGracePeriodExpired,
+		},
+		{
+			name:   "GracePeriodExpiredIsNotVerified",
+			policy: policy,
+			data: &models.CheckEmailVerification{
+				SignInProvider: "password",
+				CreatedAt:      longAgo,
+			},
+			expectErr: services.ErrEmailNotVerified,
+		},
+		{
+			name:   "EmptyPolicyDefaultsToRequired",
+			policy: models.EmailVerificationPolicy{},
+			data: &models.CheckEmailVerification{
+				SignInProvider: "phone",
+			},
+			expectErr: services.ErrEmailNotVerified,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			service := services.NewCheckEmailVerificationService(data.policy)
+
+			decision, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, decision)
+		})
+	}
+}
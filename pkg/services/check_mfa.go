@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+// CheckMFAService decides whether a user must have signed in with a second factor. A second factor is required by
+// the global policy, by the role of the user in the organization they act for, or by the organization itself. Users
+// acting for no organization are held to the policies of all their organizations.
+type CheckMFAService interface {
+	Exec(ctx context.Context, data *models.CheckMFA) error
+}
+
+type checkMFAServiceImpl struct {
+	policy models.MFAPolicy
+}
+
+func (s *checkMFAServiceImpl) requiredBy(membership *models.Membership) bool {
+	if membership.Organization != nil && membership.Organization.RequireMFA {
+		return true
+	}
+
+	return lo.Contains(s.policy.Roles, membership.Role)
+}
+
+func (s *checkMFAServiceImpl) required(data *models.CheckMFA) bool {
+	if s.policy.Required {
+		return true
+	}
+
+	if data.Membership == nil {
+		return lo.SomeBy(data.Memberships, s.requiredBy)
+	}
+
+	return s.requiredBy(data.Membership)
+}
+
+func (s *checkMFAServiceImpl) Exec(_ context.Context, data *models.CheckMFA) error {
+	if data.SecondFactor == "" && s.required(data) {
+		return ErrMFARequired
+	}
+
+	return nil
+}
+
+func NewCheckMFAService(policy models.MFAPolicy) CheckMFAService {
+	return &checkMFAServiceImpl{
+		policy: policy,
+	}
+}
@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCheckMFA(t *testing.T) {
+	rolesPolicy := models.MFAPolicy{
+		Roles: []models.MembershipRole{models.MembershipRoleOwner, models.MembershipRoleAdmin},
+	}
+
+	testData := []struct {
+		name string
+
+		policy models.MFAPolicy
+		data   *models.CheckMFA
+
+		expectErr error
+	}{
+		{
+			name:   "NotRequired",
+			policy: rolesPolicy,
+			data:   &models.CheckMFA{},
+		},
+		{
+			name:      "RequiredGlobally",
+			policy:    models.MFAPolicy{Required: true},
+			data:      &models.CheckMFA{},
+			expectErr: services.ErrMFARequired,
+		},
+		{
+			name:   "RequiredGloballySatisfied",
+			policy: models.MFAPolicy{Required: true},
+			data:   &models.CheckMFA{SecondFactor: "totp"},
+		},
+		{
+			name:   "RequiredByRole",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				Membership: &models.Membership{
+					Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+					Role:         models.MembershipRoleAdmin,
+				},
+			},
+			expectErr: services.ErrMFARequired,
+		},
+		{
+			name:   "RequiredByRoleSatisfied",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				SecondFactor: "phone",
+				Membership: &models.Membership{
+					Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+					Role:         models.MembershipRoleAdmin,
+				},
+			},
+		},
+		{
+			name:   "MemberRole",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				Membership: &models.Membership{
+					Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+					Role:         models.MembershipRoleMember,
+				},
+			},
+		},
+		{
+			name:   "RequiredByOrganization",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				Membership: &models.Membership{
+					Organization: &models.Organization{
+						ID:         "00000000-0000-0000-0000-000000000001",
+						RequireMFA: true,
+					},
+					Role: models.MembershipRoleMember,
+				},
+			},
+			expectErr: services.ErrMFARequired,
+		},
+		{
+			// Users acting for no organization are held to the policies of all of them.
+			name:   "RequiredByMembership",
+			policy: models.MFAPolicy{},
+			data: &models.CheckMFA{
+				Memberships: []*models.Membership{
+					{
+						Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+						Role:         models.MembershipRoleMember,
+					},
+					{
+						Organization: &models.Organization{
+							ID:         "00000000-0000-0000-0000-000000000002",
+							RequireMFA: true,
+						},
+						Role: models.MembershipRoleMember,
+					},
+				},
+			},
+			expectErr: services.ErrMFARequired,
+		},
+		{
+			name:   "RequiredByMembershipRole",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				Memberships: []*models.Membership{
+					{
+						Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+						Role:         models.MembershipRoleOwner,
+					},
+				},
+			},
+			expectErr: services.ErrMFARequired,
+		},
+		{
+			name:   "RequiredByMembershipSatisfied",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				SecondFactor: "totp",
+				Memberships: []*models.Membership{
+					{
+						Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+						Role:         models.MembershipRoleOwner,
+					},
+				},
+			},
+		},
+		{
+			// The organization the user acts for takes precedence.
+			name:   "ActingForOrganization",
+			policy: rolesPolicy,
+			data: &models.CheckMFA{
+				Membership: &models.Membership{
+					Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000001"},
+					Role:         models.MembershipRoleMember,
+				},
+				Memberships: []*models.Membership{
+					{
+						Organization: &models.Organization{ID: "00000000-0000-0000-0000-000000000002"},
+						Role:         models.MembershipRoleOwner,
+					},
+				},
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			service := services.NewCheckMFAService(data.policy)
+
+			err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+// CheckRateLimitService takes a token from the bucket of a key, in the given scope. Requests without a key, or in a
+// scope without a limit, are always allowed.
+type CheckRateLimitService interface {
+	Exec(ctx context.Context, data *models.CheckRateLimit) (*models.RateLimitDecision, error)
+}
+
+type checkRateLimitServiceImpl struct {
+	rateLimiter clients.RateLimiter
+	config      models.RateLimitConfig
+}
+
+func (s *checkRateLimitServiceImpl) Exec(ctx context.Context, data *models.CheckRateLimit) (*models.RateLimitDecision, error) {
+	limit := s.config.Limits[data.Scope]
+	if data.Key == "" || limit.Rate <= 0 {
+		return &models.RateLimitDecision{Allowed: true}, nil
+	}
+
+	retryAfter, err := s.rateLimiter.TakeRateLimitToken(ctx, string(data.Scope)+":"+data.Key, limit.Rate, limit.Burst)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RateLimitDecision{Allowed: retryAfter == 0, RetryAfter: retryAfter}, nil
+}
+
+func NewCheckRateLimitService(rateLimiter clients.RateLimiter, config models.RateLimitConfig) CheckRateLimitService {
+	return &checkRateLimitServiceImpl{
+		rateLimiter: rateLimiter,
+		config:      config,
+	}
+}
@@ -0,0 +1,97 @@
+package services_test
+
+import (
+	"context"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCheckRateLimit(t *testing.T) {
+	testData := []struct {
+		name string
+
+		data *models.CheckRateLimit
+
+		shouldCallTakeRateLimitToken bool
+		takeRateLimitTokenResponse   time.Duration
+		takeRateLimitTokenErr        error
+
+		expect    *models.RateLimitDecision
+		expectErr error
+	}{
+		{
+			name: "Allowed",
+			data: &models.CheckRateLimit{
+				Scope: models.RateLimitScopePeerIP,
+				Key:   "203.0.113.1",
+			},
+			shouldCallTakeRateLimitToken: true,
+			expect:                       &models.RateLimitDecision{Allowed: true},
+		},
+		{
+			name: "Rejected",
+			data: &models.CheckRateLimit{
+				Scope: models.RateLimitScopePeerIP,
+				Key:   "203.0.113.1",
+			},
+			shouldCallTakeRateLimitToken: true,
+			takeRateLimitTokenResponse:   3 * time.Second,
+			expect:                       &models.RateLimitDecision{RetryAfter: 3 * time.Second},
+		},
+		{
+			name: "NoKey",
+			data: &models.CheckRateLimit{
+				Scope: models.RateLimitScopeService,
+			},
+			expect: &models.RateLimitDecision{Allowed: true},
+		},
+		{
+			name: "NoLimit",
+			data: &models.CheckRateLimit{
+				Scope: models.RateLimitScopeUID,
+				Key:   "firebase-uid-1",
+			},
+			expect: &models.RateLimitDecision{Allowed: true},
+		},
+		{
+			name: "TakeRateLimitTokenError",
+			data: &models.CheckRateLimit{
+				Scope: models.RateLimitScopePeerIP,
+				Key:   "203.0.113.1",
+			},
+			shouldCallTakeRateLimitToken: true,
+			takeRateLimitTokenErr:        FooErr,
+			expectErr:                    FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			rateLimiter := clientsmocks.NewMockRateLimiter(t)
+
+			if data.shouldCallTakeRateLimitToken {
+				rateLimiter.
+					On("TakeRateLimitToken", context.TODO(), "peer_ip:203.0.113.1", 5.0, 20).
+					Return(data.takeRateLimitTokenResponse, data.takeRateLimitTokenErr)
+			}
+
+			service := services.NewCheckRateLimitService(rateLimiter, models.RateLimitConfig{
+				Limits: map[models.RateLimitScope]models.RateLimit{
+					models.RateLimitScopePeerIP:  {Rate: 5, Burst: 20},
+					models.RateLimitScopeService: {Rate: 100, Burst: 500},
+				},
+			})
+
+			decision, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, decision)
+
+			rateLimiter.AssertExpectations(t)
+		})
+	}
+}
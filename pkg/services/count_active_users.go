@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// CountActiveUsersService returns the number of users of a tenant that authenticated within the given window. It is
+// meant for internal tooling.
+type CountActiveUsersService interface {
+	Exec(ctx context.Context, data *models.CountActiveUsers) (int, error)
+}
+
+type countActiveUsersServiceImpl struct {
+	countActiveUsersRepository dao.CountActiveUsersRepository
+}
+
+func (s *countActiveUsersServiceImpl) Exec(ctx context.Context, data *models.CountActiveUsers) (int, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return 0, errors.Join(ErrInvalidCountActiveUsers, err)
+	}
+
+	return s.countActiveUsersRepository.CountActiveUsers(ctx, data.TenantID, time.Now().Add(-data.Window))
+}
+
+func NewCountActiveUsersService(countActiveUsersRepository dao.CountActiveUsersRepository) CountActiveUsersService {
+	return &countActiveUsersServiceImpl{
+		countActiveUsersRepository: countActiveUsersRepository,
+	}
+}
@@ -0,0 +1,76 @@
+package services_test
+
+import (
+	"context"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCountActiveUsers(t *testing.T) {
+	testData := []struct {
+		name string
+
+		data *models.CountActiveUsers
+
+		shouldCallCountActiveUsers bool
+		countActiveUsersResponse   int
+		countActiveUsersErr        error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name: "CountActiveUsers",
+			data: &models.CountActiveUsers{
+				TenantID: "tenant-1",
+				Window:   24 * time.Hour,
+			},
+			shouldCallCountActiveUsers: true,
+			countActiveUsersResponse:   12,
+			expect:                     12,
+		},
+		{
+			name:      "NoWindow",
+			data:      &models.CountActiveUsers{TenantID: "tenant-1"},
+			expectErr: services.ErrInvalidCountActiveUsers,
+		},
+		{
+			name: "CountActiveUsersError",
+			data: &models.CountActiveUsers{
+				TenantID: "tenant-1",
+				Window:   24 * time.Hour,
+			},
+			shouldCallCountActiveUsers: true,
+			countActiveUsersErr:        FooErr,
+			expectErr:                  FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			countActiveUsersRepository := daomocks.NewMockCountActiveUsersRepository(t)
+
+			if data.shouldCallCountActiveUsers {
+				countActiveUsersRepository.
+					On("CountActiveUsers", context.TODO(), data.data.TenantID, mock.MatchedBy(func(since time.Time) bool {
+						return time.Since(since.Add(data.data.Window)) < time.Minute
+					})).
+					Return(data.countActiveUsersResponse, data.countActiveUsersErr)
+			}
+
+			service := services.NewCountActiveUsersService(countActiveUsersRepository)
+
+			count, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, count)
+
+			countActiveUsersRepository.AssertExpectations(t)
+		})
+	}
+}
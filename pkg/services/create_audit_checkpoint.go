@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"strconv"
+)
+
+// CreateAuditCheckpointService signs the hash of the latest audit event, under the next checkpoint sequence. It
+// returns nil when the chain is still empty.
+type CreateAuditCheckpointService interface {
+	Exec(ctx context.Context) (*models.AuditCheckpoint, error)
+}
+
+type createAuditCheckpointServiceImpl struct {
+	getLastAuditEventRepository      dao.GetLastAuditEventRepository
+	getLastAuditCheckpointRepository dao.GetLastAuditCheckpointRepository
+	createAuditCheckpointRepository  dao.CreateAuditCheckpointRepository
+	key                              []byte
+}
+
+func (s *createAuditCheckpointServiceImpl) Exec(ctx context.Context) (*models.AuditCheckpoint, error) {
+	if len(s.key) == 0 {
+		return nil, ErrAuditCheckpointKeyMissing
+	}
+
+	event, err := s.getLastAuditEventRepository.GetLastAuditEvent(ctx)
+	if err != nil {
+		if errors.Is(err, dao.ErrAuditEventNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	sequence := int64(1)
+
+	lastCheckpoint, err := s.getLastAuditCheckpointRepository.GetLastAuditCheckpoint(ctx)
+	if err == nil {
+		sequence = lastCheckpoint.Sequence + 1
+	} else if !errors.Is(err, dao.ErrAuditCheckpointNotFound) {
+		return nil, err
+	}
+
+	// Concurrent checkpoints get the same sequence, and all but one are rejected by the unique constraint.
+	checkpoint, err := s.createAuditCheckpointRepository.CreateAuditCheckpoint(ctx, &dao.CreateAuditCheckpointData{
+		Sequence:  sequence,
+		EventID:   event.ID,
+		EventHash: *event.Hash,
+		Signature: signAuditCheckpoint(s.key, sequence, event.ID, *event.Hash),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuditCheckpoint{
+		Sequence:  checkpoint.Sequence,
+		EventID:   strconv.FormatInt(checkpoint.EventID, 10),
+		EventHash: checkpoint.EventHash,
+		CreatedAt: checkpoint.CreatedAt,
+	}, nil
+}
+
+func NewCreateAuditCheckpointService(
+	getLastAuditEventRepository dao.GetLastAuditEventRepository,
+	getLastAuditCheckpointRepository dao.GetLastAuditCheckpointRepository,
+	createAuditCheckpointRepository dao.CreateAuditCheckpointRepository,
+	key []byte,
+) CreateAuditCheckpointService {
+	return &createAuditCheckpointServiceImpl{
+		getLastAuditEventRepository:      getLastAuditEventRepository,
+		getLastAuditCheckpointRepository: getLastAuditCheckpointRepository,
+		createAuditCheckpointRepository:  createAuditCheckpointRepository,
+		key:                              key,
+	}
+}
@@ -0,0 +1,175 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateAuditCheckpoint(t *testing.T) {
+	testData := []struct {
+		name string
+
+		key []byte
+
+		shouldCallGetLastAuditEvent bool
+		getLastAuditEventResponse   *entities.AuditEvent
+		getLastAuditEventErr        error
+
+		shouldCallGetLastAuditCheckpoint bool
+		getLastAuditCheckpointResponse   *entities.AuditCheckpoint
+		getLastAuditCheckpointErr        error
+
+		shouldCallCreateAuditCheckpoint bool
+		expectSequence                  int64
+		createAuditCheckpointErr        error
+
+		expect    *models.AuditCheckpoint
+		expectErr error
+	}{
+		{
+			name:                        "CreateAuditCheckpoint",
+			shouldCallGetLastAuditEvent: true,
+			getLastAuditEventResponse: &entities.AuditEvent{
+				ID:   3,
+				Hash: lo.ToPtr("hash-3"),
+			},
+			shouldCallGetLastAuditCheckpoint: true,
+			getLastAuditCheckpointResponse:   &entities.AuditCheckpoint{Sequence: 4, EventID: 2},
+			shouldCallCreateAuditCheckpoint:  true,
+			expectSequence:                   5,
+			expect: &models.AuditCheckpoint{
+				Sequence:  5,
+				EventID:   "3",
+				EventHash: "hash-3",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:                        "FirstCheckpoint",
+			shouldCallGetLastAuditEvent: true,
+			getLastAuditEventResponse: &entities.AuditEvent{
+				ID:   3,
+				Hash: lo.ToPtr("hash-3"),
+			},
+			shouldCallGetLastAuditCheckpoint: true,
+			getLastAuditCheckpointErr:        dao.ErrAuditCheckpointNotFound,
+			shouldCallCreateAuditCheckpoint:  true,
+			expectSequence:                   1,
+			expect: &models.AuditCheckpoint{
+				Sequence:  1,
+				EventID:   "3",
+				EventHash: "hash-3",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:                        "EmptyChain",
+			shouldCallGetLastAuditEvent: true,
+			getLastAuditEventErr:        dao.ErrAuditEventNotFound,
+		},
+		{
+			name:                        "GetLastAuditEventError",
+			shouldCallGetLastAuditEvent: true,
+			getLastAuditEventErr:        FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name:                        "GetLastAuditCheckpointError",
+			shouldCallGetLastAuditEvent: true,
+			getLastAuditEventResponse: &entities.AuditEvent{
+				ID:   3,
+				Hash: lo.ToPtr("hash-3"),
+			},
+			shouldCallGetLastAuditCheckpoint: true,
+			getLastAuditCheckpointErr:        FooErr,
+			expectErr:                        FooErr,
+		},
+		{
+			name:                        "CreateAuditCheckpointError",
+			shouldCallGetLastAuditEvent: true,
+			getLastAuditEventResponse: &entities.AuditEvent{
+				ID:   3,
+				Hash: lo.ToPtr("hash-3"),
+			},
+			shouldCallGetLastAuditCheckpoint: true,
+			getLastAuditCheckpointResponse:   &entities.AuditCheckpoint{Sequence: 4, EventID: 2},
+			shouldCallCreateAuditCheckpoint:  true,
+			expectSequence:                   5,
+			createAuditCheckpointErr:         FooErr,
+			expectErr:                        FooErr,
+		},
+		{
+			name:      "MissingKey",
+			key:       []byte{},
+			expectErr: services.ErrAuditCheckpointKeyMissing,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			getLastAuditEventRepository := daomocks.NewMockGetLastAuditEventRepository(t)
+			getLastAuditCheckpointRepository := daomocks.NewMockGetLastAuditCheckpointRepository(t)
+			createAuditCheckpointRepository := daomocks.NewMockCreateAuditCheckpointRepository(t)
+
+			if data.shouldCallGetLastAuditEvent {
+				getLastAuditEventRepository.
+					On("GetLastAuditEvent", context.TODO()).
+					Return(data.getLastAuditEventResponse, data.getLastAuditEventErr)
+			}
+
+			if data.shouldCallGetLastAuditCheckpoint {
+				getLastAuditCheckpointRepository.
+					On("GetLastAuditCheckpoint", context.TODO()).
+					Return(data.getLastAuditCheckpointResponse, data.getLastAuditCheckpointErr)
+			}
+
+			if data.shouldCallCreateAuditCheckpoint {
+				var checkpoint *entities.AuditCheckpoint
+				if data.createAuditCheckpointErr == nil {
+					checkpoint = &entities.AuditCheckpoint{
+						Sequence:  data.expectSequence,
+						EventID:   3,
+						EventHash: "hash-3",
+						Signature: signCheckpoint(data.expectSequence, 3, "hash-3"),
+						CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					}
+				}
+
+				createAuditCheckpointRepository.
+					On("CreateAuditCheckpoint", context.TODO(), &dao.CreateAuditCheckpointData{
+						Sequence:  data.expectSequence,
+						EventID:   3,
+						EventHash: "hash-3",
+						Signature: signCheckpoint(data.expectSequence, 3, "hash-3"),
+					}).
+					Return(checkpoint, data.createAuditCheckpointErr)
+			}
+
+			key := checkpointKey
+			if data.key != nil {
+				key = data.key
+			}
+
+			service := services.NewCreateAuditCheckpointService(
+				getLastAuditEventRepository, getLastAuditCheckpointRepository, createAuditCheckpointRepository, key,
+			)
+
+			checkpoint, err := service.Exec(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, checkpoint)
+
+			getLastAuditEventRepository.AssertExpectations(t)
+			getLastAuditCheckpointRepository.AssertExpectations(t)
+			createAuditCheckpointRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type CreateOrganizationService interface {
+	Exec(ctx context.Context, token string, data *models.CreateOrganization) (*models.Organization, error)
+}
+
+type createOrganizationServiceImpl struct {
+	auth AuthenticateService
+	dao  dao.CreateOrganizationRepository
+}
+
+func (s *createOrganizationServiceImpl) Exec(
+	ctx context.Context, token string, data *models.CreateOrganization,
+) (*models.Organization, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil && !lo.Contains(user.Scopes, models.ScopeUserWrite) {
+		return nil, ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidCreateOrganization, err)
+	}
+
+	organization, err := s.dao.CreateOrganization(ctx, user.FirebaseUID, &dao.CreateOrganizationData{
+		Name:       data.Name,
+		RequireMFA: data.RequireMFA,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return organizationToModel(organization), nil
+}
+
+func NewCreateOrganizationService(
+	auth AuthenticateService,
+	dao dao.CreateOrganizationRepository,
+) CreateOrganizationService {
+	return &createOrganizationServiceImpl{
+		auth: auth,
+		dao:  dao,
+	}
+}
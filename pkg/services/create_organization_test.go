@@ -0,0 +1,141 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.CreateOrganization
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallCreate bool
+		createResponse   *entities.Organization
+		createErr        error
+
+		expect    *models.Organization
+		expectErr error
+	}{
+		{
+			name:  "CreateOrganization",
+			token: "foo-token",
+			data: &models.CreateOrganization{
+				Name: "organization-1",
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+			},
+			shouldCallCreate: true,
+			createResponse: &entities.Organization{
+				ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name: "organization-1",
+			},
+			expect: &models.Organization{
+				ID:   "00000000-0000-0000-0000-000000000001",
+				Name: "organization-1",
+			},
+		},
+		{
+			name:  "RequireMFA",
+			token: "foo-token",
+			data: &models.CreateOrganization{
+				Name:       "organization-1",
+				RequireMFA: true,
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+			},
+			shouldCallCreate: true,
+			createResponse: &entities.Organization{
+				ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name:       "organization-1",
+				RequireMFA: true,
+			},
+			expect: &models.Organization{
+				ID:         "00000000-0000-0000-0000-000000000001",
+				Name:       "organization-1",
+				RequireMFA: true,
+			},
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			data:      &models.CreateOrganization{Name: "organization-1"},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "InsufficientScope",
+			token: "inr_pat_foo",
+			data:  &models.CreateOrganization{Name: "organization-1"},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidData",
+			token: "foo-token",
+			data:  &models.CreateOrganization{},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+			},
+			expectErr: services.ErrInvalidCreateOrganization,
+		},
+		{
+			name:  "CreateError",
+			token: "foo-token",
+			data:  &models.CreateOrganization{Name: "organization-1"},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+			},
+			shouldCallCreate: true,
+			createErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			createRepository := daomocks.NewMockCreateOrganizationRepository(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallCreate {
+				createRepository.
+					On("CreateOrganization", context.TODO(), data.authResponse.FirebaseUID, &dao.CreateOrganizationData{
+						Name:       data.data.Name,
+						RequireMFA: data.data.RequireMFA,
+					}).
+					Return(data.createResponse, data.createErr)
+			}
+
+			service := services.NewCreateOrganizationService(authService, createRepository)
+
+			organization, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, organization)
+
+			authService.AssertExpectations(t)
+			createRepository.AssertExpectations(t)
+		})
+	}
+}
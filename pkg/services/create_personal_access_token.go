@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+type CreatePersonalAccessTokenService interface {
+	Exec(ctx context.Context, token string, data *models.CreatePersonalAccessToken) (*models.CreatedPersonalAccessToken, error)
+}
+
+type createPersonalAccessTokenServiceImpl struct {
+	auth      AuthenticateService
+	createDAO dao.CreatePersonalAccessTokenRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	// maxAuthAge is how recently the user must have signed in to create a token.
+	maxAuthAge time.Duration
+}
+
+func (s *createPersonalAccessTokenServiceImpl) Exec(
+	ctx context.Context, token string, data *models.CreatePersonalAccessToken,
+) (*models.CreatedPersonalAccessToken, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token, MaxAuthAge: s.maxAuthAge})
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens can only be managed from an interactive session. Otherwise, a leaked token could be used to create
+	// new ones, and keep access after being revoked.
+	if user.Scopes != nil {
+		return nil, ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidCreatePersonalAccessToken, err)
+	}
+
+	if data.ExpiresAt != nil && !data.ExpiresAt.After(time.Now()) {
+		return nil, errors.Join(ErrInvalidCreatePersonalAccessToken, ErrPersonalAccessTokenExpired)
+	}
+
+	clearToken, err := generateSecret(PersonalAccessTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.createDAO.CreatePersonalAccessToken(ctx, user.FirebaseUID, &dao.CreatePersonalAccessTokenData{
+		TenantID:  user.TenantID,
+		Name:      data.Name,
+		TokenHash: hashSecret(clearToken),
+		Scopes:    data.Scopes,
+		ExpiresAt: data.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: user.FirebaseUID,
+		Target:     "personal_access_tokens/" + created.ID.String(),
+		Action:     models.AuditActionCreatePersonalAccessToken,
+		Outcome:    models.AuditOutcomeSuccess,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreatedPersonalAccessToken{
+		PersonalAccessToken: *personalAccessTokenToModel(created),
+		Token:               clearToken,
+	}, nil
+}
+
+func NewCreatePersonalAccessTokenService(
+	auth AuthenticateService,
+	createDAO dao.CreatePersonalAccessTokenRepository,
+	recordAuditEvent RecordAuditEventService,
+	maxAuthAge time.Duration,
+) CreatePersonalAccessTokenService {
+	return &createPersonalAccessTokenServiceImpl{
+		auth:             auth,
+		createDAO:        createDAO,
+		recordAuditEvent: recordAuditEvent,
+		maxAuthAge:       maxAuthAge,
+	}
+}
@@ -0,0 +1,187 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour)
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.CreatePersonalAccessToken
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallCreate bool
+		createResponse   *entities.PersonalAccessToken
+		createErr        error
+
+		expect    *models.PersonalAccessToken
+		expectErr error
+	}{
+		{
+			name:  "CreatePersonalAccessToken",
+			token: "foo-token",
+			data: &models.CreatePersonalAccessToken{
+				Name:      "token-1",
+				Scopes:    []string{models.ScopeUserRead},
+				ExpiresAt: &expiresAt,
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallCreate: true,
+			createResponse: &entities.PersonalAccessToken{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				FirebaseUID: "user-one-uid",
+				Name:        "token-1",
+				Scopes:      []string{models.ScopeUserRead},
+				ExpiresAt:   &expiresAt,
+				CreatedAt:   &createdAt,
+			},
+			expect: &models.PersonalAccessToken{
+				ID:        "00000000-0000-0000-0000-000000000001",
+				Name:      "token-1",
+				Scopes:    []string{models.ScopeUserRead},
+				ExpiresAt: &expiresAt,
+				CreatedAt: &createdAt,
+			},
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.CreatePersonalAccessToken{
+				Name:   "token-1",
+				Scopes: []string{models.ScopeUserRead},
+			},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "AuthenticatedWithPersonalAccessToken",
+			token: "inr_pat_foo",
+			data: &models.CreatePersonalAccessToken{
+				Name:   "token-1",
+				Scopes: []string{models.ScopeUserRead},
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+				Scopes:      []string{models.ScopeUserRead, models.ScopeUserWrite},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidScope",
+			token: "foo-token",
+			data: &models.CreatePersonalAccessToken{
+				Name:   "token-1",
+				Scopes: []string{"admin"},
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			expectErr: services.ErrInvalidCreatePersonalAccessToken,
+		},
+		{
+			name:  "AlreadyExpired",
+			token: "foo-token",
+			data: &models.CreatePersonalAccessToken{
+				Name:      "token-1",
+				Scopes:    []string{models.ScopeUserRead},
+				ExpiresAt: lo.ToPtr(time.Now().Add(-time.Hour)),
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			expectErr: services.ErrInvalidCreatePersonalAccessToken,
+		},
+		{
+			name:  "CreateError",
+			token: "foo-token",
+			data: &models.CreatePersonalAccessToken{
+				Name:   "token-1",
+				Scopes: []string{models.ScopeUserRead},
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallCreate: true,
+			createErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			createRepository := daomocks.NewMockCreatePersonalAccessTokenRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token, MaxAuthAge: 5 * time.Minute}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallCreate {
+				createRepository.
+					On(
+						"CreatePersonalAccessToken",
+						context.TODO(),
+						data.authResponse.FirebaseUID,
+						mock.MatchedBy(func(in *dao.CreatePersonalAccessTokenData) bool {
+							return in.Name == data.data.Name && len(in.TokenHash) == 64
+						}),
+					).
+					Return(data.createResponse, data.createErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionCreatePersonalAccessToken && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewCreatePersonalAccessTokenService(
+				authService, createRepository, recordAuditEventService, 5*time.Minute,
+			)
+
+			token, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expect != nil {
+				require.NotNil(t, token)
+				require.True(t, strings.HasPrefix(token.Token, services.PersonalAccessTokenPrefix))
+				require.Equal(t, data.expect, &token.PersonalAccessToken)
+			} else {
+				require.Nil(t, token)
+			}
+
+			authService.AssertExpectations(t)
+			createRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
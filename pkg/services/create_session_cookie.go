@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// CreateSessionCookieService exchanges an ID token for a long-lived session cookie, that Authenticate accepts in
+// place of ID tokens. The user must have signed in recently.
+type CreateSessionCookieService interface {
+	Exec(ctx context.Context, idToken string) (*models.SessionCookie, error)
+}
+
+type createSessionCookieServiceImpl struct {
+	client *auth.Client
+	auth   AuthenticateService
+
+	recordAuditEvent RecordAuditEventService
+
+	config models.SessionCookieConfig
+}
+
+func (s *createSessionCookieServiceImpl) Exec(ctx context.Context, idToken string) (*models.SessionCookie, error) {
+	// Otherwise, a session cookie could be renewed forever from itself.
+	if isSessionCookie(idToken) {
+		return nil, ErrIDTokenRequired
+	}
+
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: idToken, MaxAuthAge: s.config.MaxAuthAge})
+	if err != nil {
+		return nil, err
+	}
+
+	// Personal access tokens and impersonation sessions must not be turned into a long-lived credential.
+	if user.Scopes != nil {
+		return nil, ErrInsufficientScope
+	}
+
+	// Firebase does not issue session cookies to the users of Identity Platform tenants.
+	if user.TenantID != "" {
+		return nil, ErrSessionCookieTenantUnsupported
+	}
+
+	expiresAt := time.Now().Add(s.config.TTL)
+
+	cookie, err := s.client.SessionCookie(ctx, idToken, s.config.TTL)
+	if err != nil {
+		return nil, errors.Join(ErrVerifyToken, err)
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: user.FirebaseUID,
+		Action:     models.AuditActionCreateSessionCookie,
+		Outcome:    models.AuditOutcomeSuccess,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SessionCookie{
+		Value:     cookie,
+		ExpiresAt: &expiresAt,
+	}, nil
+}
+
+func NewCreateSessionCookieService(
+	client *auth.Client,
+	auth AuthenticateService,
+	recordAuditEvent RecordAuditEventService,
+	config models.SessionCookieConfig,
+) CreateSessionCookieService {
+	return &createSessionCookieServiceImpl{
+		client:           client,
+		auth:             auth,
+		recordAuditEvent: recordAuditEvent,
+		config:           config,
+	}
+}
@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var createSessionCookieFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+}
+
+func TestCreateSessionCookie(t *testing.T) {
+	require.NoError(t, CreateUsersFixtures(createSessionCookieFixtures))
+	defer CleanUsersFixtures(createSessionCookieFixtures)
+
+	idToken := getIDToken(t, "user-one-uid")
+
+	// Only the issuer is looked at before the cookie is rejected.
+	sessionCookie := "e30." + base64.RawURLEncoding.EncodeToString(
+		[]byte(`{"iss":"https://session.firebase.google.com/inrich-dev"}`),
+	) + ".c2lnbmF0dXJl"
+
+	testData := []struct {
+		name string
+
+		token string
+
+		shouldCallAuth bool
+		authResponse   *models.User
+		authErr        error
+
+		expectCookie bool
+		expectErr    error
+	}{
+		{
+			name:           "CreateSessionCookie",
+			token:          idToken,
+			shouldCallAuth: true,
+			authResponse:   &models.User{FirebaseUID: "user-one-uid", Email: "user@gmail.com"},
+			expectCookie:   true,
+		},
+		{
+			name:      "SessionCookie",
+			token:     sessionCookie,
+			expectErr: services.ErrIDTokenRequired,
+		},
+		{
+			name:           "AuthenticatedWithPersonalAccessToken",
+			token:          "inr_pat_foo",
+			shouldCallAuth: true,
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:           "TenantUser",
+			token:          idToken,
+			shouldCallAuth: true,
+			authResponse:   &models.User{FirebaseUID: "user-one-uid", TenantID: "tenant-1"},
+			expectErr:      services.ErrSessionCookieTenantUnsupported,
+		},
+		{
+			name:           "AuthError",
+			token:          idToken,
+			shouldCallAuth: true,
+			authErr:        services.ErrReauthenticationRequired,
+			expectErr:      services.ErrReauthenticationRequired,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			if data.shouldCallAuth {
+				authService.
+					On("Exec", context.TODO(), &models.Authenticate{Token: data.token, MaxAuthAge: 5 * time.Minute}).
+					Return(data.authResponse, data.authErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), &models.RecordAuditEvent{
+						ActorUID:   "user-one-uid",
+						SubjectUID: "user-one-uid",
+						Action:     models.AuditActionCreateSessionCookie,
+						Outcome:    models.AuditOutcomeSuccess,
+					}).
+					Return(nil)
+			}
+
+			service := services.NewCreateSessionCookieService(
+				config.AuthClient,
+				authService,
+				recordAuditEventService,
+				models.SessionCookieConfig{TTL: 24 * time.Hour, MaxAuthAge: 5 * time.Minute},
+			)
+
+			cookie, err := service.Exec(context.TODO(), data.token)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expectCookie {
+				require.NotNil(t, cookie)
+				require.WithinDuration(t, time.Now().Add(24*time.Hour), *cookie.ExpiresAt, time.Minute)
+
+				// The cookie is accepted in place of the ID token.
+				token, err := config.AuthClient.VerifySessionCookie(context.TODO(), cookie.Value)
+				require.NoError(t, err)
+				require.Equal(t, "user-one-uid", token.UID)
+			} else {
+				require.Nil(t, cookie)
+			}
+
+			authService.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// CreateWebhookSubscriptionService is meant for internal tooling, like ListAuditEventsService. The signing secret is
+// only returned once, and must be handed to the receiver.
+type CreateWebhookSubscriptionService interface {
+	Exec(ctx context.Context, data *models.CreateWebhookSubscription) (*models.WebhookSubscription, error)
+}
+
+type createWebhookSubscriptionServiceImpl struct {
+	createWebhookSubscriptionRepository dao.CreateWebhookSubscriptionRepository
+}
+
+func (s *createWebhookSubscriptionServiceImpl) Exec(
+	ctx context.Context, data *models.CreateWebhookSubscription,
+) (*models.WebhookSubscription, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidCreateWebhookSubscription, err)
+	}
+
+	// Hostnames are checked again once resolved, when deliveries are sent. Rejecting internal addresses here only
+	// reports the obvious mistakes early.
+	webhookURL, err := url.Parse(data.URL)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidCreateWebhookSubscription, err)
+	}
+
+	host := webhookURL.Hostname()
+	addr, err := netip.ParseAddr(host)
+	if strings.EqualFold(host, "localhost") || (err == nil && !clients.WebhookAddressAllowed(addr)) {
+		return nil, errors.Join(ErrInvalidCreateWebhookSubscription, clients.ErrWebhookAddressNotAllowed)
+	}
+
+	secret, err := generateSecret(WebhookSecretPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.createWebhookSubscriptionRepository.CreateWebhookSubscription(
+		ctx,
+		&dao.CreateWebhookSubscriptionData{
+			URL:        data.URL,
+			Secret:     secret,
+			EventTypes: data.EventTypes,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := webhookSubscriptionToModel(subscription)
+	result.Secret = secret
+
+	return result, nil
+}
+
+func NewCreateWebhookSubscriptionService(
+	createWebhookSubscriptionRepository dao.CreateWebhookSubscriptionRepository,
+) CreateWebhookSubscriptionService {
+	return &createWebhookSubscriptionServiceImpl{
+		createWebhookSubscriptionRepository: createWebhookSubscriptionRepository,
+	}
+}
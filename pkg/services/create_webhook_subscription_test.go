@@ -0,0 +1,151 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateWebhookSubscription(t *testing.T) {
+	testData := []struct {
+		name string
+
+		data *models.CreateWebhookSubscription
+
+		shouldCallCreate bool
+		createResponse   *entities.WebhookSubscription
+		createErr        error
+
+		expect    *models.WebhookSubscription
+		expectErr error
+	}{
+		{
+			name: "CreateWebhookSubscription",
+			data: &models.CreateWebhookSubscription{
+				URL:        "https://example.com/webhooks",
+				EventTypes: []string{"user.created", "user.deleted"},
+			},
+			shouldCallCreate: true,
+			createResponse: &entities.WebhookSubscription{
+				ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				URL:        "https://example.com/webhooks",
+				EventTypes: []string{"user.created", "user.deleted"},
+				CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.WebhookSubscription{
+				ID:         "00000000-0000-0000-0000-000000000001",
+				URL:        "https://example.com/webhooks",
+				EventTypes: []string{"user.created", "user.deleted"},
+				CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "InvalidURL",
+			data: &models.CreateWebhookSubscription{
+				URL:        "not-a-url",
+				EventTypes: []string{"user.created"},
+			},
+			expectErr: services.ErrInvalidCreateWebhookSubscription,
+		},
+		{
+			name: "PlainHTTP",
+			data: &models.CreateWebhookSubscription{
+				URL:        "http://example.com/webhooks",
+				EventTypes: []string{"user.created"},
+			},
+			expectErr: services.ErrInvalidCreateWebhookSubscription,
+		},
+		{
+			name: "PrivateAddress",
+			data: &models.CreateWebhookSubscription{
+				URL:        "https://10.0.0.1/webhooks",
+				EventTypes: []string{"user.created"},
+			},
+			expectErr: clients.ErrWebhookAddressNotAllowed,
+		},
+		{
+			name: "MetadataAddress",
+			data: &models.CreateWebhookSubscription{
+				URL:        "https://169.254.169.254/latest",
+				EventTypes: []string{"user.created"},
+			},
+			expectErr: clients.ErrWebhookAddressNotAllowed,
+		},
+		{
+			name: "Localhost",
+			data: &models.CreateWebhookSubscription{
+				URL:        "https://localhost:8080/webhooks",
+				EventTypes: []string{"user.created"},
+			},
+			expectErr: clients.ErrWebhookAddressNotAllowed,
+		},
+		{
+			name: "NoEventType",
+			data: &models.CreateWebhookSubscription{
+				URL: "https://example.com/webhooks",
+			},
+			expectErr: services.ErrInvalidCreateWebhookSubscription,
+		},
+		{
+			name: "UnknownEventType",
+			data: &models.CreateWebhookSubscription{
+				URL:        "https://example.com/webhooks",
+				EventTypes: []string{"user.renamed"},
+			},
+			expectErr: services.ErrInvalidCreateWebhookSubscription,
+		},
+		{
+			name: "CreateError",
+			data: &models.CreateWebhookSubscription{
+				URL:        "https://example.com/webhooks",
+				EventTypes: []string{"user.created"},
+			},
+			shouldCallCreate: true,
+			createErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			createRepository := daomocks.NewMockCreateWebhookSubscriptionRepository(t)
+
+			var secret string
+			if data.shouldCallCreate {
+				createRepository.
+					On("CreateWebhookSubscription", context.TODO(), mock.MatchedBy(func(in *dao.CreateWebhookSubscriptionData) bool {
+						secret = in.Secret
+						return in.URL == data.data.URL &&
+							strings.HasPrefix(in.Secret, services.WebhookSecretPrefix) &&
+							slices.Equal(in.EventTypes, data.data.EventTypes)
+					})).
+					Return(data.createResponse, data.createErr)
+			}
+
+			service := services.NewCreateWebhookSubscriptionService(createRepository)
+
+			subscription, err := service.Exec(context.TODO(), data.data)
+
+			if subscription != nil {
+				// The secret is random, but must be the one that was stored.
+				require.Equal(t, secret, subscription.Secret)
+				subscription.Secret = ""
+			}
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, subscription)
+		})
+	}
+}
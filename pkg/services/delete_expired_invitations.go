@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"time"
+)
+
+// DeleteExpiredInvitationsService removes pending invitations past their expiration date. It returns the number of
+// invitations removed.
+type DeleteExpiredInvitationsService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type deleteExpiredInvitationsServiceImpl struct {
+	dao dao.DeleteExpiredInvitationsRepository
+}
+
+func (s *deleteExpiredInvitationsServiceImpl) Exec(ctx context.Context) (int, error) {
+	return s.dao.DeleteExpiredInvitations(ctx, time.Now())
+}
+
+func NewDeleteExpiredInvitationsService(dao dao.DeleteExpiredInvitationsRepository) DeleteExpiredInvitationsService {
+	return &deleteExpiredInvitationsServiceImpl{
+		dao: dao,
+	}
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// DeleteIdleRateLimitBucketsService removes the rate limit buckets unused for longer than the idle TTL. It returns the
+// number of buckets removed.
+type DeleteIdleRateLimitBucketsService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type deleteIdleRateLimitBucketsServiceImpl struct {
+	dao    dao.DeleteIdleRateLimitBucketsRepository
+	config models.RateLimitConfig
+}
+
+func (s *deleteIdleRateLimitBucketsServiceImpl) Exec(ctx context.Context) (int, error) {
+	return s.dao.DeleteIdleRateLimitBuckets(ctx, time.Now().Add(-s.config.IdleTTL))
+}
+
+func NewDeleteIdleRateLimitBucketsService(
+	dao dao.DeleteIdleRateLimitBucketsRepository, config models.RateLimitConfig,
+) DeleteIdleRateLimitBucketsService {
+	return &deleteIdleRateLimitBucketsServiceImpl{
+		dao:    dao,
+		config: config,
+	}
+}
@@ -0,0 +1,56 @@
+package services_test
+
+import (
+	"context"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDeleteIdleRateLimitBuckets(t *testing.T) {
+	testData := []struct {
+		name string
+
+		deleteResponse int
+		deleteErr      error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:           "DeleteIdleRateLimitBuckets",
+			deleteResponse: 3,
+			expect:         3,
+		},
+		{
+			name:      "DeleteError",
+			deleteErr: FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			deleteRepository := daomocks.NewMockDeleteIdleRateLimitBucketsRepository(t)
+
+			deleteRepository.
+				On("DeleteIdleRateLimitBuckets", context.TODO(), mock.MatchedBy(func(before time.Time) bool {
+					return time.Since(before) > 59*time.Minute && time.Since(before) < 61*time.Minute
+				})).
+				Return(data.deleteResponse, data.deleteErr)
+
+			service := services.NewDeleteIdleRateLimitBucketsService(deleteRepository, models.RateLimitConfig{IdleTTL: time.Hour})
+
+			count, err := service.Exec(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, count)
+
+			deleteRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// DeleteOutboxEventsService removes the outbox events published or marked dead for longer than the retention. It
+// returns the number of events removed.
+type DeleteOutboxEventsService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type deleteOutboxEventsServiceImpl struct {
+	dao    dao.DeleteOutboxEventsRepository
+	config models.OutboxRelayConfig
+}
+
+func (s *deleteOutboxEventsServiceImpl) Exec(ctx context.Context) (int, error) {
+	return s.dao.DeleteOutboxEvents(ctx, time.Now().Add(-s.config.Retention))
+}
+
+func NewDeleteOutboxEventsService(
+	dao dao.DeleteOutboxEventsRepository, config models.OutboxRelayConfig,
+) DeleteOutboxEventsService {
+	return &deleteOutboxEventsServiceImpl{
+		dao:    dao,
+		config: config,
+	}
+}
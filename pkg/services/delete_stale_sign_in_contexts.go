@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// DeleteStaleSignInContextsService removes the sign-in contexts unused for longer than the context retention. A user
+// signing in again from a removed context is assessed as if it was new. It returns the number of contexts removed.
+type DeleteStaleSignInContextsService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type deleteStaleSignInContextsServiceImpl struct {
+	dao    dao.DeleteStaleSignInContextsRepository
+	config models.SignInRiskConfig
+}
+
+func (s *deleteStaleSignInContextsServiceImpl) Exec(ctx context.Context) (int, error) {
+	return s.dao.DeleteStaleSignInContexts(ctx, time.Now().Add(-s.config.ContextRetention))
+}
+
+func NewDeleteStaleSignInContextsService(
+	dao dao.DeleteStaleSignInContextsRepository, config models.SignInRiskConfig,
+) DeleteStaleSignInContextsService {
+	return &deleteStaleSignInContextsServiceImpl{
+		dao:    dao,
+		config: config,
+	}
+}
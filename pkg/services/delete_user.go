@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// DeleteUserService deletes the account of the authenticated user, both in the database and in Firebase.
+type DeleteUserService interface {
+	Exec(ctx context.Context, token string) error
+}
+
+type deleteUserServiceImpl struct {
+	client *auth.Client
+	auth   AuthenticateService
+	dao    dao.DeleteUserRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	// maxAuthAge is how recently the user must have signed in to delete their account.
+	maxAuthAge time.Duration
+}
+
+func (s *deleteUserServiceImpl) Exec(ctx context.Context, token string) error {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token, MaxAuthAge: s.maxAuthAge})
+	if err != nil {
+		return err
+	}
+
+	// Deleting an account is not something a script should be able to do.
+	if user.Scopes != nil {
+		return ErrInsufficientScope
+	}
+
+	// The row is deleted first: if the Firebase deletion fails, the call can be retried, since a missing row is
+	// not an error.
+	if err := s.dao.DeleteUser(ctx, user.TenantID, user.FirebaseUID); err != nil && !errors.Is(err, dao.ErrUserNotFound) {
+		return err
+	}
+
+	client, err := firebaseUsersForTenant(s.client, user.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteUser(ctx, user.FirebaseUID); err != nil && !auth.IsUserNotFound(err) {
+		return err
+	}
+
+	return s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: user.FirebaseUID,
+		Action:     models.AuditActionDeleteUser,
+		Outcome:    models.AuditOutcomeSuccess,
+		Diff:       auditDiff(map[string]*models.AuditChange{"publicIdentifier": {Before: user.PublicIdentifier}}),
+	})
+}
+
+func NewDeleteUserService(
+	client *auth.Client,
+	auth AuthenticateService,
+	dao dao.DeleteUserRepository,
+	recordAuditEvent RecordAuditEventService,
+	maxAuthAge time.Duration,
+) DeleteUserService {
+	return &deleteUserServiceImpl{
+		client:           client,
+		auth:             auth,
+		dao:              dao,
+		recordAuditEvent: recordAuditEvent,
+		maxAuthAge:       maxAuthAge,
+	}
+}
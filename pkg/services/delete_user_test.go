@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"context"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var deleteUserFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+}
+
+func TestDeleteUser(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallDeleteUser bool
+		deleteUserErr        error
+
+		expectFirebaseDeleted bool
+		expectErr             error
+	}{
+		{
+			name:  "DeleteUser",
+			token: "foo-token",
+			authResponse: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+			},
+			shouldCallDeleteUser:  true,
+			expectFirebaseDeleted: true,
+		},
+		{
+			// A previous call may have failed after the row was deleted.
+			name:  "NoLocalData",
+			token: "foo-token",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallDeleteUser:  true,
+			deleteUserErr:         dao.ErrUserNotFound,
+			expectFirebaseDeleted: true,
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "AuthenticatedWithPersonalAccessToken",
+			token: "inr_pat_foo",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+				Scopes:      []string{models.ScopeUserWrite},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "DeleteUserError",
+			token: "foo-token",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallDeleteUser: true,
+			deleteUserErr:        FooErr,
+			expectErr:            FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			require.NoError(t, CreateUsersFixtures(deleteUserFixtures))
+			defer CleanUsersFixtures(deleteUserFixtures)
+
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			deleteUserRepository := daomocks.NewMockDeleteUserRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token, MaxAuthAge: 5 * time.Minute}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallDeleteUser {
+				deleteUserRepository.
+					On("DeleteUser", context.TODO(), data.authResponse.TenantID, data.authResponse.FirebaseUID).
+					Return(data.deleteUserErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionDeleteUser && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewDeleteUserService(
+				config.AuthClient, authService, deleteUserRepository, recordAuditEventService, 5*time.Minute,
+			)
+
+			err := service.Exec(context.TODO(), data.token)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			_, err = config.AuthClient.GetUser(context.TODO(), "user-one-uid")
+			if data.expectFirebaseDeleted {
+				require.True(t, auth.IsUserNotFound(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
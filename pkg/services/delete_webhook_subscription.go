@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+)
+
+// DeleteWebhookSubscriptionService is meant for internal tooling. Pending deliveries of the subscription are dropped.
+type DeleteWebhookSubscriptionService interface {
+	Exec(ctx context.Context, id string) error
+}
+
+type deleteWebhookSubscriptionServiceImpl struct {
+	deleteWebhookSubscriptionRepository dao.DeleteWebhookSubscriptionRepository
+}
+
+func (s *deleteWebhookSubscriptionServiceImpl) Exec(ctx context.Context, id string) error {
+	subscriptionID, err := uuid.Parse(id)
+	if err != nil {
+		return errors.Join(ErrInvalidWebhookSubscriptionID, err)
+	}
+
+	if err := s.deleteWebhookSubscriptionRepository.DeleteWebhookSubscription(ctx, subscriptionID); err != nil {
+		if errors.Is(err, dao.ErrWebhookSubscriptionNotFound) {
+			return ErrWebhookSubscriptionNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func NewDeleteWebhookSubscriptionService(
+	deleteWebhookSubscriptionRepository dao.DeleteWebhookSubscriptionRepository,
+) DeleteWebhookSubscriptionService {
+	return &deleteWebhookSubscriptionServiceImpl{
+		deleteWebhookSubscriptionRepository: deleteWebhookSubscriptionRepository,
+	}
+}
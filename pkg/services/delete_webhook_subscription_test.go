@@ -0,0 +1,67 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteWebhookSubscription(t *testing.T) {
+	testData := []struct {
+		name string
+
+		id string
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name:             "DeleteWebhookSubscription",
+			id:               "00000000-0000-0000-0000-000000000001",
+			shouldCallDelete: true,
+		},
+		{
+			name:      "InvalidID",
+			id:        "not-a-uuid",
+			expectErr: services.ErrInvalidWebhookSubscriptionID,
+		},
+		{
+			name:             "SubscriptionNotFound",
+			id:               "00000000-0000-0000-0000-000000000001",
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrWebhookSubscriptionNotFound,
+			expectErr:        services.ErrWebhookSubscriptionNotFound,
+		},
+		{
+			name:             "DeleteError",
+			id:               "00000000-0000-0000-0000-000000000001",
+			shouldCallDelete: true,
+			deleteErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			deleteRepository := daomocks.NewMockDeleteWebhookSubscriptionRepository(t)
+
+			if data.shouldCallDelete {
+				deleteRepository.
+					On("DeleteWebhookSubscription", context.TODO(), uuid.MustParse(data.id)).
+					Return(data.deleteErr)
+			}
+
+			service := services.NewDeleteWebhookSubscriptionService(deleteRepository)
+
+			err := service.Exec(context.TODO(), data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// DeliverWebhooksService attempts a batch of due webhook deliveries, and returns how many succeeded. Failed
+// deliveries are retried with an exponential backoff, until they run out of attempts.
+type DeliverWebhooksService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type deliverWebhooksServiceImpl struct {
+	claimWebhookDeliveriesRepository       dao.ClaimWebhookDeliveriesRepository
+	recordWebhookDeliveryAttemptRepository dao.RecordWebhookDeliveryAttemptRepository
+	sender                                 clients.WebhookSender
+	config                                 models.WebhookDeliveryConfig
+}
+
+// deliver attempts a single delivery, and records the outcome. Failed attempts are not an error of the worker itself:
+// they are only reported by the returned boolean.
+func (s *deliverWebhooksServiceImpl) deliver(ctx context.Context, delivery *entities.WebhookDelivery) (bool, error) {
+	sendCtx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	statusCode, err := s.sender.Send(sendCtx, &clients.Webhook{
+		ID:      delivery.ID.String(),
+		URL:     delivery.Subscription.URL,
+		Secret:  delivery.Subscription.Secret,
+		Payload: delivery.Payload,
+	})
+
+	attempt := &dao.RecordWebhookDeliveryAttemptData{
+		StatusCode: lo.EmptyableToPtr(statusCode),
+		Duration:   time.Since(start),
+		Status:     entities.WebhookDeliveryStatusDelivered,
+	}
+
+	if err != nil {
+		attempt.Error = lo.ToPtr(err.Error())
+
+		if delivery.Attempts+1 >= s.config.MaxAttempts {
+			attempt.Status = entities.WebhookDeliveryStatusDead
+		} else {
+			attempt.Status = entities.WebhookDeliveryStatusPending
+			attempt.NextAttemptAt = time.Now().Add(
+				exponentialBackoff(s.config.MinBackoff, s.config.MaxBackoff, delivery.Attempts),
+			)
+		}
+	}
+
+	if err := s.recordWebhookDeliveryAttemptRepository.RecordWebhookDeliveryAttempt(ctx, *delivery.ID, attempt); err != nil {
+		return false, err
+	}
+
+	return attempt.Status == entities.WebhookDeliveryStatusDelivered, nil
+}
+
+func (s *deliverWebhooksServiceImpl) Exec(ctx context.Context) (int, error) {
+	leaseUntil := time.Now().Add(s.config.Lease)
+
+	deliveries, err := s.claimWebhookDeliveriesRepository.ClaimWebhookDeliveries(ctx, leaseUntil, s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		// Deliveries are sent one after another. Once the lease could expire during a send, another worker may claim
+		// the remaining deliveries and send them twice, so they are left for when their lease expires instead.
+		if time.Until(leaseUntil) < s.config.Timeout {
+			break
+		}
+
+		ok, err := s.deliver(ctx, delivery)
+		if err != nil {
+			return delivered, err
+		}
+
+		if ok {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+func NewDeliverWebhooksService(
+	claimWebhookDeliveriesRepository dao.ClaimWebhookDeliveriesRepository,
+	recordWebhookDeliveryAttemptRepository dao.RecordWebhookDeliveryAttemptRepository,
+	sender clients.WebhookSender,
+	config models.WebhookDeliveryConfig,
+) DeliverWebhooksService {
+	return &deliverWebhooksServiceImpl{
+		claimWebhookDeliveriesRepository:       claimWebhookDeliveriesRepository,
+		recordWebhookDeliveryAttemptRepository: recordWebhookDeliveryAttemptRepository,
+		sender:                                 sender,
+		config:                                 config,
+	}
+}
@@ -0,0 +1,236 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var deliverWebhooksConfig = models.WebhookDeliveryConfig{
+	BatchSize:   10,
+	Lease:       time.Minute,
+	Timeout:     time.Second,
+	MinBackoff:  time.Second,
+	MaxBackoff:  5 * time.Second,
+	MaxAttempts: 3,
+}
+
+func newWebhookDelivery(id string, attempts int) *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		ID:             lo.ToPtr(uuid.MustParse(id)),
+		SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		EventID:        "1",
+		EventType:      entities.OutboxEventUserCreated,
+		Payload:        json.RawMessage(`{"id": "1"}`),
+		Status:         entities.WebhookDeliveryStatusPending,
+		Attempts:       attempts,
+		Subscription: &entities.WebhookSubscription{
+			ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			URL:    "https://example.com/webhooks",
+			Secret: "secret-1",
+		},
+	}
+}
+
+type expectedWebhookAttempt struct {
+	statusCode *int
+	status     entities.WebhookDeliveryStatus
+	// retryIn is only checked for pending deliveries.
+	retryIn time.Duration
+}
+
+func TestDeliverWebhooks(t *testing.T) {
+	testData := []struct {
+		name string
+
+		claimResponse []*entities.WebhookDelivery
+		claimErr      error
+
+		sendStatus map[string]int
+		sendErr    map[string]error
+
+		recordErr error
+
+		expectAttempts map[string]*expectedWebhookAttempt
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name: "DeliverWebhooks",
+			claimResponse: []*entities.WebhookDelivery{
+				newWebhookDelivery("00000000-0000-0000-0000-000000000001", 0),
+				newWebhookDelivery("00000000-0000-0000-0000-000000000002", 1),
+			},
+			sendStatus: map[string]int{
+				"00000000-0000-0000-0000-000000000001": 200,
+				"00000000-0000-0000-0000-000000000002": 204,
+			},
+			expectAttempts: map[string]*expectedWebhookAttempt{
+				"00000000-0000-0000-0000-000000000001": {statusCode: lo.ToPtr(200), status: entities.WebhookDeliveryStatusDelivered},
+				"00000000-0000-0000-0000-000000000002": {statusCode: lo.ToPtr(204), status: entities.WebhookDeliveryStatusDelivered},
+			},
+			expect: 2,
+		},
+		{
+			name:          "NoDeliveries",
+			claimResponse: []*entities.WebhookDelivery{},
+		},
+		{
+			name:      "ClaimError",
+			claimErr:  FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name: "Retry",
+			claimResponse: []*entities.WebhookDelivery{
+				newWebhookDelivery("00000000-0000-0000-0000-000000000001", 0),
+				newWebhookDelivery("00000000-0000-0000-0000-000000000002", 1),
+			},
+			sendStatus: map[string]int{
+				"00000000-0000-0000-0000-000000000001": 503,
+			},
+			sendErr: map[string]error{
+				"00000000-0000-0000-0000-000000000001": clients.ErrWebhookRejected,
+				"00000000-0000-0000-0000-000000000002": FooErr,
+			},
+			expectAttempts: map[string]*expectedWebhookAttempt{
+				"00000000-0000-0000-0000-000000000001": {
+					statusCode: lo.ToPtr(503),
+					status:     entities.WebhookDeliveryStatusPending,
+					retryIn:    time.Second,
+				},
+				"00000000-0000-0000-0000-000000000002": {
+					status:  entities.WebhookDeliveryStatusPending,
+					retryIn: 2 * time.Second,
+				},
+			},
+		},
+		{
+			name: "Dead",
+			claimResponse: []*entities.WebhookDelivery{
+				newWebhookDelivery("00000000-0000-0000-0000-000000000001", 2),
+			},
+			sendErr: map[string]error{
+				"00000000-0000-0000-0000-000000000001": FooErr,
+			},
+			expectAttempts: map[string]*expectedWebhookAttempt{
+				"00000000-0000-0000-0000-000000000001": {status: entities.WebhookDeliveryStatusDead},
+			},
+		},
+		{
+			name: "RecordError",
+			claimResponse: []*entities.WebhookDelivery{
+				newWebhookDelivery("00000000-0000-0000-0000-000000000001", 0),
+			},
+			sendStatus: map[string]int{
+				"00000000-0000-0000-0000-000000000001": 200,
+			},
+			expectAttempts: map[string]*expectedWebhookAttempt{
+				"00000000-0000-0000-0000-000000000001": {statusCode: lo.ToPtr(200), status: entities.WebhookDeliveryStatusDelivered},
+			},
+			recordErr: FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			claimRepository := daomocks.NewMockClaimWebhookDeliveriesRepository(t)
+			recordRepository := daomocks.NewMockRecordWebhookDeliveryAttemptRepository(t)
+			sender := clientsmocks.NewMockWebhookSender(t)
+
+			claimRepository.
+				On("ClaimWebhookDeliveries", context.TODO(), mock.AnythingOfType("time.Time"), deliverWebhooksConfig.BatchSize).
+				Return(data.claimResponse, data.claimErr)
+
+			for id, expected := range data.expectAttempts {
+				sender.
+					On("Send", mock.Anything, &clients.Webhook{
+						ID:      id,
+						URL:     "https://example.com/webhooks",
+						Secret:  "secret-1",
+						Payload: []byte(`{"id": "1"}`),
+					}).
+					Return(data.sendStatus[id], data.sendErr[id])
+
+				recordRepository.
+					On("RecordWebhookDeliveryAttempt", context.TODO(), uuid.MustParse(id), mock.MatchedBy(func(in *dao.RecordWebhookDeliveryAttemptData) bool {
+						if in.Status != expected.status || lo.FromPtr(in.StatusCode) != lo.FromPtr(expected.statusCode) {
+							return false
+						}
+
+						if (data.sendErr[id] == nil) != (in.Error == nil) {
+							return false
+						}
+
+						if expected.status == entities.WebhookDeliveryStatusPending {
+							return in.NextAttemptAt.Sub(time.Now().Add(expected.retryIn)).Abs() < time.Second/2
+						}
+
+						return true
+					})).
+					Return(data.recordErr)
+			}
+
+			service := services.NewDeliverWebhooksService(claimRepository, recordRepository, sender, deliverWebhooksConfig)
+
+			count, err := service.Exec(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, count)
+		})
+	}
+}
+
+func TestDeliverWebhooksLeaseExpiring(t *testing.T) {
+	webhooksConfig := deliverWebhooksConfig
+	webhooksConfig.Lease = 1500 * time.Millisecond
+
+	claimRepository := daomocks.NewMockClaimWebhookDeliveriesRepository(t)
+	recordRepository := daomocks.NewMockRecordWebhookDeliveryAttemptRepository(t)
+	sender := clientsmocks.NewMockWebhookSender(t)
+
+	claimRepository.
+		On("ClaimWebhookDeliveries", context.TODO(), mock.AnythingOfType("time.Time"), webhooksConfig.BatchSize).
+		Return([]*entities.WebhookDelivery{
+			newWebhookDelivery("00000000-0000-0000-0000-000000000001", 0),
+			newWebhookDelivery("00000000-0000-0000-0000-000000000002", 0),
+		}, nil)
+
+	// The first delivery is slow, so the second one could not be sent before the lease expires.
+	sender.
+		On("Send", mock.Anything, mock.MatchedBy(func(in *clients.Webhook) bool {
+			return in.ID == "00000000-0000-0000-0000-000000000001"
+		})).
+		After(600*time.Millisecond).
+		Return(200, nil)
+	recordRepository.
+		On(
+			"RecordWebhookDeliveryAttempt", context.TODO(), uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			mock.Anything,
+		).
+		Return(nil)
+
+	service := services.NewDeliverWebhooksService(claimRepository, recordRepository, sender, webhooksConfig)
+
+	count, err := service.Exec(context.TODO())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	sender.AssertExpectations(t)
+	recordRepository.AssertExpectations(t)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// EndImpersonationService ends an impersonation session before it expires. Tokens of the session are rejected by
+// Authenticate right away.
+type EndImpersonationService interface {
+	Exec(ctx context.Context, token string, id string) (*models.ImpersonationSession, error)
+}
+
+type endImpersonationServiceImpl struct {
+	auth AuthenticateService
+	dao  dao.EndImpersonationSessionRepository
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *endImpersonationServiceImpl) Exec(ctx context.Context, token string, id string) (*models.ImpersonationSession, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil || !user.Staff {
+		return nil, ErrNotStaff
+	}
+
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidImpersonationSessionID, err)
+	}
+
+	session, err := s.dao.EndImpersonationSession(ctx, user.FirebaseUID, sessionID, time.Now())
+	if err != nil {
+		if errors.Is(err, dao.ErrImpersonationSessionNotFound) {
+			return nil, ErrImpersonationSessionNotFound
+		}
+
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: session.TargetUID,
+		Target:     "impersonation_sessions/" + session.ID.String(),
+		Action:     models.AuditActionEndImpersonation,
+		Outcome:    models.AuditOutcomeSuccess,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return impersonationSessionToModel(session), nil
+}
+
+func NewEndImpersonationService(
+	auth AuthenticateService,
+	dao dao.EndImpersonationSessionRepository,
+	recordAuditEvent RecordAuditEventService,
+) EndImpersonationService {
+	return &endImpersonationServiceImpl{
+		auth:             auth,
+		dao:              dao,
+		recordAuditEvent: recordAuditEvent,
+	}
+}
@@ -0,0 +1,144 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestEndImpersonation(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token string
+		id    string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallEndImpersonationSession bool
+		endImpersonationSessionResponse   *entities.ImpersonationSession
+		endImpersonationSessionErr        error
+
+		expect    *models.ImpersonationSession
+		expectErr error
+	}{
+		{
+			name:                              "EndImpersonation",
+			token:                             "foo-token",
+			id:                                "00000000-0000-0000-0000-000000000001",
+			authResponse:                      &models.User{FirebaseUID: "staff-uid-1", Staff: true},
+			shouldCallEndImpersonationSession: true,
+			endImpersonationSessionResponse: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+				EndedAt:         lo.ToPtr(time.Date(2024, 10, 15, 12, 10, 0, 0, time.UTC)),
+				CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ImpersonationSession{
+				ID:              "00000000-0000-0000-0000-000000000001",
+				ImpersonatorUID: "staff-uid-1",
+				TargetUID:       "firebase-uid-1",
+				Reason:          "ticket 1234",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+				EndedAt:         lo.ToPtr(time.Date(2024, 10, 15, 12, 10, 0, 0, time.UTC)),
+				CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:         "NotStaff",
+			token:        "foo-token",
+			id:           "00000000-0000-0000-0000-000000000001",
+			authResponse: &models.User{FirebaseUID: "firebase-uid-1"},
+			expectErr:    services.ErrNotStaff,
+		},
+		{
+			name:         "InvalidID",
+			token:        "foo-token",
+			id:           "not-a-uuid",
+			authResponse: &models.User{FirebaseUID: "staff-uid-1", Staff: true},
+			expectErr:    services.ErrInvalidImpersonationSessionID,
+		},
+		{
+			name:                              "ImpersonationSessionNotFound",
+			token:                             "foo-token",
+			id:                                "00000000-0000-0000-0000-000000000001",
+			authResponse:                      &models.User{FirebaseUID: "staff-uid-1", Staff: true},
+			shouldCallEndImpersonationSession: true,
+			endImpersonationSessionErr:        dao.ErrImpersonationSessionNotFound,
+			expectErr:                         services.ErrImpersonationSessionNotFound,
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			id:        "00000000-0000-0000-0000-000000000001",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:                              "EndImpersonationSessionError",
+			token:                             "foo-token",
+			id:                                "00000000-0000-0000-0000-000000000001",
+			authResponse:                      &models.User{FirebaseUID: "staff-uid-1", Staff: true},
+			shouldCallEndImpersonationSession: true,
+			endImpersonationSessionErr:        FooErr,
+			expectErr:                         FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			endImpersonationSessionRepository := daomocks.NewMockEndImpersonationSessionRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallEndImpersonationSession {
+				endImpersonationSessionRepository.
+					On("EndImpersonationSession", context.TODO(), "staff-uid-1", uuid.MustParse(data.id), mock.Anything).
+					Return(data.endImpersonationSessionResponse, data.endImpersonationSessionErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), &models.RecordAuditEvent{
+						ActorUID:   "staff-uid-1",
+						SubjectUID: "firebase-uid-1",
+						Target:     "impersonation_sessions/00000000-0000-0000-0000-000000000001",
+						Action:     models.AuditActionEndImpersonation,
+						Outcome:    models.AuditOutcomeSuccess,
+					}).
+					Return(nil)
+			}
+
+			service := services.NewEndImpersonationService(
+				authService, endImpersonationSessionRepository, recordAuditEventService,
+			)
+
+			session, err := service.Exec(context.TODO(), data.token, data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, session)
+
+			authService.AssertExpectations(t)
+			endImpersonationSessionRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
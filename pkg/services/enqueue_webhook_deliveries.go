@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+// EnqueueWebhookDeliveriesService schedules the delivery of a user event to every webhook subscribed to it. It is
+// fed by the outbox relay, through a clients.PublisherFunc.
+type EnqueueWebhookDeliveriesService interface {
+	Exec(ctx context.Context, event *clients.UserEvent) error
+}
+
+type enqueueWebhookDeliveriesServiceImpl struct {
+	createWebhookDeliveriesRepository dao.CreateWebhookDeliveriesRepository
+}
+
+func (s *enqueueWebhookDeliveriesServiceImpl) Exec(ctx context.Context, event *clients.UserEvent) error {
+	payload, err := json.Marshal(&models.WebhookPayload{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		Data: &models.WebhookUserData{
+			TenantID:         event.TenantID,
+			FirebaseUID:      event.FirebaseUID,
+			PublicIdentifier: event.PublicIdentifier,
+			MergedUID:        event.MergedUID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.createWebhookDeliveriesRepository.CreateWebhookDeliveries(ctx, &dao.CreateWebhookDeliveriesData{
+		EventID:   event.ID,
+		EventType: event.Type,
+		Payload:   payload,
+	})
+
+	return err
+}
+
+func NewEnqueueWebhookDeliveriesService(
+	createWebhookDeliveriesRepository dao.CreateWebhookDeliveriesRepository,
+) EnqueueWebhookDeliveriesService {
+	return &enqueueWebhookDeliveriesServiceImpl{
+		createWebhookDeliveriesRepository: createWebhookDeliveriesRepository,
+	}
+}
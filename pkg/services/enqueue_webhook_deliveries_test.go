@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestEnqueueWebhookDeliveries(t *testing.T) {
+	testData := []struct {
+		name string
+
+		event *clients.UserEvent
+
+		createErr error
+
+		expectPayload string
+		expectErr     error
+	}{
+		{
+			name: "EnqueueWebhookDeliveries",
+			event: &clients.UserEvent{
+				ID:               "1",
+				Type:             clients.UserEventUpdated,
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-1",
+				OccurredAt:       time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC),
+			},
+			expectPayload: `{
+				"id": "1",
+				"type": "user.updated",
+				"occurredAt": "2024-10-10T00:00:00Z",
+				"data": {"firebaseUID": "user-one-uid", "publicIdentifier": "public-identifier-1"}
+			}`,
+		},
+		{
+			name: "TenantUser",
+			event: &clients.UserEvent{
+				ID:               "2",
+				Type:             clients.UserEventDeleted,
+				TenantID:         "tenant-1",
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-1",
+				OccurredAt:       time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC),
+			},
+			expectPayload: `{
+				"id": "2",
+				"type": "user.deleted",
+				"occurredAt": "2024-10-10T00:00:00Z",
+				"data": {"tenantID": "tenant-1", "firebaseUID": "user-one-uid", "publicIdentifier": "public-identifier-1"}
+			}`,
+		},
+		{
+			name: "MergedUser",
+			event: &clients.UserEvent{
+				ID:               "3",
+				Type:             clients.UserEventMerged,
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-1",
+				MergedUID:        "user-two-uid",
+				OccurredAt:       time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC),
+			},
+			expectPayload: `{
+				"id": "3",
+				"type": "user.merged",
+				"occurredAt": "2024-10-10T00:00:00Z",
+				"data": {"firebaseUID": "user-one-uid", "publicIdentifier": "public-identifier-1", "mergedUID": "user-two-uid"}
+			}`,
+		},
+		{
+			name: "CreateError",
+			event: &clients.UserEvent{
+				ID:          "1",
+				Type:        clients.UserEventCreated,
+				FirebaseUID: "user-one-uid",
+			},
+			createErr: FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			createRepository := daomocks.NewMockCreateWebhookDeliveriesRepository(t)
+
+			var payload string
+			createRepository.
+				On("CreateWebhookDeliveries", context.TODO(), mock.MatchedBy(func(in *dao.CreateWebhookDeliveriesData) bool {
+					payload = string(in.Payload)
+					return in.EventID == data.event.ID && in.EventType == data.event.Type
+				})).
+				Return(1, data.createErr)
+
+			service := services.NewEnqueueWebhookDeliveriesService(createRepository)
+
+			err := service.Exec(context.TODO(), data.event)
+
+			require.ErrorIs(t, err, data.expectErr)
+			if data.expectPayload != "" {
+				require.JSONEq(t, data.expectPayload, payload)
+			}
+		})
+	}
+}
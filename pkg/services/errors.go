@@ -8,6 +8,96 @@ var (
 	ErrUnauthenticated  = errors.New("unauthenticated")
 	ErrVerifyToken      = errors.New("verify token")
 	ErrEmailNotVerified = errors.New("email not verified")
+	ErrUserDisabled     = errors.New("user disabled")
+	ErrStepUpRequired   = errors.New("step-up authentication required")
+	ErrMFARequired      = errors.New("multi-factor authentication required")
+
+	ErrReauthenticationRequired = errors.New("reauthentication required")
+
+	ErrIDTokenRequired                = errors.New("an ID token is required")
+	ErrSessionCookieTenantUnsupported = errors.New("session cookies are not supported for tenant users")
+
+	ErrIdentityProviderRequired       = errors.New("user must sign in with the identity provider of their organization")
+	ErrIdentityProviderDomainMismatch = errors.New("email domain is not served by this identity provider")
+
+	ErrEmailVerificationGracePeriodExpired = errors.New("email verification grace period expired")
+
+	ErrNoEmail              = errors.New("user has no email")
+	ErrInvalidEmail         = errors.New("invalid email")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrEmailThrottled       = errors.New("too many emails sent recently")
+
+	ErrEmailDomainBlocked    = errors.New("email domain blocked")
+	ErrEmailDomainNotAllowed = errors.New("email domain not allowed")
 
 	ErrInvalidUpdateUser = errors.New("invalid update user")
+
+	ErrInvalidCountActiveUsers = errors.New("invalid count active users")
+
+	ErrInsufficientScope                = errors.New("insufficient scope")
+	ErrPersonalAccessTokenNotFound      = errors.New("personal access token not found")
+	ErrPersonalAccessTokenExpired       = errors.New("personal access token expired")
+	ErrInvalidCreatePersonalAccessToken = errors.New("invalid create personal access token")
+	ErrInvalidPersonalAccessTokenID     = errors.New("invalid personal access token id")
+
+	ErrInvalidOrganizationID           = errors.New("invalid organization id")
+	ErrInvalidCreateOrganization       = errors.New("invalid create organization")
+	ErrInvalidUpdateOrganization       = errors.New("invalid update organization")
+	ErrOrganizationNotFound            = errors.New("organization not found")
+	ErrInvalidAddOrganizationMember    = errors.New("invalid add organization member")
+	ErrInvalidRemoveOrganizationMember = errors.New("invalid remove organization member")
+	ErrNotOrganizationMember           = errors.New("not an organization member")
+	ErrInsufficientOrganizationRole    = errors.New("insufficient organization role")
+	ErrOrganizationMemberAlreadyExists = errors.New("organization member already exists")
+	ErrOrganizationMemberNotFound      = errors.New("organization member not found")
+	ErrLastOrganizationOwner           = errors.New("cannot remove the last organization owner")
+
+	ErrInvalidInviteOrganizationMember = errors.New("invalid invite organization member")
+	ErrInvalidResendInvitation         = errors.New("invalid resend invitation")
+	ErrInvalidInvitationID             = errors.New("invalid invitation id")
+	ErrInvitationAlreadyExists         = errors.New("invitation already exists")
+	ErrInvitationNotFound              = errors.New("invitation not found")
+	ErrInvitationExpired               = errors.New("invitation expired")
+	ErrInvitationEmailMismatch         = errors.New("invitation was sent to another email")
+
+	ErrInvalidListAuditEvents = errors.New("invalid list audit events")
+	ErrInvalidPageToken       = errors.New("invalid page token")
+
+	// ErrAuditCheckpointKeyMissing is returned instead of signing checkpoints with an empty key, which anyone could
+	// forge.
+	ErrAuditCheckpointKeyMissing = errors.New("audit checkpoint key is missing")
+
+	ErrInvalidCreateWebhookSubscription = errors.New("invalid create webhook subscription")
+	ErrInvalidWebhookSubscriptionID     = errors.New("invalid webhook subscription id")
+	ErrWebhookSubscriptionNotFound      = errors.New("webhook subscription not found")
+	ErrInvalidListWebhookDeliveries     = errors.New("invalid list webhook deliveries")
+	ErrInvalidWebhookDeliveryID         = errors.New("invalid webhook delivery id")
+	ErrWebhookDeliveryNotFound          = errors.New("webhook delivery not found")
+
+	// ErrWebhookLeaseTooShort is returned when the lease of a batch of deliveries could expire while it is being sent,
+	// letting another worker send the same deliveries again.
+	ErrWebhookLeaseTooShort = errors.New("webhook delivery lease is shorter than a batch")
+
+	ErrNotStaff                      = errors.New("not a staff member")
+	ErrInvalidImpersonate            = errors.New("invalid impersonate")
+	ErrCannotImpersonateStaff        = errors.New("cannot impersonate a staff member")
+	ErrInvalidImpersonationSessionID = errors.New("invalid impersonation session id")
+	ErrImpersonationSessionNotFound  = errors.New("impersonation session not found")
+	ErrImpersonationSessionEnded     = errors.New("impersonation session ended")
+
+	ErrServiceUnauthenticated     = errors.New("service unauthenticated")
+	ErrInvalidMintCustomToken     = errors.New("invalid mint custom token")
+	ErrCustomTokenNotAllowed      = errors.New("service is not allowed to mint custom tokens for this user")
+	ErrCustomTokenClaimNotAllowed = errors.New("service is not allowed to set this claim")
+	ErrCustomTokenStaffUser       = errors.New("cannot mint custom tokens for a staff member")
+
+	ErrInvalidLinkAccounts        = errors.New("invalid link accounts")
+	ErrAccountsAlreadyLinked      = errors.New("accounts already linked")
+	ErrLinkAccountsEmailMismatch  = errors.New("accounts do not share a verified email")
+	ErrLinkAccountsTenantMismatch = errors.New("accounts belong to different tenants")
+	ErrLinkAccountsStaff          = errors.New("staff accounts cannot be linked")
+
+	ErrInvalidMergeUsers     = errors.New("invalid merge users")
+	ErrMergeSurvivorIncluded = errors.New("the surviving user cannot be merged into itself")
+	ErrMergeUsersStaff       = errors.New("staff accounts cannot be merged")
 )
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+)
+
+// FlushAuditEventsService writes the audit events buffered since the last flush, and returns the number of events
+// written. On failure, the events are put back in the buffer for the next flush.
+type FlushAuditEventsService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type flushAuditEventsServiceImpl struct {
+	buffer                      *AuditEventBuffer
+	createAuditEventsRepository dao.CreateAuditEventsRepository
+}
+
+func (s *flushAuditEventsServiceImpl) Exec(ctx context.Context) (int, error) {
+	events := s.buffer.take()
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if _, err := s.createAuditEventsRepository.CreateAuditEvents(ctx, events); err != nil {
+		s.buffer.restore(events)
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+func NewFlushAuditEventsService(
+	buffer *AuditEventBuffer, createAuditEventsRepository dao.CreateAuditEventsRepository,
+) FlushAuditEventsService {
+	return &flushAuditEventsServiceImpl{
+		buffer:                      buffer,
+		createAuditEventsRepository: createAuditEventsRepository,
+	}
+}
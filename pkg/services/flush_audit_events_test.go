@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"slices"
+	"testing"
+)
+
+func TestFlushAuditEvents(t *testing.T) {
+	testData := []struct {
+		name string
+
+		// recorded lists the subjects of the failed authentications recorded before the flush.
+		recorded []string
+
+		shouldCallCreateAuditEvents bool
+		createAuditEventsErr        error
+
+		expect    int
+		expectErr error
+		// expectRetried is the number of events written by the next flush.
+		expectRetried int
+	}{
+		{
+			name:                        "FlushAuditEvents",
+			recorded:                    []string{"firebase-uid-1", "firebase-uid-2"},
+			shouldCallCreateAuditEvents: true,
+			expect:                      2,
+		},
+		{
+			name: "Empty",
+		},
+		{
+			name:                        "CreateAuditEventsError",
+			recorded:                    []string{"firebase-uid-1", "firebase-uid-2"},
+			shouldCallCreateAuditEvents: true,
+			createAuditEventsErr:        FooErr,
+			expectErr:                   FooErr,
+			expectRetried:               2,
+		},
+	}
+
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "198.51.100.1, 203.0.113.1",
+		"user-agent", "grpc-go/1.64.0",
+	))
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			createAuditEventsRepository := daomocks.NewMockCreateAuditEventsRepository(t)
+
+			// Events are written in the order they were recorded, with the metadata of the request that recorded them.
+			matchEvents := mock.MatchedBy(func(in []*dao.CreateAuditEventData) bool {
+				subjects := lo.Map(in, func(item *dao.CreateAuditEventData, _ int) string {
+					return lo.FromPtr(item.SubjectUID)
+				})
+				fromClient := lo.EveryBy(in, func(item *dao.CreateAuditEventData) bool {
+					return lo.FromPtr(item.PeerIP) == "203.0.113.1"
+				})
+
+				return slices.Equal(data.recorded, subjects) && fromClient
+			})
+
+			if data.shouldCallCreateAuditEvents {
+				createAuditEventsRepository.
+					On("CreateAuditEvents", ctx, matchEvents).
+					Return(nil, data.createAuditEventsErr).
+					Once()
+			}
+
+			buffer := services.NewAuditEventBuffer()
+			recordService := services.NewBufferAuditEventService(buffer, 1)
+			for _, subjectUID := range data.recorded {
+				err := recordService.Exec(ctx, &models.RecordAuditEvent{
+					SubjectUID: subjectUID,
+					Action:     models.AuditActionAuthenticate,
+					Outcome:    models.AuditOutcomeFailure,
+				})
+				require.NoError(t, err)
+			}
+
+			service := services.NewFlushAuditEventsService(buffer, createAuditEventsRepository)
+
+			count, err := service.Exec(ctx)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, count)
+
+			// Failed writes are kept for the next flush.
+			if data.expectRetried > 0 {
+				createAuditEventsRepository.
+					On("CreateAuditEvents", ctx, matchEvents).
+					Return(nil, nil).
+					Once()
+			}
+
+			count, err = service.Exec(ctx)
+
+			require.NoError(t, err)
+			require.Equal(t, data.expectRetried, count)
+
+			createAuditEventsRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+)
+
+// FlushUserActivityService writes the activity buffered since the last flush, and returns the number of users
+// written. On failure, the activity is put back in the buffer for the next flush.
+type FlushUserActivityService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type flushUserActivityServiceImpl struct {
+	buffer                         *UserActivityBuffer
+	recordUserActivitiesRepository dao.RecordUserActivitiesRepository
+}
+
+func (s *flushUserActivityServiceImpl) Exec(ctx context.Context) (int, error) {
+	activities := s.buffer.take()
+	if len(activities) == 0 {
+		return 0, nil
+	}
+
+	if err := s.recordUserActivitiesRepository.RecordUserActivities(ctx, activities); err != nil {
+		for _, activity := range activities {
+			s.buffer.add(activity)
+		}
+
+		return 0, err
+	}
+
+	return len(activities), nil
+}
+
+func NewFlushUserActivityService(
+	buffer *UserActivityBuffer, recordUserActivitiesRepository dao.RecordUserActivitiesRepository,
+) FlushUserActivityService {
+	return &flushUserActivityServiceImpl{
+		buffer:                         buffer,
+		recordUserActivitiesRepository: recordUserActivitiesRepository,
+	}
+}
@@ -0,0 +1,89 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFlushUserActivity(t *testing.T) {
+	testData := []struct {
+		name string
+
+		tracked []*models.TrackUserActivity
+
+		shouldCallRecordUserActivities bool
+		recordUserActivitiesErr        error
+
+		expect    int
+		expectErr error
+		// expectRetried is the number of users written by the next flush.
+		expectRetried int
+	}{
+		{
+			name:                           "FlushUserActivity",
+			tracked:                        []*models.TrackUserActivity{{FirebaseUID: "firebase-uid-1"}, {FirebaseUID: "firebase-uid-2"}},
+			shouldCallRecordUserActivities: true,
+			expect:                         2,
+		},
+		{
+			name: "Empty",
+		},
+		{
+			name:                           "RecordUserActivitiesError",
+			tracked:                        []*models.TrackUserActivity{{FirebaseUID: "firebase-uid-1"}, {FirebaseUID: "firebase-uid-2"}},
+			shouldCallRecordUserActivities: true,
+			recordUserActivitiesErr:        FooErr,
+			expectErr:                      FooErr,
+			expectRetried:                  2,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			recordUserActivitiesRepository := daomocks.NewMockRecordUserActivitiesRepository(t)
+
+			if data.shouldCallRecordUserActivities {
+				recordUserActivitiesRepository.
+					On("RecordUserActivities", context.TODO(), mock.MatchedBy(func(in []*dao.RecordUserActivityData) bool {
+						return len(in) == len(data.tracked)
+					})).
+					Return(data.recordUserActivitiesErr).
+					Once()
+			}
+
+			buffer := services.NewUserActivityBuffer()
+			trackService := services.NewTrackUserActivityService(buffer, 1)
+			for _, tracked := range data.tracked {
+				trackService.Exec(context.TODO(), tracked)
+			}
+
+			service := services.NewFlushUserActivityService(buffer, recordUserActivitiesRepository)
+
+			count, err := service.Exec(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, count)
+
+			// Failed writes are kept for the next flush.
+			if data.expectRetried > 0 {
+				recordUserActivitiesRepository.
+					On("RecordUserActivities", context.TODO(), mock.Anything).
+					Return(nil).
+					Once()
+			}
+
+			count, err = service.Exec(context.TODO())
+
+			require.NoError(t, err)
+			require.Equal(t, data.expectRetried, count)
+
+			recordUserActivitiesRepository.AssertExpectations(t)
+		})
+	}
+}
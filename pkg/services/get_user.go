@@ -10,44 +10,86 @@ import (
 )
 
 type GetUserService interface {
-	Exec(ctx context.Context, uid string) (*models.User, error)
+	Exec(ctx context.Context, tenantID string, uid string) (*models.User, error)
 }
 
 type getUserServiceImpl struct {
-	client *auth.Client
-	dao    dao.GetUserRepository
+	client                    *auth.Client
+	dao                       dao.GetUserRepository
+	getUserActivityRepository dao.GetUserActivityRepository
+	getUserAliasRepository    dao.GetUserAliasRepository
+	identityProviders         []models.IdentityProviderConfig
 }
 
-func (s *getUserServiceImpl) Exec(ctx context.Context, uid string) (*models.User, error) {
-	user, err := s.client.GetUser(ctx, uid)
+func (s *getUserServiceImpl) Exec(ctx context.Context, tenantID string, uid string) (*models.User, error) {
+	// Linked accounts are returned as the user they were linked to.
+	uid, err := resolveUserAlias(ctx, s.getUserAliasRepository, tenantID, uid)
 	if err != nil {
-		if auth.IsUserNotFound(err) {
-			return nil, ErrUserNotFound
+		return nil, err
+	}
+
+	result := &models.User{
+		TenantID:         tenantID,
+		FirebaseUID:      uid,
+		IdentityProvider: externalIdentityProviderName(s.identityProviders, uid),
+	}
+
+	// Users of external providers have no Firebase account, and are only known by their row.
+	if result.IdentityProvider == "" {
+		client, err := firebaseUsersForTenant(s.client, tenantID)
+		if err != nil {
+			return nil, err
 		}
 
-		return nil, err
+		user, err := client.GetUser(ctx, uid)
+		if err != nil {
+			if auth.IsUserNotFound(err) {
+				return nil, ErrUserNotFound
+			}
+
+			return nil, err
+		}
+
+		result.Email = user.Email
 	}
 
-	extra, err := s.dao.GetUser(ctx, uid)
+	extra, err := s.dao.GetUser(ctx, tenantID, uid)
 	if err != nil {
 		if !errors.Is(err, dao.ErrUserNotFound) {
 			return nil, err
 		}
 
+		if result.IdentityProvider != "" {
+			return nil, ErrUserNotFound
+		}
+
 		// If no extra information found, just return the firebase user with their default value.
 		extra = new(entities.User)
 	}
 
-	return &models.User{
-		PublicIdentifier: extra.PublicIdentifier,
-		FirebaseUID:      user.UID,
-		Email:            user.Email,
-	}, nil
+	result.PublicIdentifier = extra.PublicIdentifier
+
+	if userActivity, err := s.getUserActivityRepository.GetUserActivity(ctx, tenantID, uid); err == nil {
+		result.Activity = userActivityToModel(userActivity)
+	} else if !errors.Is(err, dao.ErrUserActivityNotFound) {
+		return nil, err
+	}
+
+	return result, nil
 }
 
-func NewGetUserService(client *auth.Client, dao dao.GetUserRepository) GetUserService {
+func NewGetUserService(
+	client *auth.Client,
+	dao dao.GetUserRepository,
+	getUserActivityRepository dao.GetUserActivityRepository,
+	getUserAliasRepository dao.GetUserAliasRepository,
+	identityProviders []models.IdentityProviderConfig,
+) GetUserService {
 	return &getUserServiceImpl{
-		client: client,
-		dao:    dao,
+		client:                    client,
+		dao:                       dao,
+		getUserActivityRepository: getUserActivityRepository,
+		getUserAliasRepository:    getUserAliasRepository,
+		identityProviders:         identityProviders,
 	}
 }
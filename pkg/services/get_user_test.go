@@ -8,8 +8,10 @@ import (
 	"github.com/in-rich/uservice-authentication/pkg/entities"
 	"github.com/in-rich/uservice-authentication/pkg/models"
 	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 var getUserInfoFixtures = []*FixtureUser{
@@ -29,10 +31,17 @@ func TestGetUserService(t *testing.T) {
 
 		uid string
 
+		getUserAliasResponse *entities.UserAlias
+		getUserAliasErr      error
+
 		shouldCallGetUser bool
 		getUserResponse   *entities.User
 		getUserErr        error
 
+		shouldCallGetUserActivity bool
+		getUserActivityResponse   *entities.UserActivity
+		getUserActivityErr        error
+
 		expect    *models.User
 		expectErr error
 	}{
@@ -44,6 +53,8 @@ func TestGetUserService(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 			},
+			shouldCallGetUserActivity: true,
+			getUserActivityErr:        dao.ErrUserActivityNotFound,
 			expect: &models.User{
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
@@ -51,16 +62,94 @@ func TestGetUserService(t *testing.T) {
 			},
 		},
 		{
-			name:              "NoLocalData",
+			name:              "WithActivity",
 			uid:               "user-one-uid",
 			shouldCallGetUser: true,
-			getUserErr:        dao.ErrUserNotFound,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			shouldCallGetUserActivity: true,
+			getUserActivityResponse: &entities.UserActivity{
+				FirebaseUID:         "user-one-uid",
+				LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 0, 0, 0, 0, time.UTC)),
+				AuthenticationCount: 42,
+				LastPeerIP:          lo.ToPtr("192.0.2.1"),
+			},
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				Activity: &models.UserActivity{
+					LastSeenAt:          lo.ToPtr(time.Date(2024, 10, 11, 0, 0, 0, 0, time.UTC)),
+					AuthenticationCount: 42,
+					LastPeerIP:          "192.0.2.1",
+				},
+			},
+		},
+		{
+			name:                      "NoLocalData",
+			uid:                       "user-one-uid",
+			shouldCallGetUser:         true,
+			getUserErr:                dao.ErrUserNotFound,
+			shouldCallGetUserActivity: true,
+			getUserActivityErr:        dao.ErrUserActivityNotFound,
 			expect: &models.User{
 				PublicIdentifier: "",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
 			},
 		},
+		{
+			name: "LinkedAccount",
+			uid:  "user-alias-uid",
+			getUserAliasResponse: &entities.UserAlias{
+				AliasUID:     "user-alias-uid",
+				CanonicalUID: "user-one-uid",
+			},
+			shouldCallGetUser: true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			shouldCallGetUserActivity: true,
+			getUserActivityErr:        dao.ErrUserActivityNotFound,
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+			},
+		},
+		{
+			name:              "ExternalUser",
+			uid:               "acme:subject-1",
+			shouldCallGetUser: true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-2",
+				FirebaseUID:      "acme:subject-1",
+			},
+			shouldCallGetUserActivity: true,
+			getUserActivityErr:        dao.ErrUserActivityNotFound,
+			expect: &models.User{
+				PublicIdentifier: "public-identifier-2",
+				FirebaseUID:      "acme:subject-1",
+				IdentityProvider: "acme",
+			},
+		},
+		{
+			// External users are only known by their row.
+			name:              "ExternalUserNotFound",
+			uid:               "acme:subject-1",
+			shouldCallGetUser: true,
+			getUserErr:        dao.ErrUserNotFound,
+			expectErr:         services.ErrUserNotFound,
+		},
+		{
+			name:            "GetUserAliasError",
+			uid:             "user-one-uid",
+			getUserAliasErr: FooErr,
+			expectErr:       FooErr,
+		},
 		{
 			name:      "UserNotFound",
 			uid:       "user-two-uid",
@@ -73,6 +162,18 @@ func TestGetUserService(t *testing.T) {
 			getUserErr:        FooErr,
 			expectErr:         FooErr,
 		},
+		{
+			name:              "GetUserActivityError",
+			uid:               "user-one-uid",
+			shouldCallGetUser: true,
+			getUserResponse: &entities.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+			},
+			shouldCallGetUserActivity: true,
+			getUserActivityErr:        FooErr,
+			expectErr:                 FooErr,
+		},
 	}
 
 	for _, data := range testData {
@@ -80,19 +181,44 @@ func TestGetUserService(t *testing.T) {
 			require.NoError(t, CreateUsersFixtures(getUserInfoFixtures))
 			defer CleanUsersFixtures(getUserInfoFixtures)
 
+			uid := data.uid
+			getUserAliasErr := data.getUserAliasErr
+			if data.getUserAliasResponse != nil {
+				uid = data.getUserAliasResponse.CanonicalUID
+			} else if getUserAliasErr == nil {
+				getUserAliasErr = dao.ErrUserAliasNotFound
+			}
+
+			getUserAliasRepository := daomocks.NewMockGetUserAliasRepository(t)
+			getUserAliasRepository.
+				On("GetUserAlias", context.TODO(), "", data.uid).
+				Return(data.getUserAliasResponse, getUserAliasErr)
+
 			getUserRepository := daomocks.NewMockGetUserRepository(t)
 			if data.shouldCallGetUser {
-				getUserRepository.On("GetUser", context.TODO(), data.uid).Return(data.getUserResponse, data.getUserErr)
+				getUserRepository.On("GetUser", context.TODO(), "", uid).Return(data.getUserResponse, data.getUserErr)
+			}
+
+			getUserActivityRepository := daomocks.NewMockGetUserActivityRepository(t)
+			if data.shouldCallGetUserActivity {
+				getUserActivityRepository.
+					On("GetUserActivity", context.TODO(), "", uid).
+					Return(data.getUserActivityResponse, data.getUserActivityErr)
 			}
 
-			service := services.NewGetUserService(config.AuthClient, getUserRepository)
+			service := services.NewGetUserService(
+				config.AuthClient, getUserRepository, getUserActivityRepository, getUserAliasRepository,
+				[]models.IdentityProviderConfig{{Name: "acme"}},
+			)
 
-			user, err := service.Exec(context.TODO(), data.uid)
+			user, err := service.Exec(context.TODO(), "", data.uid)
 
 			require.ErrorIs(t, err, data.expectErr)
 			require.Equal(t, data.expect, user)
 
+			getUserAliasRepository.AssertExpectations(t)
 			getUserRepository.AssertExpectations(t)
+			getUserActivityRepository.AssertExpectations(t)
 		})
 	}
 }
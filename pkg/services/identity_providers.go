@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"strings"
+	"time"
+)
+
+// ExternalIdentityProvider is used in place of Firebase for some tenants or email domains, usually the OIDC provider
+// of an enterprise customer.
+type ExternalIdentityProvider struct {
+	Config   models.IdentityProviderConfig
+	Provider clients.IdentityProvider
+}
+
+// identity is the account a credential was issued for, whichever provider verified it.
+type identity struct {
+	uid           string
+	tenantID      string
+	email         string
+	emailVerified bool
+	displayName   string
+	disabled      bool
+	// createdAt is zero for the users of external providers, which do not expose it, so they get no grace period to
+	// verify their email.
+	createdAt time.Time
+}
+
+func firebaseIdentity(user *auth.UserRecord) *identity {
+	return &identity{
+		uid:           user.UID,
+		tenantID:      user.TenantID,
+		email:         user.Email,
+		emailVerified: user.EmailVerified,
+		displayName:   user.DisplayName,
+		disabled:      user.Disabled,
+		createdAt:     time.UnixMilli(user.UserMetadata.CreationTimestamp),
+	}
+}
+
+// unverifiedIssuer returns the issuer of a JWT, or an empty string if the token is not a JWT. The token is not
+// verified here.
+func unverifiedIssuer(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+
+	return claims.Issuer
+}
+
+func emailDomain(email string) string {
+	_, domain, _ := strings.Cut(email, "@")
+	return strings.ToLower(domain)
+}
+
+// identityProviderFor returns the external provider that issued a token, or nil for tokens issued by Firebase.
+func identityProviderFor(providers []ExternalIdentityProvider, token string) *ExternalIdentityProvider {
+	issuer := unverifiedIssuer(token)
+	if issuer == "" {
+		return nil
+	}
+
+	provider, found := lo.Find(providers, func(provider ExternalIdentityProvider) bool {
+		return provider.Provider.Issuer() == issuer
+	})
+	if !found {
+		return nil
+	}
+
+	return &provider
+}
+
+// externalUID is the UID the users of an external provider are stored under. They have no Firebase account.
+func externalUID(config models.IdentityProviderConfig, subject string) string {
+	return config.Name + ":" + subject
+}
+
+// externalIdentityProviderName returns the name of the external provider a UID was issued by, or an empty string for
+// Firebase users.
+func externalIdentityProviderName(configs []models.IdentityProviderConfig, uid string) string {
+	config, _ := lo.Find(configs, func(config models.IdentityProviderConfig) bool {
+		return strings.HasPrefix(uid, externalUID(config, ""))
+	})
+
+	return config.Name
+}
+
+// requiresExternalIdentityProvider tells whether a Firebase user belongs to a tenant or email domain that must sign
+// in with an external provider.
+func requiresExternalIdentityProvider(providers []ExternalIdentityProvider, user *identity) bool {
+	return lo.SomeBy(providers, func(provider ExternalIdentityProvider) bool {
+		return (provider.Config.TenantID != "" && provider.Config.TenantID == user.tenantID) ||
+			lo.Contains(provider.Config.EmailDomains, emailDomain(user.email))
+	})
+}
+
+// verifyExternalIDToken also returns the sign-in time and second factor of the user, like Firebase ID tokens do.
+func verifyExternalIDToken(
+	ctx context.Context, provider *ExternalIdentityProvider, token string,
+) (*identity, time.Time, string, error) {
+	claims, err := provider.Provider.VerifyIDToken(ctx, token)
+	if err != nil {
+		return nil, time.Time{}, "", errors.Join(ErrVerifyToken, err)
+	}
+
+	if len(provider.Config.EmailDomains) > 0 && !lo.Contains(provider.Config.EmailDomains, emailDomain(claims.Email)) {
+		return nil, time.Time{}, "", errors.Join(ErrVerifyToken, ErrIdentityProviderDomainMismatch)
+	}
+
+	var secondFactor string
+	if lo.Contains(claims.AuthMethods, "mfa") {
+		secondFactor = "mfa"
+	}
+
+	return &identity{
+		uid:           externalUID(provider.Config, claims.Subject),
+		tenantID:      provider.Config.TenantID,
+		email:         claims.Email,
+		emailVerified: claims.EmailVerified,
+		displayName:   claims.Name,
+	}, claims.AuthTime, secondFactor, nil
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// ImpersonateService starts an impersonation session, and mints a custom token that signs staff in as the target
+// user. Sessions are read-only, and Authenticate rejects their tokens once they expire or are ended.
+type ImpersonateService interface {
+	Exec(ctx context.Context, token string, data *models.Impersonate) (*models.StartedImpersonationSession, error)
+}
+
+type impersonateServiceImpl struct {
+	client    *auth.Client
+	auth      AuthenticateService
+	createDAO dao.CreateImpersonationSessionRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	config models.ImpersonationConfig
+}
+
+func (s *impersonateServiceImpl) Exec(
+	ctx context.Context, token string, data *models.Impersonate,
+) (*models.StartedImpersonationSession, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token, MaxAuthAge: s.config.MaxAuthAge})
+	if err != nil {
+		return nil, err
+	}
+
+	// Impersonation sessions carry no staff claim, so staff cannot chain them.
+	if user.Scopes != nil || !user.Staff {
+		auditErr := s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+			ActorUID:   user.FirebaseUID,
+			SubjectUID: data.TargetUID,
+			Action:     models.AuditActionStartImpersonation,
+			Outcome:    models.AuditOutcomeFailure,
+			Reason:     ErrNotStaff.Error(),
+		})
+
+		return nil, errors.Join(ErrNotStaff, auditErr)
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidImpersonate, err)
+	}
+
+	client, err := firebaseUsersForTenant(s.client, data.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := client.GetUser(ctx, data.TargetUID)
+	if err != nil {
+		if auth.IsUserNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, err
+	}
+
+	// Staff would otherwise gain the privileges of their colleagues.
+	if isStaff(target.CustomClaims) {
+		return nil, ErrCannotImpersonateStaff
+	}
+
+	session, err := s.createDAO.CreateImpersonationSession(ctx, &dao.CreateImpersonationSessionData{
+		TenantID:        data.TenantID,
+		ImpersonatorUID: user.FirebaseUID,
+		TargetUID:       target.UID,
+		Reason:          data.Reason,
+		Scopes:          models.ImpersonationScopes,
+		ExpiresAt:       time.Now().Add(s.config.TTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	customToken, err := client.CustomTokenWithClaims(ctx, target.UID, map[string]interface{}{
+		impersonatorClaim:         user.FirebaseUID,
+		impersonationSessionClaim: session.ID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: target.UID,
+		Target:     "impersonation_sessions/" + session.ID.String(),
+		Action:     models.AuditActionStartImpersonation,
+		Outcome:    models.AuditOutcomeSuccess,
+		Reason:     data.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.StartedImpersonationSession{
+		ImpersonationSession: *impersonationSessionToModel(session),
+		CustomToken:          customToken,
+	}, nil
+}
+
+func NewImpersonateService(
+	client *auth.Client,
+	auth AuthenticateService,
+	createDAO dao.CreateImpersonationSessionRepository,
+	recordAuditEvent RecordAuditEventService,
+	config models.ImpersonationConfig,
+) ImpersonateService {
+	return &impersonateServiceImpl{
+		client:           client,
+		auth:             auth,
+		createDAO:        createDAO,
+		recordAuditEvent: recordAuditEvent,
+		config:           config,
+	}
+}
@@ -0,0 +1,252 @@
+package services_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var impersonateFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+	{
+		Email:         "staff@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "staff two",
+		UID:           "staff-two-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+		CustomClaims:  map[string]interface{}{"staff": true},
+	},
+}
+
+// decodeCustomTokenClaims returns the payload of a custom token, without checking its signature.
+func decodeCustomTokenClaims(t *testing.T, token string) map[string]interface{} {
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(payload, &claims))
+
+	return claims
+}
+
+func TestImpersonate(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.Impersonate
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallCreateImpersonationSession bool
+		createImpersonationSessionResponse   *entities.ImpersonationSession
+		createImpersonationSessionErr        error
+
+		expectAudit *models.AuditOutcome
+
+		expect    *models.ImpersonationSession
+		expectErr error
+	}{
+		{
+			name:  "Impersonate",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-one-uid",
+				Reason:    "ticket 1234",
+			},
+			authResponse:                         &models.User{FirebaseUID: "staff-one-uid", Staff: true},
+			shouldCallCreateImpersonationSession: true,
+			createImpersonationSessionResponse: &entities.ImpersonationSession{
+				ID:              lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ImpersonatorUID: "staff-one-uid",
+				TargetUID:       "user-one-uid",
+				Reason:          "ticket 1234",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+				CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeSuccess),
+			expect: &models.ImpersonationSession{
+				ID:              "00000000-0000-0000-0000-000000000001",
+				ImpersonatorUID: "staff-one-uid",
+				TargetUID:       "user-one-uid",
+				Reason:          "ticket 1234",
+				Scopes:          []string{models.ScopeUserRead},
+				ExpiresAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 15, 0, 0, time.UTC)),
+				CreatedAt:       lo.ToPtr(time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:  "NotStaff",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-one-uid",
+				Reason:    "ticket 1234",
+			},
+			authResponse: &models.User{FirebaseUID: "staff-one-uid"},
+			expectAudit:  lo.ToPtr(models.AuditOutcomeFailure),
+			expectErr:    services.ErrNotStaff,
+		},
+		{
+			// An impersonation session must not be used to start another one.
+			name:  "Scoped",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-one-uid",
+				Reason:    "ticket 1234",
+			},
+			authResponse: &models.User{
+				FirebaseUID:  "staff-two-uid",
+				Scopes:       []string{models.ScopeUserRead},
+				Impersonator: "staff-one-uid",
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeFailure),
+			expectErr:   services.ErrNotStaff,
+		},
+		{
+			name:  "MissingReason",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-one-uid",
+			},
+			authResponse: &models.User{FirebaseUID: "staff-one-uid", Staff: true},
+			expectErr:    services.ErrInvalidImpersonate,
+		},
+		{
+			name:  "TargetNotFound",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-three-uid",
+				Reason:    "ticket 1234",
+			},
+			authResponse: &models.User{FirebaseUID: "staff-one-uid", Staff: true},
+			expectErr:    services.ErrUserNotFound,
+		},
+		{
+			name:  "TargetIsStaff",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "staff-two-uid",
+				Reason:    "ticket 1234",
+			},
+			authResponse: &models.User{FirebaseUID: "staff-one-uid", Staff: true},
+			expectErr:    services.ErrCannotImpersonateStaff,
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-one-uid",
+				Reason:    "ticket 1234",
+			},
+			authErr:   services.ErrReauthenticationRequired,
+			expectErr: services.ErrReauthenticationRequired,
+		},
+		{
+			name:  "CreateImpersonationSessionError",
+			token: "foo-token",
+			data: &models.Impersonate{
+				TargetUID: "user-one-uid",
+				Reason:    "ticket 1234",
+			},
+			authResponse:                         &models.User{FirebaseUID: "staff-one-uid", Staff: true},
+			shouldCallCreateImpersonationSession: true,
+			createImpersonationSessionErr:        FooErr,
+			expectErr:                            FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			require.NoError(t, CreateUsersFixtures(impersonateFixtures))
+			defer CleanUsersFixtures(impersonateFixtures)
+
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			createImpersonationSessionRepository := daomocks.NewMockCreateImpersonationSessionRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.
+				On("Exec", context.TODO(), &models.Authenticate{Token: data.token, MaxAuthAge: 5 * time.Minute}).
+				Return(data.authResponse, data.authErr)
+
+			if data.shouldCallCreateImpersonationSession {
+				createImpersonationSessionRepository.
+					On("CreateImpersonationSession", context.TODO(), mock.MatchedBy(func(in *dao.CreateImpersonationSessionData) bool {
+						return in.ImpersonatorUID == "staff-one-uid" &&
+							in.TargetUID == data.data.TargetUID &&
+							in.Reason == data.data.Reason &&
+							reflect.DeepEqual(in.Scopes, models.ImpersonationScopes) &&
+							in.ExpiresAt.After(time.Now().Add(10*time.Minute))
+					})).
+					Return(data.createImpersonationSessionResponse, data.createImpersonationSessionErr)
+			}
+
+			if data.expectAudit != nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionStartImpersonation &&
+							in.Outcome == *data.expectAudit &&
+							in.SubjectUID == data.data.TargetUID
+					})).
+					Return(nil)
+			}
+
+			service := services.NewImpersonateService(
+				config.AuthClient,
+				authService,
+				createImpersonationSessionRepository,
+				recordAuditEventService,
+				models.ImpersonationConfig{TTL: 15 * time.Minute, MaxAuthAge: 5 * time.Minute},
+			)
+
+			session, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expect == nil {
+				require.Nil(t, session)
+			} else {
+				require.Equal(t, *data.expect, session.ImpersonationSession)
+
+				// The custom token signs in as the target user, and carries the session.
+				claims := decodeCustomTokenClaims(t, session.CustomToken)
+				require.Equal(t, data.data.TargetUID, claims["uid"])
+				require.Equal(t, map[string]interface{}{
+					"impersonator":          "staff-one-uid",
+					"impersonation_session": data.expect.ID,
+				}, claims["claims"])
+			}
+
+			authService.AssertExpectations(t)
+			createImpersonationSessionRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+const (
+	// staffClaim is the Firebase custom claim granting staff privileges, set by an administrator on the user.
+	staffClaim = "staff"
+	// impersonatorClaim and impersonationSessionClaim are set on the custom tokens of impersonation sessions, and
+	// carried over to the ID tokens they are exchanged for.
+	impersonatorClaim         = "impersonator"
+	impersonationSessionClaim = "impersonation_session"
+)
+
+func isStaff(claims map[string]interface{}) bool {
+	staff, _ := claims[staffClaim].(bool)
+	return staff
+}
+
+func impersonationSessionToModel(session *entities.ImpersonationSession) *models.ImpersonationSession {
+	return &models.ImpersonationSession{
+		ID:              session.ID.String(),
+		TenantID:        session.TenantID,
+		ImpersonatorUID: session.ImpersonatorUID,
+		TargetUID:       session.TargetUID,
+		Reason:          session.Reason,
+		Scopes:          session.Scopes,
+		ExpiresAt:       session.ExpiresAt,
+		EndedAt:         session.EndedAt,
+		CreatedAt:       session.CreatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"net/url"
+	"strings"
+)
+
+// InvitationCodePrefix is prepended to every invitation code.
+const InvitationCodePrefix = "inr_inv_"
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func invitationLink(acceptURL string, code string) (string, error) {
+	link, err := url.Parse(acceptURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := link.Query()
+	query.Set("code", code)
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
+
+func sendInvitation(
+	ctx context.Context, sendEmail SendEmailService, acceptURL string,
+	invitation *entities.OrganizationInvitation, organization *entities.Organization, code string, locale string,
+) error {
+	link, err := invitationLink(acceptURL, code)
+	if err != nil {
+		return err
+	}
+
+	return sendEmail.Exec(ctx, &models.SendEmail{
+		Kind:   models.EmailKindOrganizationInvitation,
+		To:     invitation.Email,
+		Locale: locale,
+		Data: &models.EmailLinkData{
+			Email:            invitation.Email,
+			Link:             link,
+			OrganizationName: organization.Name,
+		},
+	})
+}
+
+func invitationToModel(invitation *entities.OrganizationInvitation) *models.Invitation {
+	return &models.Invitation{
+		ID:             invitation.ID.String(),
+		OrganizationID: invitation.OrganizationID.String(),
+		Email:          invitation.Email,
+		Role:           models.MembershipRole(invitation.Role),
+		InvitedBy:      invitation.InvitedBy,
+		ExpiresAt:      invitation.ExpiresAt,
+		CreatedAt:      invitation.CreatedAt,
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+type InviteOrganizationMemberService interface {
+	Exec(ctx context.Context, token string, data *models.InviteOrganizationMember) (*models.Invitation, error)
+}
+
+type inviteOrganizationMemberServiceImpl struct {
+	auth                       AuthenticateService
+	getMembershipRepository    dao.GetMembershipRepository
+	createInvitationRepository dao.CreateInvitationRepository
+	sendEmail                  SendEmailService
+	ttl                        time.Duration
+	acceptURL                  string
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *inviteOrganizationMemberServiceImpl) Exec(
+	ctx context.Context, token string, data *models.InviteOrganizationMember,
+) (*models.Invitation, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil && !lo.Contains(user.Scopes, models.ScopeUserWrite) {
+		return nil, ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidInviteOrganizationMember, err)
+	}
+
+	organizationID, err := uuid.Parse(data.OrganizationID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	actor, err := getManagerMembership(ctx, s.getMembershipRepository, organizationID, user.FirebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	role := entities.MembershipRole(data.Role)
+	if !outranks(actor.Role, role) {
+		return nil, ErrInsufficientOrganizationRole
+	}
+
+	code, err := generateSecret(InvitationCodePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.createInvitationRepository.CreateInvitation(ctx, organizationID, &dao.CreateInvitationData{
+		Email:     normalizeEmail(data.Email),
+		Role:      role,
+		CodeHash:  hashSecret(code),
+		InvitedBy: user.FirebaseUID,
+		ExpiresAt: time.Now().Add(s.ttl),
+	})
+	if err != nil {
+		if errors.Is(err, dao.ErrInvitationAlreadyExists) {
+			return nil, ErrInvitationAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	if err := sendInvitation(ctx, s.sendEmail, s.acceptURL, invitation, actor.Organization, code, data.Locale); err != nil {
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID: user.FirebaseUID,
+		Target:   "organizations/" + organizationID.String() + "/invitations/" + invitation.ID.String(),
+		Action:   models.AuditActionInviteOrganizationMember,
+		Outcome:  models.AuditOutcomeSuccess,
+		Diff: map[string]*models.AuditChange{
+			"email": {After: invitation.Email},
+			"role":  {After: data.Role},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invitationToModel(invitation), nil
+}
+
+func NewInviteOrganizationMemberService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	createInvitationRepository dao.CreateInvitationRepository,
+	sendEmail SendEmailService,
+	ttl time.Duration,
+	acceptURL string,
+	recordAuditEvent RecordAuditEventService,
+) InviteOrganizationMemberService {
+	return &inviteOrganizationMemberServiceImpl{
+		auth:                       auth,
+		getMembershipRepository:    getMembershipRepository,
+		createInvitationRepository: createInvitationRepository,
+		sendEmail:                  sendEmail,
+		ttl:                        ttl,
+		acceptURL:                  acceptURL,
+		recordAuditEvent:           recordAuditEvent,
+	}
+}
@@ -0,0 +1,291 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInviteOrganizationMember(t *testing.T) {
+	organization := &entities.Organization{
+		ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: "organization-1",
+	}
+
+	expiresAt := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.InviteOrganizationMember
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		shouldCallCreateInvitation bool
+		createInvitationErr        error
+
+		shouldCallSendEmail bool
+		sendEmailErr        error
+
+		expect    *models.Invitation
+		expectErr error
+	}{
+		{
+			name:  "InviteOrganizationMember",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "Invitee@Gmail.com",
+				Role:           models.MembershipRoleMember,
+				Locale:         "fr",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			shouldCallCreateInvitation: true,
+			shouldCallSendEmail:        true,
+			expect: &models.Invitation{
+				ID:             "00000000-0000-0000-0000-000000000001",
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+				InvitedBy:      "user-one-uid",
+				ExpiresAt:      &expiresAt,
+			},
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+			},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "InsufficientScope",
+			token: "inr_pat_foo",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidEmail",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse: &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:    services.ErrInvalidInviteOrganizationMember,
+		},
+		{
+			name:  "NotOrganizationMember",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipErr:        dao.ErrMembershipNotFound,
+			expectErr:               services.ErrNotOrganizationMember,
+		},
+		{
+			name:  "MemberCannotInvite",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleMember,
+				Organization: organization,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "AdminCannotInviteOwner",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleOwner,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "InvitationAlreadyExists",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallCreateInvitation: true,
+			createInvitationErr:        dao.ErrInvitationAlreadyExists,
+			expectErr:                  services.ErrInvitationAlreadyExists,
+		},
+		{
+			name:  "SendEmailError",
+			token: "foo-token",
+			data: &models.InviteOrganizationMember{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallCreateInvitation: true,
+			shouldCallSendEmail:        true,
+			sendEmailErr:               FooErr,
+			expectErr:                  FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			createInvitationRepository := daomocks.NewMockCreateInvitationRepository(t)
+			sendEmailService := servicesmocks.NewMockSendEmailService(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), *organization.ID, data.authResponse.FirebaseUID).
+					Return(data.getMembershipResponse, data.getMembershipErr)
+			}
+
+			var codeHash string
+
+			if data.shouldCallCreateInvitation {
+				createInvitationRepository.
+					On("CreateInvitation", context.TODO(), *organization.ID, mock.MatchedBy(func(in *dao.CreateInvitationData) bool {
+						codeHash = in.CodeHash
+						return in.Email == strings.ToLower(data.data.Email) &&
+							in.Role == entities.MembershipRole(data.data.Role) &&
+							in.InvitedBy == data.authResponse.FirebaseUID &&
+							in.ExpiresAt.After(time.Now())
+					})).
+					Return(func(_ context.Context, _ uuid.UUID, in *dao.CreateInvitationData) *entities.OrganizationInvitation {
+						if data.createInvitationErr != nil {
+							return nil
+						}
+
+						return &entities.OrganizationInvitation{
+							ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+							OrganizationID: *organization.ID,
+							Email:          in.Email,
+							Role:           in.Role,
+							CodeHash:       in.CodeHash,
+							InvitedBy:      in.InvitedBy,
+							ExpiresAt:      &expiresAt,
+						}
+					}, data.createInvitationErr)
+			}
+
+			if data.shouldCallSendEmail {
+				sendEmailService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.SendEmail) bool {
+						code := strings.TrimPrefix(in.Data.Link, "https://app.example.com/invitations?code=")
+
+						// The link must carry the clear code matching the stored hash.
+						return in.Kind == models.EmailKindOrganizationInvitation &&
+							in.To == strings.ToLower(data.data.Email) &&
+							in.Locale == data.data.Locale &&
+							in.Data.OrganizationName == organization.Name &&
+							strings.HasPrefix(code, services.InvitationCodePrefix) &&
+							hashToken(code) == codeHash
+					})).
+					Return(data.sendEmailErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionInviteOrganizationMember && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewInviteOrganizationMemberService(
+				authService,
+				getMembershipRepository,
+				createInvitationRepository,
+				sendEmailService,
+				7*24*time.Hour,
+				"https://app.example.com/invitations",
+				recordAuditEventService,
+			)
+
+			invitation, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, invitation)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			createInvitationRepository.AssertExpectations(t)
+			sendEmailService.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
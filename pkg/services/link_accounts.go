@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"strings"
+)
+
+// LinkAccountsService links two accounts of the same person, such as a password account and a Google account
+// created separately. The secondary account becomes an alias of the primary one: it keeps signing in, but acts as the
+// primary user everywhere. Both credentials must be recent, and both accounts must share a verified email.
+type LinkAccountsService interface {
+	Exec(ctx context.Context, data *models.LinkAccounts) (*models.LinkedAccounts, error)
+}
+
+type linkAccountsServiceImpl struct {
+	auth                AuthenticateService
+	linkUsersRepository dao.LinkUsersRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	config models.AccountLinkingConfig
+}
+
+func (s *linkAccountsServiceImpl) checkLinkable(primary *models.User, secondary *models.User) error {
+	// Personal access tokens and impersonation sessions do not prove the user holds the account.
+	if primary.Scopes != nil || secondary.Scopes != nil {
+		return ErrInsufficientScope
+	}
+
+	// Staff privileges are granted per account, and must not spread to another one.
+	if primary.Staff || secondary.Staff {
+		return ErrLinkAccountsStaff
+	}
+
+	if primary.TenantID != secondary.TenantID {
+		return ErrLinkAccountsTenantMismatch
+	}
+
+	// Authenticate resolves aliases, so accounts that are already linked show up as the same user.
+	if primary.FirebaseUID == secondary.FirebaseUID {
+		return ErrAccountsAlreadyLinked
+	}
+
+	if !hasVerifiedEmail(primary) || !hasVerifiedEmail(secondary) {
+		return ErrLinkAccountsEmailMismatch
+	}
+	if !strings.EqualFold(primary.Email, secondary.Email) {
+		return ErrLinkAccountsEmailMismatch
+	}
+
+	return nil
+}
+
+func (s *linkAccountsServiceImpl) Exec(ctx context.Context, data *models.LinkAccounts) (*models.LinkedAccounts, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidLinkAccounts, err)
+	}
+
+	primary, err := s.auth.Exec(ctx, &models.Authenticate{
+		Token:      data.PrimaryToken,
+		MaxAuthAge: s.config.MaxAuthAge,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secondary, err := s.auth.Exec(ctx, &models.Authenticate{
+		Token:      data.SecondaryToken,
+		MaxAuthAge: s.config.MaxAuthAge,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkLinkable(primary, secondary); err != nil {
+		auditErr := s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+			ActorUID:   primary.FirebaseUID,
+			SubjectUID: secondary.FirebaseUID,
+			Action:     models.AuditActionLinkAccounts,
+			Outcome:    models.AuditOutcomeFailure,
+			Reason:     err.Error(),
+		})
+
+		return nil, errors.Join(err, auditErr)
+	}
+
+	alias, err := s.linkUsersRepository.LinkUsers(ctx, primary.TenantID, primary.FirebaseUID, secondary.FirebaseUID)
+	if err != nil {
+		if errors.Is(err, dao.ErrUserAliasAlreadyExists) {
+			return nil, ErrAccountsAlreadyLinked
+		}
+
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   primary.FirebaseUID,
+		SubjectUID: secondary.FirebaseUID,
+		Action:     models.AuditActionLinkAccounts,
+		Outcome:    models.AuditOutcomeSuccess,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.LinkedAccounts{
+		TenantID:     alias.TenantID,
+		CanonicalUID: alias.CanonicalUID,
+		AliasUID:     alias.AliasUID,
+	}, nil
+}
+
+func hasVerifiedEmail(user *models.User) bool {
+	return user.Email != "" &&
+		user.EmailVerification != nil &&
+		user.EmailVerification.Status == models.EmailVerificationStatusVerified
+}
+
+func NewLinkAccountsService(
+	auth AuthenticateService,
+	linkUsersRepository dao.LinkUsersRepository,
+	recordAuditEvent RecordAuditEventService,
+	config models.AccountLinkingConfig,
+) LinkAccountsService {
+	return &linkAccountsServiceImpl{
+		auth:                auth,
+		linkUsersRepository: linkUsersRepository,
+		recordAuditEvent:    recordAuditEvent,
+		config:              config,
+	}
+}
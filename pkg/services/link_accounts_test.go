@@ -0,0 +1,257 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestLinkAccounts(t *testing.T) {
+	verified := &models.EmailVerificationDecision{Status: models.EmailVerificationStatusVerified}
+
+	primaryUser := &models.User{FirebaseUID: "firebase-uid-1", Email: "user@gmail.com", EmailVerification: verified}
+	secondaryUser := &models.User{FirebaseUID: "firebase-uid-2", Email: "User@Gmail.com", EmailVerification: verified}
+
+	linkAccounts := &models.LinkAccounts{PrimaryToken: "primary-token", SecondaryToken: "secondary-token"}
+
+	testData := []struct {
+		name string
+
+		data *models.LinkAccounts
+
+		shouldCallPrimaryAuth bool
+		primaryAuthResponse   *models.User
+		primaryAuthErr        error
+
+		shouldCallSecondaryAuth bool
+		secondaryAuthResponse   *models.User
+		secondaryAuthErr        error
+
+		shouldCallLinkUsers bool
+		linkUsersResponse   *entities.UserAlias
+		linkUsersErr        error
+
+		expectAuditFailure bool
+
+		expect    *models.LinkedAccounts
+		expectErr error
+	}{
+		{
+			name:                    "LinkAccounts",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse:   secondaryUser,
+			shouldCallLinkUsers:     true,
+			linkUsersResponse: &entities.UserAlias{
+				AliasUID:     "firebase-uid-2",
+				CanonicalUID: "firebase-uid-1",
+			},
+			expect: &models.LinkedAccounts{
+				CanonicalUID: "firebase-uid-1",
+				AliasUID:     "firebase-uid-2",
+			},
+		},
+		{
+			name:      "InvalidData",
+			data:      &models.LinkAccounts{PrimaryToken: "primary-token"},
+			expectErr: services.ErrInvalidLinkAccounts,
+		},
+		{
+			name:                  "PrimaryAuthError",
+			data:                  linkAccounts,
+			shouldCallPrimaryAuth: true,
+			primaryAuthErr:        services.ErrReauthenticationRequired,
+			expectErr:             services.ErrReauthenticationRequired,
+		},
+		{
+			name:                    "SecondaryAuthError",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthErr:        services.ErrVerifyToken,
+			expectErr:               services.ErrVerifyToken,
+		},
+		{
+			name:                    "ScopedCredential",
+			data:                    &models.LinkAccounts{PrimaryToken: "primary-token", SecondaryToken: "inr_pat_foo"},
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse: &models.User{
+				FirebaseUID: "firebase-uid-2",
+				Email:       "user@gmail.com",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectAuditFailure: true,
+			expectErr:          services.ErrInsufficientScope,
+		},
+		{
+			name:                    "Staff",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse: &models.User{
+				FirebaseUID:       "firebase-uid-2",
+				Email:             "user@gmail.com",
+				EmailVerification: verified,
+				Staff:             true,
+			},
+			expectAuditFailure: true,
+			expectErr:          services.ErrLinkAccountsStaff,
+		},
+		{
+			name:                    "TenantMismatch",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse: &models.User{
+				TenantID:          "tenant-1",
+				FirebaseUID:       "firebase-uid-2",
+				Email:             "user@gmail.com",
+				EmailVerification: verified,
+			},
+			expectAuditFailure: true,
+			expectErr:          services.ErrLinkAccountsTenantMismatch,
+		},
+		{
+			name:                    "AlreadyLinked",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse:   primaryUser,
+			expectAuditFailure:      true,
+			expectErr:               services.ErrAccountsAlreadyLinked,
+		},
+		{
+			name:                    "EmailMismatch",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse: &models.User{
+				FirebaseUID:       "firebase-uid-2",
+				Email:             "other@gmail.com",
+				EmailVerification: verified,
+			},
+			expectAuditFailure: true,
+			expectErr:          services.ErrLinkAccountsEmailMismatch,
+		},
+		{
+			name:                    "EmailNotVerified",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse: &models.User{
+				FirebaseUID: "firebase-uid-2",
+				Email:       "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{
+					Status:   models.EmailVerificationStatusPending,
+					Deadline: lo.ToPtr(time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			expectAuditFailure: true,
+			expectErr:          services.ErrLinkAccountsEmailMismatch,
+		},
+		{
+			name:                    "AliasAlreadyExists",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse:   secondaryUser,
+			shouldCallLinkUsers:     true,
+			linkUsersErr:            dao.ErrUserAliasAlreadyExists,
+			expectErr:               services.ErrAccountsAlreadyLinked,
+		},
+		{
+			name:                    "LinkUsersError",
+			data:                    linkAccounts,
+			shouldCallPrimaryAuth:   true,
+			primaryAuthResponse:     primaryUser,
+			shouldCallSecondaryAuth: true,
+			secondaryAuthResponse:   secondaryUser,
+			shouldCallLinkUsers:     true,
+			linkUsersErr:            FooErr,
+			expectErr:               FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			linkUsersRepository := daomocks.NewMockLinkUsersRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			if data.shouldCallPrimaryAuth {
+				authService.
+					On("Exec", context.TODO(), &models.Authenticate{Token: "primary-token", MaxAuthAge: 5 * time.Minute}).
+					Return(data.primaryAuthResponse, data.primaryAuthErr)
+			}
+
+			if data.shouldCallSecondaryAuth {
+				authService.
+					On("Exec", context.TODO(), &models.Authenticate{
+						Token:      data.data.SecondaryToken,
+						MaxAuthAge: 5 * time.Minute,
+					}).
+					Return(data.secondaryAuthResponse, data.secondaryAuthErr)
+			}
+
+			if data.shouldCallLinkUsers {
+				linkUsersRepository.
+					On("LinkUsers", context.TODO(), "", "firebase-uid-1", "firebase-uid-2").
+					Return(data.linkUsersResponse, data.linkUsersErr)
+			}
+
+			if data.expect != nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), &models.RecordAuditEvent{
+						ActorUID:   "firebase-uid-1",
+						SubjectUID: "firebase-uid-2",
+						Action:     models.AuditActionLinkAccounts,
+						Outcome:    models.AuditOutcomeSuccess,
+					}).
+					Return(nil)
+			}
+			if data.expectAuditFailure {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionLinkAccounts && in.Outcome == models.AuditOutcomeFailure
+					})).
+					Return(nil)
+			}
+
+			service := services.NewLinkAccountsService(
+				authService,
+				linkUsersRepository,
+				recordAuditEventService,
+				models.AccountLinkingConfig{MaxAuthAge: 5 * time.Minute},
+			)
+
+			linked, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, linked)
+
+			authService.AssertExpectations(t)
+			linkUsersRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
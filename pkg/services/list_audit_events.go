@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"strconv"
+)
+
+// ListAuditEventsService is meant for internal tooling, like GetUserService. Callers are trusted to filter the events
+// they are allowed to see.
+type ListAuditEventsService interface {
+	Exec(ctx context.Context, data *models.ListAuditEvents) (*models.AuditEventsPage, error)
+}
+
+type listAuditEventsServiceImpl struct {
+	listAuditEventsRepository dao.ListAuditEventsRepository
+}
+
+func (s *listAuditEventsServiceImpl) Exec(ctx context.Context, data *models.ListAuditEvents) (*models.AuditEventsPage, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidListAuditEvents, err)
+	}
+
+	// The page token is the ID of the last event from the previous page.
+	var beforeID int64
+	if data.PageToken != "" {
+		parsed, err := strconv.ParseInt(data.PageToken, 10, 64)
+		if err != nil || parsed <= 0 {
+			return nil, errors.Join(ErrInvalidPageToken, err)
+		}
+
+		beforeID = parsed
+	}
+
+	pageSize := data.PageSize
+	if pageSize == 0 {
+		pageSize = defaultAuditEventsPageSize
+	}
+
+	events, err := s.listAuditEventsRepository.ListAuditEvents(ctx, &dao.ListAuditEventsData{
+		ActorUID:   data.ActorUID,
+		SubjectUID: data.SubjectUID,
+		Action:     data.Action,
+		BeforeID:   beforeID,
+		Limit:      pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.AuditEventsPage{
+		Events: lo.Map(events, func(item *entities.AuditEvent, _ int) *models.AuditEvent {
+			return auditEventToModel(item)
+		}),
+	}
+
+	// A full page means there might be more events.
+	if len(events) == pageSize {
+		page.NextPageToken = strconv.FormatInt(events[len(events)-1].ID, 10)
+	}
+
+	return page, nil
+}
+
+func NewListAuditEventsService(listAuditEventsRepository dao.ListAuditEventsRepository) ListAuditEventsService {
+	return &listAuditEventsServiceImpl{
+		listAuditEventsRepository: listAuditEventsRepository,
+	}
+}
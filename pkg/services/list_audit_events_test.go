@@ -0,0 +1,143 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	events := []*entities.AuditEvent{
+		{
+			ID:         12,
+			ActorUID:   lo.ToPtr("user-one-uid"),
+			SubjectUID: lo.ToPtr("user-one-uid"),
+			Action:     models.AuditActionUpdateUser,
+			Outcome:    entities.AuditOutcomeSuccess,
+			Diff: map[string]*entities.AuditChange{
+				"publicIdentifier": {Before: "public-identifier-1", After: "public-identifier-2"},
+			},
+			CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			ID:         7,
+			SubjectUID: lo.ToPtr("user-one-uid"),
+			Action:     models.AuditActionAuthenticate,
+			Outcome:    entities.AuditOutcomeFailure,
+			Reason:     lo.ToPtr("email not verified"),
+			PeerIP:     lo.ToPtr("10.0.0.1"),
+			CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	expectEvents := []*models.AuditEvent{
+		{
+			ID:         "12",
+			ActorUID:   "user-one-uid",
+			SubjectUID: "user-one-uid",
+			Action:     models.AuditActionUpdateUser,
+			Outcome:    models.AuditOutcomeSuccess,
+			Diff: map[string]*models.AuditChange{
+				"publicIdentifier": {Before: "public-identifier-1", After: "public-identifier-2"},
+			},
+			CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			ID:         "7",
+			SubjectUID: "user-one-uid",
+			Action:     models.AuditActionAuthenticate,
+			Outcome:    models.AuditOutcomeFailure,
+			Reason:     "email not verified",
+			PeerIP:     "10.0.0.1",
+			Diff:       map[string]*models.AuditChange{},
+			CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		data *models.ListAuditEvents
+
+		shouldCallListAuditEvents bool
+		listAuditEventsData       *dao.ListAuditEventsData
+		listAuditEventsResponse   []*entities.AuditEvent
+		listAuditEventsErr        error
+
+		expect    *models.AuditEventsPage
+		expectErr error
+	}{
+		{
+			name:                      "LastPage",
+			data:                      &models.ListAuditEvents{SubjectUID: "user-one-uid"},
+			shouldCallListAuditEvents: true,
+			listAuditEventsData:       &dao.ListAuditEventsData{SubjectUID: "user-one-uid", Limit: 50},
+			listAuditEventsResponse:   events,
+			expect:                    &models.AuditEventsPage{Events: expectEvents},
+		},
+		{
+			name: "FullPage",
+			data: &models.ListAuditEvents{
+				ActorUID:  "user-one-uid",
+				Action:    models.AuditActionUpdateUser,
+				PageSize:  2,
+				PageToken: "20",
+			},
+			shouldCallListAuditEvents: true,
+			listAuditEventsData: &dao.ListAuditEventsData{
+				ActorUID: "user-one-uid",
+				Action:   models.AuditActionUpdateUser,
+				BeforeID: 20,
+				Limit:    2,
+			},
+			listAuditEventsResponse: events,
+			expect:                  &models.AuditEventsPage{Events: expectEvents, NextPageToken: "7"},
+		},
+		{
+			name:      "InvalidPageToken",
+			data:      &models.ListAuditEvents{PageToken: "foo"},
+			expectErr: services.ErrInvalidPageToken,
+		},
+		{
+			name:      "InvalidPageSize",
+			data:      &models.ListAuditEvents{PageSize: 1000},
+			expectErr: services.ErrInvalidListAuditEvents,
+		},
+		{
+			name:                      "ListAuditEventsError",
+			data:                      &models.ListAuditEvents{},
+			shouldCallListAuditEvents: true,
+			listAuditEventsData:       &dao.ListAuditEventsData{Limit: 50},
+			listAuditEventsErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			listAuditEventsRepository := daomocks.NewMockListAuditEventsRepository(t)
+
+			if data.shouldCallListAuditEvents {
+				listAuditEventsRepository.
+					On("ListAuditEvents", context.TODO(), data.listAuditEventsData).
+					Return(data.listAuditEventsResponse, data.listAuditEventsErr)
+			}
+
+			service := services.NewListAuditEventsService(listAuditEventsRepository)
+
+			page, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, page)
+
+			listAuditEventsRepository.AssertExpectations(t)
+		})
+	}
+}
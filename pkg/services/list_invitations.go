@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type ListInvitationsService interface {
+	Exec(ctx context.Context, token string, organizationID string) ([]*models.Invitation, error)
+}
+
+type listInvitationsServiceImpl struct {
+	auth                      AuthenticateService
+	getMembershipRepository   dao.GetMembershipRepository
+	listInvitationsRepository dao.ListInvitationsRepository
+}
+
+func (s *listInvitationsServiceImpl) Exec(
+	ctx context.Context, token string, organizationID string,
+) ([]*models.Invitation, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	parsedOrganizationID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	if _, err := getManagerMembership(ctx, s.getMembershipRepository, parsedOrganizationID, user.FirebaseUID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.listInvitationsRepository.ListInvitations(ctx, parsedOrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(invitations, func(item *entities.OrganizationInvitation, _ int) *models.Invitation {
+		return invitationToModel(item)
+	}), nil
+}
+
+func NewListInvitationsService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	listInvitationsRepository dao.ListInvitationsRepository,
+) ListInvitationsService {
+	return &listInvitationsServiceImpl{
+		auth:                      auth,
+		getMembershipRepository:   getMembershipRepository,
+		listInvitationsRepository: listInvitationsRepository,
+	}
+}
@@ -0,0 +1,157 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListInvitations(t *testing.T) {
+	expiresAt := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		token          string
+		organizationID string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		shouldCallList bool
+		listResponse   []*entities.OrganizationInvitation
+		listErr        error
+
+		expect    []*models.Invitation
+		expectErr error
+	}{
+		{
+			name:                    "ListInvitations",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleAdmin,
+			},
+			shouldCallList: true,
+			listResponse: []*entities.OrganizationInvitation{
+				{
+					ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:          "invitee@gmail.com",
+					Role:           entities.MembershipRoleMember,
+					CodeHash:       "code-hash-1",
+					InvitedBy:      "user-one-uid",
+					ExpiresAt:      &expiresAt,
+				},
+			},
+			expect: []*models.Invitation{
+				{
+					ID:             "00000000-0000-0000-0000-000000000002",
+					OrganizationID: "00000000-0000-0000-0000-000000000001",
+					Email:          "invitee@gmail.com",
+					Role:           models.MembershipRoleMember,
+					InvitedBy:      "user-one-uid",
+					ExpiresAt:      &expiresAt,
+				},
+			},
+		},
+		{
+			name:           "AuthError",
+			token:          "foo-token",
+			organizationID: "00000000-0000-0000-0000-000000000001",
+			authErr:        FooErr,
+			expectErr:      FooErr,
+		},
+		{
+			name:           "InvalidOrganizationID",
+			token:          "foo-token",
+			organizationID: "not-a-uuid",
+			authResponse:   &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:      services.ErrInvalidOrganizationID,
+		},
+		{
+			name:                    "NotOrganizationMember",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipErr:        dao.ErrMembershipNotFound,
+			expectErr:               services.ErrNotOrganizationMember,
+		},
+		{
+			name:                    "MemberCannotList",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleMember,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:                    "ListError",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallList: true,
+			listErr:        FooErr,
+			expectErr:      FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			listInvitationsRepository := daomocks.NewMockListInvitationsRepository(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), uuid.MustParse(data.organizationID), data.authResponse.FirebaseUID).
+					Return(data.getMembershipResponse, data.getMembershipErr)
+			}
+
+			if data.shouldCallList {
+				listInvitationsRepository.
+					On("ListInvitations", context.TODO(), uuid.MustParse(data.organizationID)).
+					Return(data.listResponse, data.listErr)
+			}
+
+			service := services.NewListInvitationsService(authService, getMembershipRepository, listInvitationsRepository)
+
+			invitations, err := service.Exec(context.TODO(), data.token, data.organizationID)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, invitations)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			listInvitationsRepository.AssertExpectations(t)
+		})
+	}
+}
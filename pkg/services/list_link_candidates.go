@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+// ListLinkCandidatesService returns the other accounts of the authenticated user, found by their verified email,
+// that can be linked with LinkAccounts. Firebase only holds several accounts with the same email when the project
+// allows multiple accounts per email.
+type ListLinkCandidatesService interface {
+	Exec(ctx context.Context, token string) ([]*models.LinkCandidate, error)
+}
+
+type listLinkCandidatesServiceImpl struct {
+	client                    *auth.Client
+	auth                      AuthenticateService
+	listUserAliasesRepository dao.ListUserAliasesRepository
+}
+
+func (s *listLinkCandidatesServiceImpl) Exec(ctx context.Context, token string) ([]*models.LinkCandidate, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil {
+		return nil, ErrInsufficientScope
+	}
+
+	// An unverified email proves nothing about the other accounts using it.
+	if !hasVerifiedEmail(user) {
+		return nil, ErrEmailNotVerified
+	}
+
+	client, err := firebaseUsersForTenant(s.client, user.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := client.GetUsers(ctx, []auth.UserIdentifier{auth.EmailIdentifier{Email: user.Email}})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := lo.Filter(accounts.Users, func(item *auth.UserRecord, _ int) bool {
+		return item.UID != user.FirebaseUID && item.EmailVerified && !item.Disabled
+	})
+	if len(candidates) == 0 {
+		return []*models.LinkCandidate{}, nil
+	}
+
+	// Accounts that are already aliases cannot be linked again.
+	aliases, err := s.listUserAliasesRepository.ListUserAliases(
+		ctx,
+		user.TenantID,
+		lo.Map(candidates, func(item *auth.UserRecord, _ int) string {
+			return item.UID
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := lo.SliceToMap(aliases, func(item *entities.UserAlias) (string, bool) {
+		return item.AliasUID, true
+	})
+
+	return lo.FilterMap(candidates, func(item *auth.UserRecord, _ int) (*models.LinkCandidate, bool) {
+		if linked[item.UID] {
+			return nil, false
+		}
+
+		return &models.LinkCandidate{
+			FirebaseUID: item.UID,
+			Email:       item.Email,
+			Providers: lo.Map(item.ProviderUserInfo, func(provider *auth.UserInfo, _ int) string {
+				return provider.ProviderID
+			}),
+		}, true
+	}), nil
+}
+
+func NewListLinkCandidatesService(
+	client *auth.Client, auth AuthenticateService, listUserAliasesRepository dao.ListUserAliasesRepository,
+) ListLinkCandidatesService {
+	return &listLinkCandidatesServiceImpl{
+		client:                    client,
+		auth:                      auth,
+		listUserAliasesRepository: listUserAliasesRepository,
+	}
+}
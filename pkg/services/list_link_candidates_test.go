@@ -0,0 +1,95 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/config"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var listLinkCandidatesFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+	},
+}
+
+func TestListLinkCandidates(t *testing.T) {
+	require.NoError(t, CreateUsersFixtures(listLinkCandidatesFixtures))
+	defer CleanUsersFixtures(listLinkCandidatesFixtures)
+
+	verified := &models.EmailVerificationDecision{Status: models.EmailVerificationStatusVerified}
+
+	testData := []struct {
+		name string
+
+		authResponse *models.User
+		authErr      error
+
+		expect    []*models.LinkCandidate
+		expectErr error
+	}{
+		{
+			// The emulator allows a single account per email, so the user is their only match.
+			name: "NoCandidates",
+			authResponse: &models.User{
+				FirebaseUID:       "user-one-uid",
+				Email:             "user@gmail.com",
+				EmailVerification: verified,
+			},
+			expect: []*models.LinkCandidate{},
+		},
+		{
+			name: "EmailNotVerified",
+			authResponse: &models.User{
+				FirebaseUID:       "user-one-uid",
+				Email:             "user@gmail.com",
+				EmailVerification: &models.EmailVerificationDecision{Status: models.EmailVerificationStatusExempt},
+			},
+			expectErr: services.ErrEmailNotVerified,
+		},
+		{
+			name: "ScopedCredential",
+			authResponse: &models.User{
+				FirebaseUID:       "user-one-uid",
+				Email:             "user@gmail.com",
+				EmailVerification: verified,
+				Scopes:            []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:      "AuthError",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			listUserAliasesRepository := daomocks.NewMockListUserAliasesRepository(t)
+
+			authService.
+				On("Exec", context.TODO(), &models.Authenticate{Token: "foo-token"}).
+				Return(data.authResponse, data.authErr)
+
+			service := services.NewListLinkCandidatesService(config.AuthClient, authService, listUserAliasesRepository)
+
+			candidates, err := service.Exec(context.TODO(), "foo-token")
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, candidates)
+
+			authService.AssertExpectations(t)
+			listUserAliasesRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type ListPersonalAccessTokensService interface {
+	Exec(ctx context.Context, token string) ([]*models.PersonalAccessToken, error)
+}
+
+type listPersonalAccessTokensServiceImpl struct {
+	auth AuthenticateService
+	dao  dao.ListPersonalAccessTokensRepository
+}
+
+func (s *listPersonalAccessTokensServiceImpl) Exec(ctx context.Context, token string) ([]*models.PersonalAccessToken, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil {
+		return nil, ErrInsufficientScope
+	}
+
+	tokens, err := s.dao.ListPersonalAccessTokens(ctx, user.FirebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(tokens, func(item *entities.PersonalAccessToken, _ int) *models.PersonalAccessToken {
+		return personalAccessTokenToModel(item)
+	}), nil
+}
+
+func NewListPersonalAccessTokensService(
+	auth AuthenticateService,
+	dao dao.ListPersonalAccessTokensRepository,
+) ListPersonalAccessTokensService {
+	return &listPersonalAccessTokensServiceImpl{
+		auth: auth,
+		dao:  dao,
+	}
+}
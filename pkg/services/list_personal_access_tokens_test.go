@@ -0,0 +1,115 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		token string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallList bool
+		listResponse   []*entities.PersonalAccessToken
+		listErr        error
+
+		expect    []*models.PersonalAccessToken
+		expectErr error
+	}{
+		{
+			name:  "ListPersonalAccessTokens",
+			token: "foo-token",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallList: true,
+			listResponse: []*entities.PersonalAccessToken{
+				{
+					ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					FirebaseUID: "user-one-uid",
+					Name:        "token-1",
+					TokenHash:   "token-hash-1",
+					Scopes:      []string{models.ScopeUserRead},
+					CreatedAt:   &createdAt,
+				},
+			},
+			expect: []*models.PersonalAccessToken{
+				{
+					ID:        "00000000-0000-0000-0000-000000000001",
+					Name:      "token-1",
+					Scopes:    []string{models.ScopeUserRead},
+					CreatedAt: &createdAt,
+				},
+			},
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "AuthenticatedWithPersonalAccessToken",
+			token: "inr_pat_foo",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "ListError",
+			token: "foo-token",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallList: true,
+			listErr:        FooErr,
+			expectErr:      FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			listRepository := daomocks.NewMockListPersonalAccessTokensRepository(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallList {
+				listRepository.
+					On("ListPersonalAccessTokens", context.TODO(), data.authResponse.FirebaseUID).
+					Return(data.listResponse, data.listErr)
+			}
+
+			service := services.NewListPersonalAccessTokensService(authService, listRepository)
+
+			tokens, err := service.Exec(context.TODO(), data.token)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, tokens)
+
+			authService.AssertExpectations(t)
+			listRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type ListUserOrganizationsService interface {
+	Exec(ctx context.Context, token string) ([]*models.Membership, error)
+}
+
+type listUserOrganizationsServiceImpl struct {
+	auth AuthenticateService
+	dao  dao.ListMembershipsRepository
+}
+
+func (s *listUserOrganizationsServiceImpl) Exec(ctx context.Context, token string) ([]*models.Membership, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.dao.ListMemberships(ctx, user.FirebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(memberships, func(item *entities.Membership, _ int) *models.Membership {
+		return membershipToModel(item)
+	}), nil
+}
+
+func NewListUserOrganizationsService(
+	auth AuthenticateService,
+	dao dao.ListMembershipsRepository,
+) ListUserOrganizationsService {
+	return &listUserOrganizationsServiceImpl{
+		auth: auth,
+		dao:  dao,
+	}
+}
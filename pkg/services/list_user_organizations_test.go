@@ -0,0 +1,103 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListUserOrganizations(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallList bool
+		listResponse   []*entities.Membership
+		listErr        error
+
+		expect    []*models.Membership
+		expectErr error
+	}{
+		{
+			name:  "ListUserOrganizations",
+			token: "foo-token",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+			},
+			shouldCallList: true,
+			listResponse: []*entities.Membership{
+				{
+					OrganizationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					FirebaseUID:    "user-one-uid",
+					Role:           entities.MembershipRoleOwner,
+					Organization: &entities.Organization{
+						ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Name: "organization-1",
+					},
+				},
+			},
+			expect: []*models.Membership{
+				{
+					Organization: &models.Organization{
+						ID:   "00000000-0000-0000-0000-000000000001",
+						Name: "organization-1",
+					},
+					FirebaseUID: "user-one-uid",
+					Role:        models.MembershipRoleOwner,
+				},
+			},
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "ListError",
+			token: "foo-token",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+			},
+			shouldCallList: true,
+			listErr:        FooErr,
+			expectErr:      FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			listRepository := daomocks.NewMockListMembershipsRepository(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallList {
+				listRepository.
+					On("ListMemberships", context.TODO(), data.authResponse.FirebaseUID).
+					Return(data.listResponse, data.listErr)
+			}
+
+			service := services.NewListUserOrganizationsService(authService, listRepository)
+
+			memberships, err := service.Exec(context.TODO(), data.token)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, memberships)
+
+			authService.AssertExpectations(t)
+			listRepository.AssertExpectations(t)
+		})
+	}
+}
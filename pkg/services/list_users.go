@@ -10,50 +10,94 @@ import (
 )
 
 type ListUsersService interface {
-	Exec(ctx context.Context, uids []string) ([]*models.User, error)
+	Exec(ctx context.Context, tenantID string, uids []string) ([]*models.User, error)
 }
 
 type listUsersServiceImpl struct {
-	client *auth.Client
-	dao    dao.ListUsersRepository
+	client                    *auth.Client
+	dao                       dao.ListUsersRepository
+	listUserAliasesRepository dao.ListUserAliasesRepository
+	identityProviders         []models.IdentityProviderConfig
 }
 
-func (s *listUsersServiceImpl) Exec(ctx context.Context, uids []string) ([]*models.User, error) {
-	identifiers := lo.Map(uids, func(item string, index int) auth.UserIdentifier {
-		return auth.UIDIdentifier{UID: item}
-	})
-
-	users, err := s.client.GetUsers(ctx, identifiers)
+func (s *listUsersServiceImpl) Exec(ctx context.Context, tenantID string, uids []string) ([]*models.User, error) {
+	// Linked accounts are returned as the user they were linked to, once even if several of their UIDs are given.
+	uids, err := resolveUserAliases(ctx, s.listUserAliasesRepository, tenantID, uids)
 	if err != nil {
 		return nil, err
 	}
 
-	extras, err := s.dao.ListUsers(ctx, uids)
+	// Users of external providers have no Firebase account, and are only known by their row.
+	externalUIDs, firebaseUIDs := lo.FilterReject(uids, func(item string, _ int) bool {
+		return externalIdentityProviderName(s.identityProviders, item) != ""
+	})
+
+	records := make([]*auth.UserRecord, 0, len(firebaseUIDs))
+	if len(firebaseUIDs) > 0 {
+		client, err := firebaseUsersForTenant(s.client, tenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		identifiers := lo.Map(firebaseUIDs, func(item string, index int) auth.UserIdentifier {
+			return auth.UIDIdentifier{UID: item}
+		})
+
+		users, err := client.GetUsers(ctx, identifiers)
+		if err != nil {
+			return nil, err
+		}
+
+		records = users.Users
+	}
+
+	extras, err := s.dao.ListUsers(ctx, tenantID, uids)
 	if err != nil {
 		return nil, err
 	}
 
-	return lo.Map(users.Users, func(item *auth.UserRecord, index int) *models.User {
-		extra, ok := lo.Find(extras, func(extraItem *entities.User) bool {
-			return extraItem.FirebaseUID == item.UID
-		})
+	extrasByUID := lo.SliceToMap(extras, func(item *entities.User) (string, *entities.User) {
+		return item.FirebaseUID, item
+	})
 
+	results := lo.Map(records, func(item *auth.UserRecord, index int) *models.User {
 		result := &models.User{
+			TenantID:    tenantID,
 			FirebaseUID: item.UID,
 			Email:       item.Email,
 		}
 
-		if ok {
+		if extra, ok := extrasByUID[item.UID]; ok {
 			result.PublicIdentifier = extra.PublicIdentifier
 		}
 
 		return result
-	}), nil
+	})
+
+	for _, uid := range externalUIDs {
+		if extra, ok := extrasByUID[uid]; ok {
+			results = append(results, &models.User{
+				PublicIdentifier: extra.PublicIdentifier,
+				TenantID:         tenantID,
+				FirebaseUID:      uid,
+				IdentityProvider: externalIdentityProviderName(s.identityProviders, uid),
+			})
+		}
+	}
+
+	return results, nil
 }
 
-func NewListUsersService(client *auth.Client, dao dao.ListUsersRepository) ListUsersService {
+func NewListUsersService(
+	client *auth.Client,
+	dao dao.ListUsersRepository,
+	listUserAliasesRepository dao.ListUserAliasesRepository,
+	identityProviders []models.IdentityProviderConfig,
+) ListUsersService {
 	return &listUsersServiceImpl{
-		client: client,
-		dao:    dao,
+		client:                    client,
+		dao:                       dao,
+		listUserAliasesRepository: listUserAliasesRepository,
+		identityProviders:         identityProviders,
 	}
 }
@@ -44,15 +44,67 @@ func TestListUsersService(t *testing.T) {
 
 		uids []string
 
-		listUsersResult []*entities.User
-		listUsersErr    error
+		listUserAliasesResult []*entities.UserAlias
+		listUserAliasesErr    error
+
+		// resolvedUIDs are the UIDs left once aliases are resolved. They default to uids.
+		resolvedUIDs []string
+
+		shouldCallListUsers bool
+		listUsersResult     []*entities.User
+		listUsersErr        error
 
 		expect    []*models.User
 		expectErr error
 	}{
 		{
-			name: "ListUsers",
-			uids: []string{"user-one-uid", "user-four-uid", "user-three-uid"},
+			name:                "ListUsers",
+			uids:                []string{"user-one-uid", "user-four-uid", "user-three-uid"},
+			shouldCallListUsers: true,
+			listUsersResult: []*entities.User{
+				{
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "user-one-uid",
+				},
+			},
+			expect: []*models.User{
+				{
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "user-one-uid",
+					Email:            "user1@gmail.com",
+				},
+				{
+					PublicIdentifier: "",
+					FirebaseUID:      "user-three-uid",
+					Email:            "user3@gmail.com",
+				},
+			},
+		},
+		{
+			name:                "ListUsersError",
+			uids:                []string{"user-one-uid", "user-four-uid", "user-three-uid"},
+			shouldCallListUsers: true,
+			listUsersErr:        FooErr,
+			expectErr:           FooErr,
+		},
+		{
+			name:                "NoResults",
+			uids:                []string{"user-four-uid"},
+			shouldCallListUsers: true,
+			listUsersResult:     []*entities.User{},
+			expect:              []*models.User{},
+		},
+		{
+			name: "LinkedAccounts",
+			uids: []string{"user-one-uid", "user-alias-uid", "user-three-uid"},
+			listUserAliasesResult: []*entities.UserAlias{
+				{
+					AliasUID:     "user-alias-uid",
+					CanonicalUID: "user-one-uid",
+				},
+			},
+			resolvedUIDs:        []string{"user-one-uid", "user-three-uid"},
+			shouldCallListUsers: true,
 			listUsersResult: []*entities.User{
 				{
 					PublicIdentifier: "public-identifier-1",
@@ -73,16 +125,37 @@ func TestListUsersService(t *testing.T) {
 			},
 		},
 		{
-			name:         "ListUsersError",
-			uids:         []string{"user-one-uid", "user-four-uid", "user-three-uid"},
-			listUsersErr: FooErr,
-			expectErr:    FooErr,
+			name:                "ExternalUsers",
+			uids:                []string{"acme:subject-1", "user-one-uid", "acme:subject-2"},
+			shouldCallListUsers: true,
+			listUsersResult: []*entities.User{
+				{
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "user-one-uid",
+				},
+				{
+					PublicIdentifier: "public-identifier-2",
+					FirebaseUID:      "acme:subject-1",
+				},
+			},
+			expect: []*models.User{
+				{
+					PublicIdentifier: "public-identifier-1",
+					FirebaseUID:      "user-one-uid",
+					Email:            "user1@gmail.com",
+				},
+				{
+					PublicIdentifier: "public-identifier-2",
+					FirebaseUID:      "acme:subject-1",
+					IdentityProvider: "acme",
+				},
+			},
 		},
 		{
-			name:            "NoResults",
-			uids:            []string{"user-four-uid"},
-			listUsersResult: []*entities.User{},
-			expect:          []*models.User{},
+			name:               "ListUserAliasesError",
+			uids:               []string{"user-one-uid"},
+			listUserAliasesErr: FooErr,
+			expectErr:          FooErr,
 		},
 	}
 
@@ -91,17 +164,32 @@ func TestListUsersService(t *testing.T) {
 			require.NoError(t, CreateUsersFixtures(listUsersInfoFixtures))
 			defer CleanUsersFixtures(listUsersInfoFixtures)
 
+			listUserAliasesRepository := daomocks.NewMockListUserAliasesRepository(t)
+			listUserAliasesRepository.On("ListUserAliases", context.TODO(), "", data.uids).
+				Return(data.listUserAliasesResult, data.listUserAliasesErr)
+
+			resolvedUIDs := data.resolvedUIDs
+			if resolvedUIDs == nil {
+				resolvedUIDs = data.uids
+			}
+
 			listUsersRepository := daomocks.NewMockListUsersRepository(t)
-			listUsersRepository.On("ListUsers", context.TODO(), data.uids).
-				Return(data.listUsersResult, data.listUsersErr)
+			if data.shouldCallListUsers {
+				listUsersRepository.On("ListUsers", context.TODO(), "", resolvedUIDs).
+					Return(data.listUsersResult, data.listUsersErr)
+			}
 
-			service := services.NewListUsersService(config.AuthClient, listUsersRepository)
+			service := services.NewListUsersService(
+				config.AuthClient, listUsersRepository, listUserAliasesRepository,
+				[]models.IdentityProviderConfig{{Name: "acme"}},
+			)
 
-			users, err := service.Exec(context.TODO(), data.uids)
+			users, err := service.Exec(context.TODO(), "", data.uids)
 
 			require.ErrorIs(t, err, data.expectErr)
 			require.Equal(t, data.expect, users)
 
+			listUserAliasesRepository.AssertExpectations(t)
 			listUsersRepository.AssertExpectations(t)
 		})
 	}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+// ListWebhookDeliveriesService is meant for internal tooling. It returns the most recent deliveries of a
+// subscription, each with the log of its attempts.
+type ListWebhookDeliveriesService interface {
+	Exec(ctx context.Context, data *models.ListWebhookDeliveries) ([]*models.WebhookDelivery, error)
+}
+
+type listWebhookDeliveriesServiceImpl struct {
+	listWebhookDeliveriesRepository dao.ListWebhookDeliveriesRepository
+}
+
+func (s *listWebhookDeliveriesServiceImpl) Exec(
+	ctx context.Context, data *models.ListWebhookDeliveries,
+) ([]*models.WebhookDelivery, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidListWebhookDeliveries, err)
+	}
+
+	pageSize := data.PageSize
+	if pageSize == 0 {
+		pageSize = defaultWebhookDeliveriesPageSize
+	}
+
+	deliveries, err := s.listWebhookDeliveriesRepository.ListWebhookDeliveries(ctx, &dao.ListWebhookDeliveriesData{
+		SubscriptionID: uuid.MustParse(data.SubscriptionID),
+		Status:         entities.WebhookDeliveryStatus(data.Status),
+		Limit:          pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(deliveries, func(item *entities.WebhookDelivery, _ int) *models.WebhookDelivery {
+		return webhookDeliveryToModel(item)
+	}), nil
+}
+
+func NewListWebhookDeliveriesService(
+	listWebhookDeliveriesRepository dao.ListWebhookDeliveriesRepository,
+) ListWebhookDeliveriesService {
+	return &listWebhookDeliveriesServiceImpl{
+		listWebhookDeliveriesRepository: listWebhookDeliveriesRepository,
+	}
+}
@@ -0,0 +1,144 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListWebhookDeliveries(t *testing.T) {
+	testData := []struct {
+		name string
+
+		data *models.ListWebhookDeliveries
+
+		shouldCallList bool
+		expectListData *dao.ListWebhookDeliveriesData
+		listResponse   []*entities.WebhookDelivery
+		listErr        error
+
+		expect    []*models.WebhookDelivery
+		expectErr error
+	}{
+		{
+			name: "ListWebhookDeliveries",
+			data: &models.ListWebhookDeliveries{
+				SubscriptionID: "00000000-0000-0000-0000-000000000001",
+				Status:         models.WebhookDeliveryStatusDead,
+			},
+			shouldCallList: true,
+			expectListData: &dao.ListWebhookDeliveriesData{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Status:         entities.WebhookDeliveryStatusDead,
+				Limit:          50,
+			},
+			listResponse: []*entities.WebhookDelivery{
+				{
+					ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					EventID:        "2",
+					EventType:      entities.OutboxEventUserDeleted,
+					Status:         entities.WebhookDeliveryStatusDead,
+					Attempts:       2,
+					NextAttemptAt:  lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+					Log: []*entities.WebhookDeliveryAttempt{
+						{
+							ID:         1,
+							StatusCode: lo.ToPtr(500),
+							Error:      lo.ToPtr("receiver rejected the webhook with status 500"),
+							DurationMS: 15,
+							CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+						{
+							ID:         2,
+							Error:      lo.ToPtr("connection refused"),
+							DurationMS: 3,
+							CreatedAt:  lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			expect: []*models.WebhookDelivery{
+				{
+					ID:             "00000000-0000-0000-0000-000000000002",
+					SubscriptionID: "00000000-0000-0000-0000-000000000001",
+					EventID:        "2",
+					EventType:      entities.OutboxEventUserDeleted,
+					Status:         models.WebhookDeliveryStatusDead,
+					Attempts:       2,
+					Log: []*models.WebhookDeliveryAttempt{
+						{
+							StatusCode: 500,
+							Error:      "receiver rejected the webhook with status 500",
+							Duration:   15 * time.Millisecond,
+							CreatedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+						{
+							Error:     "connection refused",
+							Duration:  3 * time.Millisecond,
+							CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+					CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "InvalidSubscriptionID",
+			data: &models.ListWebhookDeliveries{
+				SubscriptionID: "not-a-uuid",
+			},
+			expectErr: services.ErrInvalidListWebhookDeliveries,
+		},
+		{
+			name: "InvalidStatus",
+			data: &models.ListWebhookDeliveries{
+				SubscriptionID: "00000000-0000-0000-0000-000000000001",
+				Status:         "unknown",
+			},
+			expectErr: services.ErrInvalidListWebhookDeliveries,
+		},
+		{
+			name: "ListError",
+			data: &models.ListWebhookDeliveries{
+				SubscriptionID: "00000000-0000-0000-0000-000000000001",
+				PageSize:       10,
+			},
+			shouldCallList: true,
+			expectListData: &dao.ListWebhookDeliveriesData{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Limit:          10,
+			},
+			listErr:   FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			listRepository := daomocks.NewMockListWebhookDeliveriesRepository(t)
+
+			if data.shouldCallList {
+				listRepository.
+					On("ListWebhookDeliveries", context.TODO(), data.expectListData).
+					Return(data.listResponse, data.listErr)
+			}
+
+			service := services.NewListWebhookDeliveriesService(listRepository)
+
+			deliveries, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, deliveries)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"sort"
+)
+
+// MergeUsersService cleans up duplicate accounts of the same person, created before accounts could be linked. The
+// merged users become aliases of the survivor, their rows are deleted, and their public identifier and profile are
+// moved to the survivor by precedence. Other services are told through user.merged events.
+type MergeUsersService interface {
+	Exec(ctx context.Context, token string, data *models.MergeUsers) (*models.UsersMerge, error)
+}
+
+type mergeUsersServiceImpl struct {
+	client               *auth.Client
+	auth                 AuthenticateService
+	listUsersRepository  dao.ListUsersRepository
+	mergeUsersRepository dao.MergeUsersRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	config models.MergeUsersConfig
+}
+
+// mergedAccount is the data of one of the merged users, or of the survivor.
+type mergedAccount struct {
+	uid    string
+	record *auth.UserRecord
+	row    *entities.User
+}
+
+func (a *mergedAccount) publicIdentifier() string {
+	if a.row == nil {
+		return ""
+	}
+
+	return a.row.PublicIdentifier
+}
+
+func (a *mergedAccount) displayName() string {
+	if a.record == nil {
+		return ""
+	}
+
+	return a.record.DisplayName
+}
+
+func (a *mergedAccount) photoURL() string {
+	if a.record == nil {
+		return ""
+	}
+
+	return a.record.PhotoURL
+}
+
+// sortMergedAccounts orders accounts by precedence, the first one being preferred. Accounts that are missing from
+// Firebase have no creation date, and come last.
+func sortMergedAccounts(accounts []*mergedAccount, precedence models.MergePrecedence) {
+	if precedence == models.MergePrecedenceSurvivor {
+		return
+	}
+
+	sort.SliceStable(accounts, func(i, j int) bool {
+		if accounts[i].record == nil || accounts[j].record == nil {
+			return accounts[j].record == nil && accounts[i].record != nil
+		}
+
+		left := accounts[i].record.UserMetadata.CreationTimestamp
+		right := accounts[j].record.UserMetadata.CreationTimestamp
+
+		if precedence == models.MergePrecedenceNewest {
+			return left > right
+		}
+
+		return left < right
+	})
+}
+
+// pickMergedValue returns the first non-empty value of the accounts, and records a change if it differs from the
+// current value of the survivor.
+func pickMergedValue(
+	changes map[string]*models.AuditChange, field string, current string, accounts []*mergedAccount,
+	value func(account *mergedAccount) string,
+) string {
+	for _, account := range accounts {
+		if picked := value(account); picked != "" {
+			if picked != current {
+				changes[field] = &models.AuditChange{Before: current, After: picked}
+			}
+
+			return picked
+		}
+	}
+
+	return current
+}
+
+func (s *mergeUsersServiceImpl) checkStaff(ctx context.Context, user *models.User, data *models.MergeUsers) error {
+	// Personal access tokens and impersonation sessions carry no staff privileges.
+	if user.Scopes == nil && user.Staff {
+		return nil
+	}
+
+	auditErr := s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: data.SurvivorUID,
+		Action:     models.AuditActionMergeUsers,
+		Outcome:    models.AuditOutcomeFailure,
+		Reason:     ErrNotStaff.Error(),
+	})
+
+	return errors.Join(ErrNotStaff, auditErr)
+}
+
+func (s *mergeUsersServiceImpl) loadAccounts(
+	ctx context.Context, client firebaseUsers, data *models.MergeUsers, mergedUIDs []string,
+) ([]*mergedAccount, error) {
+	uids := append([]string{data.SurvivorUID}, mergedUIDs...)
+
+	records, err := client.GetUsers(ctx, lo.Map(uids, func(item string, _ int) auth.UserIdentifier {
+		return auth.UIDIdentifier{UID: item}
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.listUsersRepository.ListUsers(ctx, data.TenantID, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	recordsByUID := lo.SliceToMap(records.Users, func(item *auth.UserRecord) (string, *auth.UserRecord) {
+		return item.UID, item
+	})
+	rowsByUID := lo.SliceToMap(rows, func(item *entities.User) (string, *entities.User) {
+		return item.FirebaseUID, item
+	})
+
+	// Merged users may have been deleted from Firebase already, but the survivor must still be able to sign in.
+	if recordsByUID[data.SurvivorUID] == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// Staff privileges are granted per account, and must not spread to another one.
+	for _, record := range records.Users {
+		if isStaff(record.CustomClaims) {
+			return nil, ErrMergeUsersStaff
+		}
+	}
+
+	return lo.Map(uids, func(item string, _ int) *mergedAccount {
+		return &mergedAccount{uid: item, record: recordsByUID[item], row: rowsByUID[item]}
+	}), nil
+}
+
+func (s *mergeUsersServiceImpl) mergeUsers(
+	ctx context.Context, tenantID string, data *dao.MergeUsersData,
+) (*dao.MergeUsersResult, error) {
+	merged, err := s.mergeUsersRepository.MergeUsers(ctx, tenantID, data)
+	if err != nil {
+		if errors.Is(err, dao.ErrUserAliasAlreadyExists) {
+			return nil, ErrAccountsAlreadyLinked
+		}
+
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+func (s *mergeUsersServiceImpl) Exec(
+	ctx context.Context, token string, data *models.MergeUsers,
+) (*models.UsersMerge, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token, MaxAuthAge: s.config.MaxAuthAge})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkStaff(ctx, user, data); err != nil {
+		return nil, err
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidMergeUsers, err)
+	}
+
+	mergedUIDs := lo.Uniq(data.MergedUIDs)
+	if lo.Contains(mergedUIDs, data.SurvivorUID) {
+		return nil, ErrMergeSurvivorIncluded
+	}
+
+	precedence := lo.CoalesceOrEmpty(data.Precedence, s.config.Precedence, models.MergePrecedenceSurvivor)
+
+	client, err := firebaseUsersForTenant(s.client, data.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.loadAccounts(ctx, client, data, mergedUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	survivor := accounts[0]
+	sortMergedAccounts(accounts, precedence)
+
+	changes := make(map[string]*models.AuditChange)
+	publicIdentifier := pickMergedValue(
+		changes, "publicIdentifier", survivor.publicIdentifier(), accounts, (*mergedAccount).publicIdentifier,
+	)
+	displayName := pickMergedValue(changes, "displayName", survivor.displayName(), accounts, (*mergedAccount).displayName)
+	photoURL := pickMergedValue(changes, "photoURL", survivor.photoURL(), accounts, (*mergedAccount).photoURL)
+
+	mergeData := &dao.MergeUsersData{
+		SurvivorUID: survivor.uid,
+		MergedUIDs:  mergedUIDs,
+		DryRun:      data.DryRun,
+	}
+	if changes["publicIdentifier"] != nil {
+		mergeData.PublicIdentifier = publicIdentifier
+	}
+
+	// The profile is updated first, so a failed merge can be retried as is. A dry run of the merge makes sure it
+	// can succeed before the profile is changed.
+	if !data.DryRun && (changes["displayName"] != nil || changes["photoURL"] != nil) {
+		if _, err := s.mergeUsers(ctx, data.TenantID, &dao.MergeUsersData{
+			SurvivorUID:      mergeData.SurvivorUID,
+			MergedUIDs:       mergeData.MergedUIDs,
+			PublicIdentifier: mergeData.PublicIdentifier,
+			DryRun:           true,
+		}); err != nil {
+			return nil, err
+		}
+
+		update := &auth.UserToUpdate{}
+		if changes["displayName"] != nil {
+			update = update.DisplayName(displayName)
+		}
+		if changes["photoURL"] != nil {
+			update = update.PhotoURL(photoURL)
+		}
+
+		if _, err := client.UpdateUser(ctx, survivor.uid, update); err != nil {
+			return nil, err
+		}
+	}
+
+	merged, err := s.mergeUsers(ctx, data.TenantID, mergeData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.DryRun {
+		err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+			ActorUID:   user.FirebaseUID,
+			SubjectUID: survivor.uid,
+			Action:     models.AuditActionMergeUsers,
+			Outcome:    models.AuditOutcomeSuccess,
+			Reason:     data.Reason,
+			Diff: lo.Assign(changes, map[string]*models.AuditChange{
+				"mergedUIDs": {Before: nil, After: mergedUIDs},
+			}),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.UsersMerge{
+		TenantID:    data.TenantID,
+		SurvivorUID: survivor.uid,
+		DryRun:      data.DryRun,
+		Changes:     changes,
+		DeletedUsers: lo.SliceToMap(merged.Deleted, func(item *entities.User) (string, string) {
+			return item.FirebaseUID, item.PublicIdentifier
+		}),
+		Aliases: lo.Map(merged.Aliases, func(item *entities.UserAlias, _ int) string {
+			return item.AliasUID
+		}),
+	}, nil
+}
+
+func NewMergeUsersService(
+	client *auth.Client,
+	auth AuthenticateService,
+	listUsersRepository dao.ListUsersRepository,
+	mergeUsersRepository dao.MergeUsersRepository,
+	recordAuditEvent RecordAuditEventService,
+	config models.MergeUsersConfig,
+) MergeUsersService {
+	return &mergeUsersServiceImpl{
+		client:               client,
+		auth:                 auth,
+		listUsersRepository:  listUsersRepository,
+		mergeUsersRepository: mergeUsersRepository,
+		recordAuditEvent:     recordAuditEvent,
+		config:               config,
+	}
+}
@@ -0,0 +1,431 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// mergeUsersFixtures are created in order, so user three is newer than user one.
+var mergeUsersFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image-one.png",
+	},
+	{
+		Email:         "staff@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "staff two",
+		UID:           "staff-two-uid",
+		Password:      "password",
+		PhotoURL:      "https://image-two.png",
+		CustomClaims:  map[string]interface{}{"staff": true},
+	},
+	{
+		Email:         "user@yahoo.com",
+		EmailVerified: true,
+		DisplayName:   "user three",
+		UID:           "user-three-uid",
+		Password:      "password",
+		PhotoURL:      "https://image-three.png",
+	},
+}
+
+func TestMergeUsers(t *testing.T) {
+	testData := []struct {
+		name string
+
+		data *models.MergeUsers
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallListUsers bool
+		listUsersResponse   []*entities.User
+		listUsersErr        error
+
+		// The merge is tried as a dry run first, when the profile of the survivor changes.
+		shouldCallMergeUsersDryRun bool
+		mergeUsersDryRunErr        error
+
+		shouldCallMergeUsers bool
+		expectMergeUsersData *dao.MergeUsersData
+		mergeUsersResponse   *dao.MergeUsersResult
+		mergeUsersErr        error
+
+		expectAudit *models.AuditOutcome
+
+		expect            *models.UsersMerge
+		expectDisplayName string
+		expectErr         error
+	}{
+		{
+			name: "MergeUsers",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid", "user-four-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse:        &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers: true,
+			listUsersResponse: []*entities.User{
+				{PublicIdentifier: "public-identifier-3", FirebaseUID: "user-three-uid"},
+				{PublicIdentifier: "public-identifier-4", FirebaseUID: "user-four-uid"},
+			},
+			shouldCallMergeUsers: true,
+			expectMergeUsersData: &dao.MergeUsersData{
+				SurvivorUID:      "user-one-uid",
+				MergedUIDs:       []string{"user-three-uid", "user-four-uid"},
+				PublicIdentifier: "public-identifier-3",
+			},
+			mergeUsersResponse: &dao.MergeUsersResult{
+				Survivor: &entities.User{PublicIdentifier: "public-identifier-3", FirebaseUID: "user-one-uid"},
+				Deleted: []*entities.User{
+					{PublicIdentifier: "public-identifier-3", FirebaseUID: "user-three-uid"},
+					{PublicIdentifier: "public-identifier-4", FirebaseUID: "user-four-uid"},
+				},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "user-three-uid", CanonicalUID: "user-one-uid"},
+					{AliasUID: "user-four-uid", CanonicalUID: "user-one-uid"},
+				},
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeSuccess),
+			expect: &models.UsersMerge{
+				SurvivorUID: "user-one-uid",
+				Changes: map[string]*models.AuditChange{
+					"publicIdentifier": {Before: "", After: "public-identifier-3"},
+				},
+				DeletedUsers: map[string]string{
+					"user-three-uid": "public-identifier-3",
+					"user-four-uid":  "public-identifier-4",
+				},
+				Aliases: []string{"user-three-uid", "user-four-uid"},
+			},
+			expectDisplayName: "user one",
+		},
+		{
+			name: "PrecedenceNewest",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Precedence:  models.MergePrecedenceNewest,
+				Reason:      "ticket 1234",
+			},
+			authResponse:        &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers: true,
+			listUsersResponse: []*entities.User{
+				{PublicIdentifier: "public-identifier-1", FirebaseUID: "user-one-uid"},
+			},
+			shouldCallMergeUsersDryRun: true,
+			shouldCallMergeUsers:       true,
+			expectMergeUsersData: &dao.MergeUsersData{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+			},
+			mergeUsersResponse: &dao.MergeUsersResult{
+				Survivor: &entities.User{PublicIdentifier: "public-identifier-1", FirebaseUID: "user-one-uid"},
+				Deleted:  []*entities.User{},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "user-three-uid", CanonicalUID: "user-one-uid"},
+				},
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeSuccess),
+			expect: &models.UsersMerge{
+				SurvivorUID: "user-one-uid",
+				Changes: map[string]*models.AuditChange{
+					"displayName": {Before: "user one", After: "user three"},
+					"photoURL":    {Before: "https://image-one.png", After: "https://image-three.png"},
+				},
+				DeletedUsers: map[string]string{},
+				Aliases:      []string{"user-three-uid"},
+			},
+			expectDisplayName: "user three",
+		},
+		{
+			// Nothing is written, and the profile of the survivor is left as is.
+			name: "DryRun",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Precedence:  models.MergePrecedenceNewest,
+				Reason:      "ticket 1234",
+				DryRun:      true,
+			},
+			authResponse:         &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers:  true,
+			listUsersResponse:    []*entities.User{},
+			shouldCallMergeUsers: true,
+			expectMergeUsersData: &dao.MergeUsersData{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				DryRun:      true,
+			},
+			mergeUsersResponse: &dao.MergeUsersResult{
+				Deleted: []*entities.User{},
+				Aliases: []*entities.UserAlias{
+					{AliasUID: "user-three-uid", CanonicalUID: "user-one-uid"},
+				},
+			},
+			expect: &models.UsersMerge{
+				SurvivorUID: "user-one-uid",
+				DryRun:      true,
+				Changes: map[string]*models.AuditChange{
+					"displayName": {Before: "user one", After: "user three"},
+					"photoURL":    {Before: "https://image-one.png", After: "https://image-three.png"},
+				},
+				DeletedUsers: map[string]string{},
+				Aliases:      []string{"user-three-uid"},
+			},
+			expectDisplayName: "user one",
+		},
+		{
+			name: "NotStaff",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse: &models.User{FirebaseUID: "user-one-uid"},
+			expectAudit:  lo.ToPtr(models.AuditOutcomeFailure),
+			expectErr:    services.ErrNotStaff,
+		},
+		{
+			// Impersonation sessions carry no staff privileges.
+			name: "Scoped",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse: &models.User{
+				FirebaseUID:  "staff-two-uid",
+				Staff:        true,
+				Scopes:       []string{models.ScopeUserRead},
+				Impersonator: "staff-one-uid",
+			},
+			expectAudit: lo.ToPtr(models.AuditOutcomeFailure),
+			expectErr:   services.ErrNotStaff,
+		},
+		{
+			name: "MissingReason",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+			},
+			authResponse: &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			expectErr:    services.ErrInvalidMergeUsers,
+		},
+		{
+			name: "InvalidPrecedence",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Precedence:  "random",
+				Reason:      "ticket 1234",
+			},
+			authResponse: &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			expectErr:    services.ErrInvalidMergeUsers,
+		},
+		{
+			name: "SurvivorIncluded",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid", "user-one-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse: &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			expectErr:    services.ErrMergeSurvivorIncluded,
+		},
+		{
+			name: "SurvivorNotFound",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-four-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse:        &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers: true,
+			listUsersResponse:   []*entities.User{},
+			expectErr:           services.ErrUserNotFound,
+		},
+		{
+			name: "MergeStaff",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"staff-two-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse:        &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers: true,
+			listUsersResponse:   []*entities.User{},
+			expectErr:           services.ErrMergeUsersStaff,
+		},
+		{
+			name: "AlreadyLinked",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse:         &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers:  true,
+			listUsersResponse:    []*entities.User{},
+			shouldCallMergeUsers: true,
+			expectMergeUsersData: &dao.MergeUsersData{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+			},
+			mergeUsersErr: dao.ErrUserAliasAlreadyExists,
+			expectErr:     services.ErrAccountsAlreadyLinked,
+		},
+		{
+			// The profile of the survivor is left as is.
+			name: "AlreadyLinkedProfileChange",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Precedence:  models.MergePrecedenceNewest,
+				Reason:      "ticket 1234",
+			},
+			authResponse:               &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers:        true,
+			listUsersResponse:          []*entities.User{},
+			shouldCallMergeUsersDryRun: true,
+			mergeUsersDryRunErr:        dao.ErrUserAliasAlreadyExists,
+			expectMergeUsersData: &dao.MergeUsersData{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+			},
+			expectDisplayName: "user one",
+			expectErr:         services.ErrAccountsAlreadyLinked,
+		},
+		{
+			name: "AuthError",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authErr:   services.ErrReauthenticationRequired,
+			expectErr: services.ErrReauthenticationRequired,
+		},
+		{
+			name: "ListUsersError",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse:        &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers: true,
+			listUsersErr:        FooErr,
+			expectErr:           FooErr,
+		},
+		{
+			name: "MergeUsersError",
+			data: &models.MergeUsers{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+				Reason:      "ticket 1234",
+			},
+			authResponse:         &models.User{FirebaseUID: "staff-two-uid", Staff: true},
+			shouldCallListUsers:  true,
+			listUsersResponse:    []*entities.User{},
+			shouldCallMergeUsers: true,
+			expectMergeUsersData: &dao.MergeUsersData{
+				SurvivorUID: "user-one-uid",
+				MergedUIDs:  []string{"user-three-uid"},
+			},
+			mergeUsersErr: FooErr,
+			expectErr:     FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			require.NoError(t, CreateUsersFixtures(mergeUsersFixtures))
+			defer CleanUsersFixtures(mergeUsersFixtures)
+
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			listUsersRepository := daomocks.NewMockListUsersRepository(t)
+			mergeUsersRepository := daomocks.NewMockMergeUsersRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.
+				On("Exec", context.TODO(), &models.Authenticate{Token: "foo-token", MaxAuthAge: 5 * time.Minute}).
+				Return(data.authResponse, data.authErr)
+
+			if data.shouldCallListUsers {
+				listUsersRepository.
+					On("ListUsers", context.TODO(), "", append([]string{data.data.SurvivorUID}, data.data.MergedUIDs...)).
+					Return(data.listUsersResponse, data.listUsersErr)
+			}
+
+			if data.shouldCallMergeUsersDryRun {
+				mergeUsersRepository.
+					On("MergeUsers", context.TODO(), "", &dao.MergeUsersData{
+						SurvivorUID:      data.expectMergeUsersData.SurvivorUID,
+						MergedUIDs:       data.expectMergeUsersData.MergedUIDs,
+						PublicIdentifier: data.expectMergeUsersData.PublicIdentifier,
+						DryRun:           true,
+					}).
+					Return(data.mergeUsersResponse, data.mergeUsersDryRunErr)
+			}
+
+			if data.shouldCallMergeUsers {
+				mergeUsersRepository.
+					On("MergeUsers", context.TODO(), "", data.expectMergeUsersData).
+					Return(data.mergeUsersResponse, data.mergeUsersErr)
+			}
+
+			if data.expectAudit != nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionMergeUsers &&
+							in.Outcome == *data.expectAudit &&
+							in.SubjectUID == data.data.SurvivorUID
+					})).
+					Return(nil)
+			}
+
+			service := services.NewMergeUsersService(
+				config.AuthClient,
+				authService,
+				listUsersRepository,
+				mergeUsersRepository,
+				recordAuditEventService,
+				models.MergeUsersConfig{Precedence: models.MergePrecedenceSurvivor, MaxAuthAge: 5 * time.Minute},
+			)
+
+			merge, err := service.Exec(context.TODO(), "foo-token", data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, merge)
+
+			if data.expectDisplayName != "" {
+				survivor, err := config.AuthClient.GetUser(context.TODO(), data.data.SurvivorUID)
+				require.NoError(t, err)
+				require.Equal(t, data.expectDisplayName, survivor.DisplayName)
+			}
+
+			authService.AssertExpectations(t)
+			listUsersRepository.AssertExpectations(t)
+			mergeUsersRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
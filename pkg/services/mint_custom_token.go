@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+// mintedByClaim is set on custom tokens with the service account that minted them. Authenticate reports it, and never
+// counts these tokens as a recent sign-in.
+const mintedByClaim = "minted_by"
+
+// reservedCustomTokenClaims are only set by this service. Firebase rejects its own reserved claims by itself.
+var reservedCustomTokenClaims = []string{staffClaim, impersonatorClaim, impersonationSessionClaim, mintedByClaim}
+
+// MintCustomTokenService lets trusted backends sign in as a user without an interactive login. Services are
+// authenticated by their identity token, and may only mint tokens for the users and claims they were granted.
+type MintCustomTokenService interface {
+	Exec(ctx context.Context, data *models.MintCustomToken) (*models.MintedCustomToken, error)
+}
+
+type mintCustomTokenServiceImpl struct {
+	client   *auth.Client
+	verifier clients.ServiceIdentityVerifier
+
+	recordAuditEvent RecordAuditEventService
+
+	config models.CustomTokenConfig
+}
+
+func (s *mintCustomTokenServiceImpl) findGrant(service string, tenantID string, firebaseUID string) *models.CustomTokenGrant {
+	grant, found := lo.Find(s.config.Grants, func(grant models.CustomTokenGrant) bool {
+		return grant.Service == service &&
+			grant.TenantID == tenantID &&
+			(lo.Contains(grant.FirebaseUIDs, firebaseUID) || lo.Contains(grant.FirebaseUIDs, models.CustomTokenAnyUser))
+	})
+	if !found {
+		return nil
+	}
+
+	return &grant
+}
+
+// mint also returns the calling service, as soon as it is known, so failures can be audited.
+func (s *mintCustomTokenServiceImpl) mint(ctx context.Context, data *models.MintCustomToken) (string, string, error) {
+	service, err := s.verifier.VerifyServiceIdentity(ctx, data.ServiceToken)
+	if err != nil {
+		return "", "", errors.Join(ErrServiceUnauthenticated, err)
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return service, "", errors.Join(ErrInvalidMintCustomToken, err)
+	}
+
+	grant := s.findGrant(service, data.TenantID, data.FirebaseUID)
+	if grant == nil {
+		return service, "", ErrCustomTokenNotAllowed
+	}
+
+	for name := range data.Claims {
+		if lo.Contains(reservedCustomTokenClaims, name) || !lo.Contains(grant.Claims, name) {
+			return service, "", errors.Join(ErrCustomTokenClaimNotAllowed, errors.New(name))
+		}
+	}
+
+	client, err := firebaseUsersForTenant(s.client, data.TenantID)
+	if err != nil {
+		return service, "", err
+	}
+
+	user, err := client.GetUser(ctx, data.FirebaseUID)
+	if err != nil {
+		if auth.IsUserNotFound(err) {
+			return service, "", ErrUserNotFound
+		}
+
+		return service, "", err
+	}
+
+	// The staff claim is stored on the user, so any token they sign in with carries it.
+	if isStaff(user.CustomClaims) {
+		return service, "", ErrCustomTokenStaffUser
+	}
+
+	claims := lo.Assign(data.Claims, map[string]interface{}{mintedByClaim: service})
+
+	token, err := client.CustomTokenWithClaims(ctx, user.UID, claims)
+	if err != nil {
+		return service, "", err
+	}
+
+	return service, token, nil
+}
+
+func (s *mintCustomTokenServiceImpl) Exec(ctx context.Context, data *models.MintCustomToken) (*models.MintedCustomToken, error) {
+	service, token, err := s.mint(ctx, data)
+
+	event := &models.RecordAuditEvent{
+		// The actor is a service account rather than a user.
+		ActorUID:   service,
+		SubjectUID: data.FirebaseUID,
+		Action:     models.AuditActionMintCustomToken,
+		Outcome:    models.AuditOutcomeSuccess,
+	}
+	if err != nil {
+		event.Outcome, event.Reason = models.AuditOutcomeFailure, err.Error()
+	}
+
+	if auditErr := s.recordAuditEvent.Exec(ctx, event); err != nil || auditErr != nil {
+		return nil, errors.Join(err, auditErr)
+	}
+
+	return &models.MintedCustomToken{Token: token}, nil
+}
+
+func NewMintCustomTokenService(
+	client *auth.Client,
+	verifier clients.ServiceIdentityVerifier,
+	recordAuditEvent RecordAuditEventService,
+	config models.CustomTokenConfig,
+) MintCustomTokenService {
+	return &mintCustomTokenServiceImpl{
+		client:           client,
+		verifier:         verifier,
+		recordAuditEvent: recordAuditEvent,
+		config:           config,
+	}
+}
@@ -0,0 +1,224 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/config"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var mintCustomTokenFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+	{
+		Email:         "staff@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "staff two",
+		UID:           "staff-two-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+		CustomClaims:  map[string]interface{}{"staff": true},
+	},
+}
+
+func TestMintCustomToken(t *testing.T) {
+	customTokenConfig := models.CustomTokenConfig{
+		Grants: []models.CustomTokenGrant{
+			{
+				Service:      "desktop@inrich-dev.iam.gserviceaccount.com",
+				FirebaseUIDs: []string{"user-one-uid"},
+				Claims:       []string{"legacy_client", "staff"},
+			},
+			{
+				Service:      "migrations@inrich-dev.iam.gserviceaccount.com",
+				FirebaseUIDs: []string{models.CustomTokenAnyUser},
+			},
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		data *models.MintCustomToken
+
+		verifyResponse string
+		verifyErr      error
+
+		expectAudit  models.AuditOutcome
+		expectClaims map[string]interface{}
+		expectErr    error
+	}{
+		{
+			name: "MintCustomToken",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-one-uid",
+				Claims:       map[string]interface{}{"legacy_client": true},
+			},
+			verifyResponse: "desktop@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeSuccess,
+			expectClaims: map[string]interface{}{
+				"legacy_client": true,
+				"minted_by":     "desktop@inrich-dev.iam.gserviceaccount.com",
+			},
+		},
+		{
+			name: "AnyUser",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-one-uid",
+			},
+			verifyResponse: "migrations@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeSuccess,
+			expectClaims: map[string]interface{}{
+				"minted_by": "migrations@inrich-dev.iam.gserviceaccount.com",
+			},
+		},
+		{
+			name: "ServiceUnauthenticated",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-one-uid",
+			},
+			verifyErr:   FooErr,
+			expectAudit: models.AuditOutcomeFailure,
+			expectErr:   services.ErrServiceUnauthenticated,
+		},
+		{
+			name: "UnknownService",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-one-uid",
+			},
+			verifyResponse: "batch@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrCustomTokenNotAllowed,
+		},
+		{
+			name: "UserNotGranted",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-three-uid",
+			},
+			verifyResponse: "desktop@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrCustomTokenNotAllowed,
+		},
+		{
+			name: "TenantNotGranted",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				TenantID:     "tenant-1",
+				FirebaseUID:  "user-one-uid",
+			},
+			verifyResponse: "migrations@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrCustomTokenNotAllowed,
+		},
+		{
+			name: "ClaimNotGranted",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-one-uid",
+				Claims:       map[string]interface{}{"legacy_client": true},
+			},
+			verifyResponse: "migrations@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrCustomTokenClaimNotAllowed,
+		},
+		{
+			// Reserved claims cannot be granted.
+			name: "ReservedClaim",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-one-uid",
+				Claims:       map[string]interface{}{"staff": true},
+			},
+			verifyResponse: "desktop@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrCustomTokenClaimNotAllowed,
+		},
+		{
+			name: "StaffUser",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "staff-two-uid",
+			},
+			verifyResponse: "migrations@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrCustomTokenStaffUser,
+		},
+		{
+			name: "UserNotFound",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+				FirebaseUID:  "user-three-uid",
+			},
+			verifyResponse: "migrations@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrUserNotFound,
+		},
+		{
+			name: "InvalidMintCustomToken",
+			data: &models.MintCustomToken{
+				ServiceToken: "service-token",
+			},
+			verifyResponse: "migrations@inrich-dev.iam.gserviceaccount.com",
+			expectAudit:    models.AuditOutcomeFailure,
+			expectErr:      services.ErrInvalidMintCustomToken,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			require.NoError(t, CreateUsersFixtures(mintCustomTokenFixtures))
+			defer CleanUsersFixtures(mintCustomTokenFixtures)
+
+			verifier := clientsmocks.NewMockServiceIdentityVerifier(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			verifier.
+				On("VerifyServiceIdentity", context.TODO(), data.data.ServiceToken).
+				Return(data.verifyResponse, data.verifyErr)
+
+			recordAuditEventService.
+				On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+					return in.Action == models.AuditActionMintCustomToken &&
+						in.Outcome == data.expectAudit &&
+						in.ActorUID == data.verifyResponse &&
+						in.SubjectUID == data.data.FirebaseUID
+				})).
+				Return(nil)
+
+			service := services.NewMintCustomTokenService(
+				config.AuthClient, verifier, recordAuditEventService, customTokenConfig,
+			)
+
+			minted, err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expectClaims == nil {
+				require.Nil(t, minted)
+			} else {
+				claims := decodeCustomTokenClaims(t, minted.Token)
+				require.Equal(t, data.data.FirebaseUID, claims["uid"])
+				require.Equal(t, data.expectClaims, claims["claims"])
+			}
+
+			verifier.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
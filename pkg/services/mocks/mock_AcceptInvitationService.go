@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAcceptInvitationService is an autogenerated mock type for the AcceptInvitationService type
+type MockAcceptInvitationService struct {
+	mock.Mock
+}
+
+type MockAcceptInvitationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAcceptInvitationService) EXPECT() *MockAcceptInvitationService_Expecter {
+	return &MockAcceptInvitationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, code
+func (_m *MockAcceptInvitationService) Exec(ctx context.Context, token string, code string) (*models.Membership, error) {
+	ret := _m.Called(ctx, token, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Membership, error)); ok {
+		return rf(ctx, token, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Membership); ok {
+		r0 = rf(ctx, token, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAcceptInvitationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAcceptInvitationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - code string
+func (_e *MockAcceptInvitationService_Expecter) Exec(ctx interface{}, token interface{}, code interface{}) *MockAcceptInvitationService_Exec_Call {
+	return &MockAcceptInvitationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, code)}
+}
+
+func (_c *MockAcceptInvitationService_Exec_Call) Run(run func(ctx context.Context, token string, code string)) *MockAcceptInvitationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAcceptInvitationService_Exec_Call) Return(_a0 *models.Membership, _a1 error) *MockAcceptInvitationService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAcceptInvitationService_Exec_Call) RunAndReturn(run func(context.Context, string, string) (*models.Membership, error)) *MockAcceptInvitationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAcceptInvitationService creates a new instance of MockAcceptInvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAcceptInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAcceptInvitationService {
+	mock := &MockAcceptInvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
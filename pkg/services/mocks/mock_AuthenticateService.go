@@ -22,9 +22,9 @@ func (_m *MockAuthenticateService) EXPECT() *MockAuthenticateService_Expecter {
 	return &MockAuthenticateService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function with given fields: ctx, token
-func (_m *MockAuthenticateService) Exec(ctx context.Context, token string) (*models.User, error) {
-	ret := _m.Called(ctx, token)
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockAuthenticateService) Exec(ctx context.Context, data *models.Authenticate) (*models.User, error) {
+	ret := _m.Called(ctx, data)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
@@ -32,19 +32,19 @@ func (_m *MockAuthenticateService) Exec(ctx context.Context, token string) (*mod
 
 	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
-		return rf(ctx, token)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Authenticate) (*models.User, error)); ok {
+		return rf(ctx, data)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
-		r0 = rf(ctx, token)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Authenticate) *models.User); ok {
+		r0 = rf(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, token)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Authenticate) error); ok {
+		r1 = rf(ctx, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,14 +59,14 @@ type MockAuthenticateService_Exec_Call struct {
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - token string
-func (_e *MockAuthenticateService_Expecter) Exec(ctx interface{}, token interface{}) *MockAuthenticateService_Exec_Call {
-	return &MockAuthenticateService_Exec_Call{Call: _e.mock.On("Exec", ctx, token)}
+//   - data *models.Authenticate
+func (_e *MockAuthenticateService_Expecter) Exec(ctx interface{}, data interface{}) *MockAuthenticateService_Exec_Call {
+	return &MockAuthenticateService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
 }
 
-func (_c *MockAuthenticateService_Exec_Call) Run(run func(ctx context.Context, token string)) *MockAuthenticateService_Exec_Call {
+func (_c *MockAuthenticateService_Exec_Call) Run(run func(ctx context.Context, data *models.Authenticate)) *MockAuthenticateService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(*models.Authenticate))
 	})
 	return _c
 }
@@ -76,7 +76,7 @@ func (_c *MockAuthenticateService_Exec_Call) Return(_a0 *models.User, _a1 error)
 	return _c
 }
 
-func (_c *MockAuthenticateService_Exec_Call) RunAndReturn(run func(context.Context, string) (*models.User, error)) *MockAuthenticateService_Exec_Call {
+func (_c *MockAuthenticateService_Exec_Call) RunAndReturn(run func(context.Context, *models.Authenticate) (*models.User, error)) *MockAuthenticateService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCheckRateLimitService is an autogenerated mock type for the CheckRateLimitService type
+type MockCheckRateLimitService struct {
+	mock.Mock
+}
+
+type MockCheckRateLimitService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCheckRateLimitService) EXPECT() *MockCheckRateLimitService_Expecter {
+	return &MockCheckRateLimitService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockCheckRateLimitService) Exec(ctx context.Context, data *models.CheckRateLimit) (*models.RateLimitDecision, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.RateLimitDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CheckRateLimit) (*models.RateLimitDecision, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CheckRateLimit) *models.RateLimitDecision); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RateLimitDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CheckRateLimit) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckRateLimitService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCheckRateLimitService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.CheckRateLimit
+func (_e *MockCheckRateLimitService_Expecter) Exec(ctx interface{}, data interface{}) *MockCheckRateLimitService_Exec_Call {
+	return &MockCheckRateLimitService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockCheckRateLimitService_Exec_Call) Run(run func(ctx context.Context, data *models.CheckRateLimit)) *MockCheckRateLimitService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CheckRateLimit))
+	})
+	return _c
+}
+
+func (_c *MockCheckRateLimitService_Exec_Call) Return(_a0 *models.RateLimitDecision, _a1 error) *MockCheckRateLimitService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckRateLimitService_Exec_Call) RunAndReturn(run func(context.Context, *models.CheckRateLimit) (*models.RateLimitDecision, error)) *MockCheckRateLimitService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCheckRateLimitService creates a new instance of MockCheckRateLimitService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCheckRateLimitService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCheckRateLimitService {
+	mock := &MockCheckRateLimitService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
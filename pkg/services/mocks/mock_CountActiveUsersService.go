@@ -0,0 +1,94 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCountActiveUsersService is an autogenerated mock type for the CountActiveUsersService type
+type MockCountActiveUsersService struct {
+	mock.Mock
+}
+
+type MockCountActiveUsersService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountActiveUsersService) EXPECT() *MockCountActiveUsersService_Expecter {
+	return &MockCountActiveUsersService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockCountActiveUsersService) Exec(ctx context.Context, data *models.CountActiveUsers) (int, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CountActiveUsers) (int, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CountActiveUsers) int); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CountActiveUsers) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCountActiveUsersService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCountActiveUsersService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.CountActiveUsers
+func (_e *MockCountActiveUsersService_Expecter) Exec(ctx interface{}, data interface{}) *MockCountActiveUsersService_Exec_Call {
+	return &MockCountActiveUsersService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockCountActiveUsersService_Exec_Call) Run(run func(ctx context.Context, data *models.CountActiveUsers)) *MockCountActiveUsersService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CountActiveUsers))
+	})
+	return _c
+}
+
+func (_c *MockCountActiveUsersService_Exec_Call) Return(_a0 int, _a1 error) *MockCountActiveUsersService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCountActiveUsersService_Exec_Call) RunAndReturn(run func(context.Context, *models.CountActiveUsers) (int, error)) *MockCountActiveUsersService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountActiveUsersService creates a new instance of MockCountActiveUsersService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountActiveUsersService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountActiveUsersService {
+	mock := &MockCountActiveUsersService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateAuditCheckpointService is an autogenerated mock type for the CreateAuditCheckpointService type
+type MockCreateAuditCheckpointService struct {
+	mock.Mock
+}
+
+type MockCreateAuditCheckpointService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateAuditCheckpointService) EXPECT() *MockCreateAuditCheckpointService_Expecter {
+	return &MockCreateAuditCheckpointService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx
+func (_m *MockCreateAuditCheckpointService) Exec(ctx context.Context) (*models.AuditCheckpoint, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.AuditCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.AuditCheckpoint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.AuditCheckpoint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AuditCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateAuditCheckpointService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCreateAuditCheckpointService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCreateAuditCheckpointService_Expecter) Exec(ctx interface{}) *MockCreateAuditCheckpointService_Exec_Call {
+	return &MockCreateAuditCheckpointService_Exec_Call{Call: _e.mock.On("Exec", ctx)}
+}
+
+func (_c *MockCreateAuditCheckpointService_Exec_Call) Run(run func(ctx context.Context)) *MockCreateAuditCheckpointService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCreateAuditCheckpointService_Exec_Call) Return(_a0 *models.AuditCheckpoint, _a1 error) *MockCreateAuditCheckpointService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateAuditCheckpointService_Exec_Call) RunAndReturn(run func(context.Context) (*models.AuditCheckpoint, error)) *MockCreateAuditCheckpointService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateAuditCheckpointService creates a new instance of MockCreateAuditCheckpointService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateAuditCheckpointService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateAuditCheckpointService {
+	mock := &MockCreateAuditCheckpointService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateOrganizationService is an autogenerated mock type for the CreateOrganizationService type
+type MockCreateOrganizationService struct {
+	mock.Mock
+}
+
+type MockCreateOrganizationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateOrganizationService) EXPECT() *MockCreateOrganizationService_Expecter {
+	return &MockCreateOrganizationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockCreateOrganizationService) Exec(ctx context.Context, token string, data *models.CreateOrganization) (*models.Organization, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.CreateOrganization) (*models.Organization, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.CreateOrganization) *models.Organization); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.CreateOrganization) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateOrganizationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCreateOrganizationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.CreateOrganization
+func (_e *MockCreateOrganizationService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockCreateOrganizationService_Exec_Call {
+	return &MockCreateOrganizationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockCreateOrganizationService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.CreateOrganization)) *MockCreateOrganizationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.CreateOrganization))
+	})
+	return _c
+}
+
+func (_c *MockCreateOrganizationService_Exec_Call) Return(_a0 *models.Organization, _a1 error) *MockCreateOrganizationService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateOrganizationService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.CreateOrganization) (*models.Organization, error)) *MockCreateOrganizationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateOrganizationService creates a new instance of MockCreateOrganizationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateOrganizationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateOrganizationService {
+	mock := &MockCreateOrganizationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
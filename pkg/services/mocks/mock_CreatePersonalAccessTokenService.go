@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreatePersonalAccessTokenService is an autogenerated mock type for the CreatePersonalAccessTokenService type
+type MockCreatePersonalAccessTokenService struct {
+	mock.Mock
+}
+
+type MockCreatePersonalAccessTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreatePersonalAccessTokenService) EXPECT() *MockCreatePersonalAccessTokenService_Expecter {
+	return &MockCreatePersonalAccessTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockCreatePersonalAccessTokenService) Exec(ctx context.Context, token string, data *models.CreatePersonalAccessToken) (*models.CreatedPersonalAccessToken, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.CreatedPersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.CreatePersonalAccessToken) (*models.CreatedPersonalAccessToken, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.CreatePersonalAccessToken) *models.CreatedPersonalAccessToken); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CreatedPersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.CreatePersonalAccessToken) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreatePersonalAccessTokenService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCreatePersonalAccessTokenService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.CreatePersonalAccessToken
+func (_e *MockCreatePersonalAccessTokenService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockCreatePersonalAccessTokenService_Exec_Call {
+	return &MockCreatePersonalAccessTokenService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockCreatePersonalAccessTokenService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.CreatePersonalAccessToken)) *MockCreatePersonalAccessTokenService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.CreatePersonalAccessToken))
+	})
+	return _c
+}
+
+func (_c *MockCreatePersonalAccessTokenService_Exec_Call) Return(_a0 *models.CreatedPersonalAccessToken, _a1 error) *MockCreatePersonalAccessTokenService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreatePersonalAccessTokenService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.CreatePersonalAccessToken) (*models.CreatedPersonalAccessToken, error)) *MockCreatePersonalAccessTokenService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreatePersonalAccessTokenService creates a new instance of MockCreatePersonalAccessTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreatePersonalAccessTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreatePersonalAccessTokenService {
+	mock := &MockCreatePersonalAccessTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
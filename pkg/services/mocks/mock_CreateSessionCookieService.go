@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateSessionCookieService is an autogenerated mock type for the CreateSessionCookieService type
+type MockCreateSessionCookieService struct {
+	mock.Mock
+}
+
+type MockCreateSessionCookieService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateSessionCookieService) EXPECT() *MockCreateSessionCookieService_Expecter {
+	return &MockCreateSessionCookieService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, idToken
+func (_m *MockCreateSessionCookieService) Exec(ctx context.Context, idToken string) (*models.SessionCookie, error) {
+	ret := _m.Called(ctx, idToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.SessionCookie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.SessionCookie, error)); ok {
+		return rf(ctx, idToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.SessionCookie); ok {
+		r0 = rf(ctx, idToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SessionCookie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, idToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateSessionCookieService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCreateSessionCookieService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idToken string
+func (_e *MockCreateSessionCookieService_Expecter) Exec(ctx interface{}, idToken interface{}) *MockCreateSessionCookieService_Exec_Call {
+	return &MockCreateSessionCookieService_Exec_Call{Call: _e.mock.On("Exec", ctx, idToken)}
+}
+
+func (_c *MockCreateSessionCookieService_Exec_Call) Run(run func(ctx context.Context, idToken string)) *MockCreateSessionCookieService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCreateSessionCookieService_Exec_Call) Return(_a0 *models.SessionCookie, _a1 error) *MockCreateSessionCookieService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateSessionCookieService_Exec_Call) RunAndReturn(run func(context.Context, string) (*models.SessionCookie, error)) *MockCreateSessionCookieService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateSessionCookieService creates a new instance of MockCreateSessionCookieService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateSessionCookieService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateSessionCookieService {
+	mock := &MockCreateSessionCookieService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
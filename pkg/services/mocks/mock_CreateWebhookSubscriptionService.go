@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateWebhookSubscriptionService is an autogenerated mock type for the CreateWebhookSubscriptionService type
+type MockCreateWebhookSubscriptionService struct {
+	mock.Mock
+}
+
+type MockCreateWebhookSubscriptionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateWebhookSubscriptionService) EXPECT() *MockCreateWebhookSubscriptionService_Expecter {
+	return &MockCreateWebhookSubscriptionService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockCreateWebhookSubscriptionService) Exec(ctx context.Context, data *models.CreateWebhookSubscription) (*models.WebhookSubscription, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CreateWebhookSubscription) (*models.WebhookSubscription, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CreateWebhookSubscription) *models.WebhookSubscription); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CreateWebhookSubscription) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateWebhookSubscriptionService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCreateWebhookSubscriptionService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.CreateWebhookSubscription
+func (_e *MockCreateWebhookSubscriptionService_Expecter) Exec(ctx interface{}, data interface{}) *MockCreateWebhookSubscriptionService_Exec_Call {
+	return &MockCreateWebhookSubscriptionService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockCreateWebhookSubscriptionService_Exec_Call) Run(run func(ctx context.Context, data *models.CreateWebhookSubscription)) *MockCreateWebhookSubscriptionService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CreateWebhookSubscription))
+	})
+	return _c
+}
+
+func (_c *MockCreateWebhookSubscriptionService_Exec_Call) Return(_a0 *models.WebhookSubscription, _a1 error) *MockCreateWebhookSubscriptionService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateWebhookSubscriptionService_Exec_Call) RunAndReturn(run func(context.Context, *models.CreateWebhookSubscription) (*models.WebhookSubscription, error)) *MockCreateWebhookSubscriptionService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateWebhookSubscriptionService creates a new instance of MockCreateWebhookSubscriptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateWebhookSubscriptionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateWebhookSubscriptionService {
+	mock := &MockCreateWebhookSubscriptionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteExpiredInvitationsService is an autogenerated mock type for the DeleteExpiredInvitationsService type
+type MockDeleteExpiredInvitationsService struct {
+	mock.Mock
+}
+
+type MockDeleteExpiredInvitationsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteExpiredInvitationsService) EXPECT() *MockDeleteExpiredInvitationsService_Expecter {
+	return &MockDeleteExpiredInvitationsService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx
+func (_m *MockDeleteExpiredInvitationsService) Exec(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteExpiredInvitationsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockDeleteExpiredInvitationsService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDeleteExpiredInvitationsService_Expecter) Exec(ctx interface{}) *MockDeleteExpiredInvitationsService_Exec_Call {
+	return &MockDeleteExpiredInvitationsService_Exec_Call{Call: _e.mock.On("Exec", ctx)}
+}
+
+func (_c *MockDeleteExpiredInvitationsService_Exec_Call) Run(run func(ctx context.Context)) *MockDeleteExpiredInvitationsService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDeleteExpiredInvitationsService_Exec_Call) Return(_a0 int, _a1 error) *MockDeleteExpiredInvitationsService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteExpiredInvitationsService_Exec_Call) RunAndReturn(run func(context.Context) (int, error)) *MockDeleteExpiredInvitationsService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteExpiredInvitationsService creates a new instance of MockDeleteExpiredInvitationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteExpiredInvitationsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteExpiredInvitationsService {
+	mock := &MockDeleteExpiredInvitationsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
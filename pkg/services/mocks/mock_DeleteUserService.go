@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteUserService is an autogenerated mock type for the DeleteUserService type
+type MockDeleteUserService struct {
+	mock.Mock
+}
+
+type MockDeleteUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteUserService) EXPECT() *MockDeleteUserService_Expecter {
+	return &MockDeleteUserService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token
+func (_m *MockDeleteUserService) Exec(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteUserService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockDeleteUserService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockDeleteUserService_Expecter) Exec(ctx interface{}, token interface{}) *MockDeleteUserService_Exec_Call {
+	return &MockDeleteUserService_Exec_Call{Call: _e.mock.On("Exec", ctx, token)}
+}
+
+func (_c *MockDeleteUserService_Exec_Call) Run(run func(ctx context.Context, token string)) *MockDeleteUserService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeleteUserService_Exec_Call) Return(_a0 error) *MockDeleteUserService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteUserService_Exec_Call) RunAndReturn(run func(context.Context, string) error) *MockDeleteUserService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteUserService creates a new instance of MockDeleteUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteUserService {
+	mock := &MockDeleteUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteWebhookSubscriptionService is an autogenerated mock type for the DeleteWebhookSubscriptionService type
+type MockDeleteWebhookSubscriptionService struct {
+	mock.Mock
+}
+
+type MockDeleteWebhookSubscriptionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteWebhookSubscriptionService) EXPECT() *MockDeleteWebhookSubscriptionService_Expecter {
+	return &MockDeleteWebhookSubscriptionService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, id
+func (_m *MockDeleteWebhookSubscriptionService) Exec(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteWebhookSubscriptionService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockDeleteWebhookSubscriptionService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDeleteWebhookSubscriptionService_Expecter) Exec(ctx interface{}, id interface{}) *MockDeleteWebhookSubscriptionService_Exec_Call {
+	return &MockDeleteWebhookSubscriptionService_Exec_Call{Call: _e.mock.On("Exec", ctx, id)}
+}
+
+func (_c *MockDeleteWebhookSubscriptionService_Exec_Call) Run(run func(ctx context.Context, id string)) *MockDeleteWebhookSubscriptionService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeleteWebhookSubscriptionService_Exec_Call) Return(_a0 error) *MockDeleteWebhookSubscriptionService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteWebhookSubscriptionService_Exec_Call) RunAndReturn(run func(context.Context, string) error) *MockDeleteWebhookSubscriptionService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteWebhookSubscriptionService creates a new instance of MockDeleteWebhookSubscriptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteWebhookSubscriptionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteWebhookSubscriptionService {
+	mock := &MockDeleteWebhookSubscriptionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
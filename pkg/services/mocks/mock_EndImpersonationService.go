@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEndImpersonationService is an autogenerated mock type for the EndImpersonationService type
+type MockEndImpersonationService struct {
+	mock.Mock
+}
+
+type MockEndImpersonationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEndImpersonationService) EXPECT() *MockEndImpersonationService_Expecter {
+	return &MockEndImpersonationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, id
+func (_m *MockEndImpersonationService) Exec(ctx context.Context, token string, id string) (*models.ImpersonationSession, error) {
+	ret := _m.Called(ctx, token, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.ImpersonationSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.ImpersonationSession, error)); ok {
+		return rf(ctx, token, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.ImpersonationSession); ok {
+		r0 = rf(ctx, token, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImpersonationSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEndImpersonationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockEndImpersonationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - id string
+func (_e *MockEndImpersonationService_Expecter) Exec(ctx interface{}, token interface{}, id interface{}) *MockEndImpersonationService_Exec_Call {
+	return &MockEndImpersonationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, id)}
+}
+
+func (_c *MockEndImpersonationService_Exec_Call) Run(run func(ctx context.Context, token string, id string)) *MockEndImpersonationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockEndImpersonationService_Exec_Call) Return(_a0 *models.ImpersonationSession, _a1 error) *MockEndImpersonationService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEndImpersonationService_Exec_Call) RunAndReturn(run func(context.Context, string, string) (*models.ImpersonationSession, error)) *MockEndImpersonationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEndImpersonationService creates a new instance of MockEndImpersonationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEndImpersonationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEndImpersonationService {
+	mock := &MockEndImpersonationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	clients "github.com/in-rich/uservice-authentication/pkg/clients"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEnqueueWebhookDeliveriesService is an autogenerated mock type for the EnqueueWebhookDeliveriesService type
+type MockEnqueueWebhookDeliveriesService struct {
+	mock.Mock
+}
+
+type MockEnqueueWebhookDeliveriesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEnqueueWebhookDeliveriesService) EXPECT() *MockEnqueueWebhookDeliveriesService_Expecter {
+	return &MockEnqueueWebhookDeliveriesService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, event
+func (_m *MockEnqueueWebhookDeliveriesService) Exec(ctx context.Context, event *clients.UserEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.UserEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEnqueueWebhookDeliveriesService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockEnqueueWebhookDeliveriesService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *clients.UserEvent
+func (_e *MockEnqueueWebhookDeliveriesService_Expecter) Exec(ctx interface{}, event interface{}) *MockEnqueueWebhookDeliveriesService_Exec_Call {
+	return &MockEnqueueWebhookDeliveriesService_Exec_Call{Call: _e.mock.On("Exec", ctx, event)}
+}
+
+func (_c *MockEnqueueWebhookDeliveriesService_Exec_Call) Run(run func(ctx context.Context, event *clients.UserEvent)) *MockEnqueueWebhookDeliveriesService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*clients.UserEvent))
+	})
+	return _c
+}
+
+func (_c *MockEnqueueWebhookDeliveriesService_Exec_Call) Return(_a0 error) *MockEnqueueWebhookDeliveriesService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEnqueueWebhookDeliveriesService_Exec_Call) RunAndReturn(run func(context.Context, *clients.UserEvent) error) *MockEnqueueWebhookDeliveriesService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEnqueueWebhookDeliveriesService creates a new instance of MockEnqueueWebhookDeliveriesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEnqueueWebhookDeliveriesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEnqueueWebhookDeliveriesService {
+	mock := &MockEnqueueWebhookDeliveriesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFlushAuditEventsService is an autogenerated mock type for the FlushAuditEventsService type
+type MockFlushAuditEventsService struct {
+	mock.Mock
+}
+
+type MockFlushAuditEventsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFlushAuditEventsService) EXPECT() *MockFlushAuditEventsService_Expecter {
+	return &MockFlushAuditEventsService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx
+func (_m *MockFlushAuditEventsService) Exec(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFlushAuditEventsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockFlushAuditEventsService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFlushAuditEventsService_Expecter) Exec(ctx interface{}) *MockFlushAuditEventsService_Exec_Call {
+	return &MockFlushAuditEventsService_Exec_Call{Call: _e.mock.On("Exec", ctx)}
+}
+
+func (_c *MockFlushAuditEventsService_Exec_Call) Run(run func(ctx context.Context)) *MockFlushAuditEventsService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFlushAuditEventsService_Exec_Call) Return(_a0 int, _a1 error) *MockFlushAuditEventsService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFlushAuditEventsService_Exec_Call) RunAndReturn(run func(context.Context) (int, error)) *MockFlushAuditEventsService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFlushAuditEventsService creates a new instance of MockFlushAuditEventsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFlushAuditEventsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFlushAuditEventsService {
+	mock := &MockFlushAuditEventsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
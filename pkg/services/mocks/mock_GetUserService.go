@@ -22,9 +22,9 @@ func (_m *MockGetUserService) EXPECT() *MockGetUserService_Expecter {
 	return &MockGetUserService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function with given fields: ctx, uid
-func (_m *MockGetUserService) Exec(ctx context.Context, uid string) (*models.User, error) {
-	ret := _m.Called(ctx, uid)
+// Exec provides a mock function with given fields: ctx, tenantID, uid
+func (_m *MockGetUserService) Exec(ctx context.Context, tenantID string, uid string) (*models.User, error) {
+	ret := _m.Called(ctx, tenantID, uid)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
@@ -32,19 +32,19 @@ func (_m *MockGetUserService) Exec(ctx context.Context, uid string) (*models.Use
 
 	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
-		return rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.User, error)); ok {
+		return rf(ctx, tenantID, uid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
-		r0 = rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.User); ok {
+		r0 = rf(ctx, tenantID, uid)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, uid)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, uid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,14 +59,15 @@ type MockGetUserService_Exec_Call struct {
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tenantID string
 //   - uid string
-func (_e *MockGetUserService_Expecter) Exec(ctx interface{}, uid interface{}) *MockGetUserService_Exec_Call {
-	return &MockGetUserService_Exec_Call{Call: _e.mock.On("Exec", ctx, uid)}
+func (_e *MockGetUserService_Expecter) Exec(ctx interface{}, tenantID interface{}, uid interface{}) *MockGetUserService_Exec_Call {
+	return &MockGetUserService_Exec_Call{Call: _e.mock.On("Exec", ctx, tenantID, uid)}
 }
 
-func (_c *MockGetUserService_Exec_Call) Run(run func(ctx context.Context, uid string)) *MockGetUserService_Exec_Call {
+func (_c *MockGetUserService_Exec_Call) Run(run func(ctx context.Context, tenantID string, uid string)) *MockGetUserService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -76,7 +77,7 @@ func (_c *MockGetUserService_Exec_Call) Return(_a0 *models.User, _a1 error) *Moc
 	return _c
 }
 
-func (_c *MockGetUserService_Exec_Call) RunAndReturn(run func(context.Context, string) (*models.User, error)) *MockGetUserService_Exec_Call {
+func (_c *MockGetUserService_Exec_Call) RunAndReturn(run func(context.Context, string, string) (*models.User, error)) *MockGetUserService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockImpersonateService is an autogenerated mock type for the ImpersonateService type
+type MockImpersonateService struct {
+	mock.Mock
+}
+
+type MockImpersonateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImpersonateService) EXPECT() *MockImpersonateService_Expecter {
+	return &MockImpersonateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockImpersonateService) Exec(ctx context.Context, token string, data *models.Impersonate) (*models.StartedImpersonationSession, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.StartedImpersonationSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.Impersonate) (*models.StartedImpersonationSession, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.Impersonate) *models.StartedImpersonationSession); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StartedImpersonationSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.Impersonate) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockImpersonateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockImpersonateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.Impersonate
+func (_e *MockImpersonateService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockImpersonateService_Exec_Call {
+	return &MockImpersonateService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockImpersonateService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.Impersonate)) *MockImpersonateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.Impersonate))
+	})
+	return _c
+}
+
+func (_c *MockImpersonateService_Exec_Call) Return(_a0 *models.StartedImpersonationSession, _a1 error) *MockImpersonateService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockImpersonateService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.Impersonate) (*models.StartedImpersonationSession, error)) *MockImpersonateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockImpersonateService creates a new instance of MockImpersonateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImpersonateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImpersonateService {
+	mock := &MockImpersonateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockInviteOrganizationMemberService is an autogenerated mock type for the InviteOrganizationMemberService type
+type MockInviteOrganizationMemberService struct {
+	mock.Mock
+}
+
+type MockInviteOrganizationMemberService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInviteOrganizationMemberService) EXPECT() *MockInviteOrganizationMemberService_Expecter {
+	return &MockInviteOrganizationMemberService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockInviteOrganizationMemberService) Exec(ctx context.Context, token string, data *models.InviteOrganizationMember) (*models.Invitation, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.InviteOrganizationMember) (*models.Invitation, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.InviteOrganizationMember) *models.Invitation); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.InviteOrganizationMember) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInviteOrganizationMemberService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockInviteOrganizationMemberService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.InviteOrganizationMember
+func (_e *MockInviteOrganizationMemberService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockInviteOrganizationMemberService_Exec_Call {
+	return &MockInviteOrganizationMemberService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockInviteOrganizationMemberService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.InviteOrganizationMember)) *MockInviteOrganizationMemberService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.InviteOrganizationMember))
+	})
+	return _c
+}
+
+func (_c *MockInviteOrganizationMemberService_Exec_Call) Return(_a0 *models.Invitation, _a1 error) *MockInviteOrganizationMemberService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInviteOrganizationMemberService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.InviteOrganizationMember) (*models.Invitation, error)) *MockInviteOrganizationMemberService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInviteOrganizationMemberService creates a new instance of MockInviteOrganizationMemberService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInviteOrganizationMemberService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInviteOrganizationMemberService {
+	mock := &MockInviteOrganizationMemberService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListInvitationsService is an autogenerated mock type for the ListInvitationsService type
+type MockListInvitationsService struct {
+	mock.Mock
+}
+
+type MockListInvitationsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListInvitationsService) EXPECT() *MockListInvitationsService_Expecter {
+	return &MockListInvitationsService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, organizationID
+func (_m *MockListInvitationsService) Exec(ctx context.Context, token string, organizationID string) ([]*models.Invitation, error) {
+	ret := _m.Called(ctx, token, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*models.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*models.Invitation, error)); ok {
+		return rf(ctx, token, organizationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*models.Invitation); ok {
+		r0 = rf(ctx, token, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListInvitationsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockListInvitationsService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - organizationID string
+func (_e *MockListInvitationsService_Expecter) Exec(ctx interface{}, token interface{}, organizationID interface{}) *MockListInvitationsService_Exec_Call {
+	return &MockListInvitationsService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, organizationID)}
+}
+
+func (_c *MockListInvitationsService_Exec_Call) Run(run func(ctx context.Context, token string, organizationID string)) *MockListInvitationsService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockListInvitationsService_Exec_Call) Return(_a0 []*models.Invitation, _a1 error) *MockListInvitationsService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListInvitationsService_Exec_Call) RunAndReturn(run func(context.Context, string, string) ([]*models.Invitation, error)) *MockListInvitationsService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListInvitationsService creates a new instance of MockListInvitationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListInvitationsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListInvitationsService {
+	mock := &MockListInvitationsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
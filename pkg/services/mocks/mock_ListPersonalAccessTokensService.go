@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListPersonalAccessTokensService is an autogenerated mock type for the ListPersonalAccessTokensService type
+type MockListPersonalAccessTokensService struct {
+	mock.Mock
+}
+
+type MockListPersonalAccessTokensService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListPersonalAccessTokensService) EXPECT() *MockListPersonalAccessTokensService_Expecter {
+	return &MockListPersonalAccessTokensService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token
+func (_m *MockListPersonalAccessTokensService) Exec(ctx context.Context, token string) ([]*models.PersonalAccessToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*models.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.PersonalAccessToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.PersonalAccessToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListPersonalAccessTokensService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockListPersonalAccessTokensService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockListPersonalAccessTokensService_Expecter) Exec(ctx interface{}, token interface{}) *MockListPersonalAccessTokensService_Exec_Call {
+	return &MockListPersonalAccessTokensService_Exec_Call{Call: _e.mock.On("Exec", ctx, token)}
+}
+
+func (_c *MockListPersonalAccessTokensService_Exec_Call) Run(run func(ctx context.Context, token string)) *MockListPersonalAccessTokensService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockListPersonalAccessTokensService_Exec_Call) Return(_a0 []*models.PersonalAccessToken, _a1 error) *MockListPersonalAccessTokensService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListPersonalAccessTokensService_Exec_Call) RunAndReturn(run func(context.Context, string) ([]*models.PersonalAccessToken, error)) *MockListPersonalAccessTokensService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListPersonalAccessTokensService creates a new instance of MockListPersonalAccessTokensService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListPersonalAccessTokensService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListPersonalAccessTokensService {
+	mock := &MockListPersonalAccessTokensService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,9 +22,9 @@ func (_m *MockListUsersService) EXPECT() *MockListUsersService_Expecter {
 	return &MockListUsersService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function with given fields: ctx, uids
-func (_m *MockListUsersService) Exec(ctx context.Context, uids []string) ([]*models.User, error) {
-	ret := _m.Called(ctx, uids)
+// Exec provides a mock function with given fields: ctx, tenantID, uids
+func (_m *MockListUsersService) Exec(ctx context.Context, tenantID string, uids []string) ([]*models.User, error) {
+	ret := _m.Called(ctx, tenantID, uids)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
@@ -32,19 +32,19 @@ func (_m *MockListUsersService) Exec(ctx context.Context, uids []string) ([]*mod
 
 	var r0 []*models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*models.User, error)); ok {
-		return rf(ctx, uids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*models.User, error)); ok {
+		return rf(ctx, tenantID, uids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []*models.User); ok {
-		r0 = rf(ctx, uids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*models.User); ok {
+		r0 = rf(ctx, tenantID, uids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, uids)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, uids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,14 +59,15 @@ type MockListUsersService_Exec_Call struct {
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tenantID string
 //   - uids []string
-func (_e *MockListUsersService_Expecter) Exec(ctx interface{}, uids interface{}) *MockListUsersService_Exec_Call {
-	return &MockListUsersService_Exec_Call{Call: _e.mock.On("Exec", ctx, uids)}
+func (_e *MockListUsersService_Expecter) Exec(ctx interface{}, tenantID interface{}, uids interface{}) *MockListUsersService_Exec_Call {
+	return &MockListUsersService_Exec_Call{Call: _e.mock.On("Exec", ctx, tenantID, uids)}
 }
 
-func (_c *MockListUsersService_Exec_Call) Run(run func(ctx context.Context, uids []string)) *MockListUsersService_Exec_Call {
+func (_c *MockListUsersService_Exec_Call) Run(run func(ctx context.Context, tenantID string, uids []string)) *MockListUsersService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
 	})
 	return _c
 }
@@ -76,7 +77,7 @@ func (_c *MockListUsersService_Exec_Call) Return(_a0 []*models.User, _a1 error)
 	return _c
 }
 
-func (_c *MockListUsersService_Exec_Call) RunAndReturn(run func(context.Context, []string) ([]*models.User, error)) *MockListUsersService_Exec_Call {
+func (_c *MockListUsersService_Exec_Call) RunAndReturn(run func(context.Context, string, []string) ([]*models.User, error)) *MockListUsersService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
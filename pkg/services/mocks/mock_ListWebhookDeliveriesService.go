@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListWebhookDeliveriesService is an autogenerated mock type for the ListWebhookDeliveriesService type
+type MockListWebhookDeliveriesService struct {
+	mock.Mock
+}
+
+type MockListWebhookDeliveriesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListWebhookDeliveriesService) EXPECT() *MockListWebhookDeliveriesService_Expecter {
+	return &MockListWebhookDeliveriesService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockListWebhookDeliveriesService) Exec(ctx context.Context, data *models.ListWebhookDeliveries) ([]*models.WebhookDelivery, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ListWebhookDeliveries) ([]*models.WebhookDelivery, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ListWebhookDeliveries) []*models.WebhookDelivery); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ListWebhookDeliveries) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListWebhookDeliveriesService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockListWebhookDeliveriesService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.ListWebhookDeliveries
+func (_e *MockListWebhookDeliveriesService_Expecter) Exec(ctx interface{}, data interface{}) *MockListWebhookDeliveriesService_Exec_Call {
+	return &MockListWebhookDeliveriesService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockListWebhookDeliveriesService_Exec_Call) Run(run func(ctx context.Context, data *models.ListWebhookDeliveries)) *MockListWebhookDeliveriesService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ListWebhookDeliveries))
+	})
+	return _c
+}
+
+func (_c *MockListWebhookDeliveriesService_Exec_Call) Return(_a0 []*models.WebhookDelivery, _a1 error) *MockListWebhookDeliveriesService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListWebhookDeliveriesService_Exec_Call) RunAndReturn(run func(context.Context, *models.ListWebhookDeliveries) ([]*models.WebhookDelivery, error)) *MockListWebhookDeliveriesService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListWebhookDeliveriesService creates a new instance of MockListWebhookDeliveriesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListWebhookDeliveriesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListWebhookDeliveriesService {
+	mock := &MockListWebhookDeliveriesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
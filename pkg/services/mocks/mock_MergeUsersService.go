@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMergeUsersService is an autogenerated mock type for the MergeUsersService type
+type MockMergeUsersService struct {
+	mock.Mock
+}
+
+type MockMergeUsersService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMergeUsersService) EXPECT() *MockMergeUsersService_Expecter {
+	return &MockMergeUsersService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockMergeUsersService) Exec(ctx context.Context, token string, data *models.MergeUsers) (*models.UsersMerge, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.UsersMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.MergeUsers) (*models.UsersMerge, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.MergeUsers) *models.UsersMerge); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UsersMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.MergeUsers) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeUsersService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockMergeUsersService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.MergeUsers
+func (_e *MockMergeUsersService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockMergeUsersService_Exec_Call {
+	return &MockMergeUsersService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockMergeUsersService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.MergeUsers)) *MockMergeUsersService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.MergeUsers))
+	})
+	return _c
+}
+
+func (_c *MockMergeUsersService_Exec_Call) Return(_a0 *models.UsersMerge, _a1 error) *MockMergeUsersService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeUsersService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.MergeUsers) (*models.UsersMerge, error)) *MockMergeUsersService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMergeUsersService creates a new instance of MockMergeUsersService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMergeUsersService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMergeUsersService {
+	mock := &MockMergeUsersService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
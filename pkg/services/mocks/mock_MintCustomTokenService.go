@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMintCustomTokenService is an autogenerated mock type for the MintCustomTokenService type
+type MockMintCustomTokenService struct {
+	mock.Mock
+}
+
+type MockMintCustomTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMintCustomTokenService) EXPECT() *MockMintCustomTokenService_Expecter {
+	return &MockMintCustomTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockMintCustomTokenService) Exec(ctx context.Context, data *models.MintCustomToken) (*models.MintedCustomToken, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.MintedCustomToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.MintCustomToken) (*models.MintedCustomToken, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.MintCustomToken) *models.MintedCustomToken); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MintedCustomToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.MintCustomToken) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintCustomTokenService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockMintCustomTokenService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.MintCustomToken
+func (_e *MockMintCustomTokenService_Expecter) Exec(ctx interface{}, data interface{}) *MockMintCustomTokenService_Exec_Call {
+	return &MockMintCustomTokenService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockMintCustomTokenService_Exec_Call) Run(run func(ctx context.Context, data *models.MintCustomToken)) *MockMintCustomTokenService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.MintCustomToken))
+	})
+	return _c
+}
+
+func (_c *MockMintCustomTokenService_Exec_Call) Return(_a0 *models.MintedCustomToken, _a1 error) *MockMintCustomTokenService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintCustomTokenService_Exec_Call) RunAndReturn(run func(context.Context, *models.MintCustomToken) (*models.MintedCustomToken, error)) *MockMintCustomTokenService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMintCustomTokenService creates a new instance of MockMintCustomTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMintCustomTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMintCustomTokenService {
+	mock := &MockMintCustomTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
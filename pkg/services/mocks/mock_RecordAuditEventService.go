@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRecordAuditEventService is an autogenerated mock type for the RecordAuditEventService type
+type MockRecordAuditEventService struct {
+	mock.Mock
+}
+
+type MockRecordAuditEventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordAuditEventService) EXPECT() *MockRecordAuditEventService_Expecter {
+	return &MockRecordAuditEventService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockRecordAuditEventService) Exec(ctx context.Context, data *models.RecordAuditEvent) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.RecordAuditEvent) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRecordAuditEventService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRecordAuditEventService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.RecordAuditEvent
+func (_e *MockRecordAuditEventService_Expecter) Exec(ctx interface{}, data interface{}) *MockRecordAuditEventService_Exec_Call {
+	return &MockRecordAuditEventService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockRecordAuditEventService_Exec_Call) Run(run func(ctx context.Context, data *models.RecordAuditEvent)) *MockRecordAuditEventService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.RecordAuditEvent))
+	})
+	return _c
+}
+
+func (_c *MockRecordAuditEventService_Exec_Call) Return(_a0 error) *MockRecordAuditEventService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRecordAuditEventService_Exec_Call) RunAndReturn(run func(context.Context, *models.RecordAuditEvent) error) *MockRecordAuditEventService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordAuditEventService creates a new instance of MockRecordAuditEventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordAuditEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordAuditEventService {
+	mock := &MockRecordAuditEventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRemoveOrganizationMemberService is an autogenerated mock type for the RemoveOrganizationMemberService type
+type MockRemoveOrganizationMemberService struct {
+	mock.Mock
+}
+
+type MockRemoveOrganizationMemberService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRemoveOrganizationMemberService) EXPECT() *MockRemoveOrganizationMemberService_Expecter {
+	return &MockRemoveOrganizationMemberService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockRemoveOrganizationMemberService) Exec(ctx context.Context, token string, data *models.RemoveOrganizationMember) error {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.RemoveOrganizationMember) error); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRemoveOrganizationMemberService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRemoveOrganizationMemberService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.RemoveOrganizationMember
+func (_e *MockRemoveOrganizationMemberService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockRemoveOrganizationMemberService_Exec_Call {
+	return &MockRemoveOrganizationMemberService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockRemoveOrganizationMemberService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.RemoveOrganizationMember)) *MockRemoveOrganizationMemberService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.RemoveOrganizationMember))
+	})
+	return _c
+}
+
+func (_c *MockRemoveOrganizationMemberService_Exec_Call) Return(_a0 error) *MockRemoveOrganizationMemberService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRemoveOrganizationMemberService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.RemoveOrganizationMember) error) *MockRemoveOrganizationMemberService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRemoveOrganizationMemberService creates a new instance of MockRemoveOrganizationMemberService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRemoveOrganizationMemberService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRemoveOrganizationMemberService {
+	mock := &MockRemoveOrganizationMemberService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
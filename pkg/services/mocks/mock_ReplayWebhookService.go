@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReplayWebhookService is an autogenerated mock type for the ReplayWebhookService type
+type MockReplayWebhookService struct {
+	mock.Mock
+}
+
+type MockReplayWebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReplayWebhookService) EXPECT() *MockReplayWebhookService_Expecter {
+	return &MockReplayWebhookService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, deliveryID
+func (_m *MockReplayWebhookService) Exec(ctx context.Context, deliveryID string) (*models.WebhookDelivery, error) {
+	ret := _m.Called(ctx, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.WebhookDelivery, error)); ok {
+		return rf(ctx, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.WebhookDelivery); ok {
+		r0 = rf(ctx, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReplayWebhookService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockReplayWebhookService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryID string
+func (_e *MockReplayWebhookService_Expecter) Exec(ctx interface{}, deliveryID interface{}) *MockReplayWebhookService_Exec_Call {
+	return &MockReplayWebhookService_Exec_Call{Call: _e.mock.On("Exec", ctx, deliveryID)}
+}
+
+func (_c *MockReplayWebhookService_Exec_Call) Run(run func(ctx context.Context, deliveryID string)) *MockReplayWebhookService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockReplayWebhookService_Exec_Call) Return(_a0 *models.WebhookDelivery, _a1 error) *MockReplayWebhookService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReplayWebhookService_Exec_Call) RunAndReturn(run func(context.Context, string) (*models.WebhookDelivery, error)) *MockReplayWebhookService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReplayWebhookService creates a new instance of MockReplayWebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReplayWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReplayWebhookService {
+	mock := &MockReplayWebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockResendInvitationService is an autogenerated mock type for the ResendInvitationService type
+type MockResendInvitationService struct {
+	mock.Mock
+}
+
+type MockResendInvitationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockResendInvitationService) EXPECT() *MockResendInvitationService_Expecter {
+	return &MockResendInvitationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockResendInvitationService) Exec(ctx context.Context, token string, data *models.ResendInvitation) (*models.Invitation, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.ResendInvitation) (*models.Invitation, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.ResendInvitation) *models.Invitation); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.ResendInvitation) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockResendInvitationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockResendInvitationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.ResendInvitation
+func (_e *MockResendInvitationService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockResendInvitationService_Exec_Call {
+	return &MockResendInvitationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockResendInvitationService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.ResendInvitation)) *MockResendInvitationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.ResendInvitation))
+	})
+	return _c
+}
+
+func (_c *MockResendInvitationService_Exec_Call) Return(_a0 *models.Invitation, _a1 error) *MockResendInvitationService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockResendInvitationService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.ResendInvitation) (*models.Invitation, error)) *MockResendInvitationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockResendInvitationService creates a new instance of MockResendInvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockResendInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockResendInvitationService {
+	mock := &MockResendInvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRevokeInvitationService is an autogenerated mock type for the RevokeInvitationService type
+type MockRevokeInvitationService struct {
+	mock.Mock
+}
+
+type MockRevokeInvitationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevokeInvitationService) EXPECT() *MockRevokeInvitationService_Expecter {
+	return &MockRevokeInvitationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, organizationID, invitationID
+func (_m *MockRevokeInvitationService) Exec(ctx context.Context, token string, organizationID string, invitationID string) error {
+	ret := _m.Called(ctx, token, organizationID, invitationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, organizationID, invitationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRevokeInvitationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRevokeInvitationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - organizationID string
+//   - invitationID string
+func (_e *MockRevokeInvitationService_Expecter) Exec(ctx interface{}, token interface{}, organizationID interface{}, invitationID interface{}) *MockRevokeInvitationService_Exec_Call {
+	return &MockRevokeInvitationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, organizationID, invitationID)}
+}
+
+func (_c *MockRevokeInvitationService_Exec_Call) Run(run func(ctx context.Context, token string, organizationID string, invitationID string)) *MockRevokeInvitationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockRevokeInvitationService_Exec_Call) Return(_a0 error) *MockRevokeInvitationService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRevokeInvitationService_Exec_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockRevokeInvitationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevokeInvitationService creates a new instance of MockRevokeInvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevokeInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevokeInvitationService {
+	mock := &MockRevokeInvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRevokePersonalAccessTokenService is an autogenerated mock type for the RevokePersonalAccessTokenService type
+type MockRevokePersonalAccessTokenService struct {
+	mock.Mock
+}
+
+type MockRevokePersonalAccessTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevokePersonalAccessTokenService) EXPECT() *MockRevokePersonalAccessTokenService_Expecter {
+	return &MockRevokePersonalAccessTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, id
+func (_m *MockRevokePersonalAccessTokenService) Exec(ctx context.Context, token string, id string) error {
+	ret := _m.Called(ctx, token, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRevokePersonalAccessTokenService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRevokePersonalAccessTokenService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - id string
+func (_e *MockRevokePersonalAccessTokenService_Expecter) Exec(ctx interface{}, token interface{}, id interface{}) *MockRevokePersonalAccessTokenService_Exec_Call {
+	return &MockRevokePersonalAccessTokenService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, id)}
+}
+
+func (_c *MockRevokePersonalAccessTokenService_Exec_Call) Run(run func(ctx context.Context, token string, id string)) *MockRevokePersonalAccessTokenService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRevokePersonalAccessTokenService_Exec_Call) Return(_a0 error) *MockRevokePersonalAccessTokenService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRevokePersonalAccessTokenService_Exec_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRevokePersonalAccessTokenService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevokePersonalAccessTokenService creates a new instance of MockRevokePersonalAccessTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevokePersonalAccessTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevokePersonalAccessTokenService {
+	mock := &MockRevokePersonalAccessTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSendEmailVerificationService is an autogenerated mock type for the SendEmailVerificationService type
+type MockSendEmailVerificationService struct {
+	mock.Mock
+}
+
+type MockSendEmailVerificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSendEmailVerificationService) EXPECT() *MockSendEmailVerificationService_Expecter {
+	return &MockSendEmailVerificationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, locale
+func (_m *MockSendEmailVerificationService) Exec(ctx context.Context, token string, locale string) error {
+	ret := _m.Called(ctx, token, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendEmailVerificationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSendEmailVerificationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - locale string
+func (_e *MockSendEmailVerificationService_Expecter) Exec(ctx interface{}, token interface{}, locale interface{}) *MockSendEmailVerificationService_Exec_Call {
+	return &MockSendEmailVerificationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, locale)}
+}
+
+func (_c *MockSendEmailVerificationService_Exec_Call) Run(run func(ctx context.Context, token string, locale string)) *MockSendEmailVerificationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSendEmailVerificationService_Exec_Call) Return(_a0 error) *MockSendEmailVerificationService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendEmailVerificationService_Exec_Call) RunAndReturn(run func(context.Context, string, string) error) *MockSendEmailVerificationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSendEmailVerificationService creates a new instance of MockSendEmailVerificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSendEmailVerificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSendEmailVerificationService {
+	mock := &MockSendEmailVerificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSendPasswordResetService is an autogenerated mock type for the SendPasswordResetService type
+type MockSendPasswordResetService struct {
+	mock.Mock
+}
+
+type MockSendPasswordResetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSendPasswordResetService) EXPECT() *MockSendPasswordResetService_Expecter {
+	return &MockSendPasswordResetService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, tenantID, email, locale
+func (_m *MockSendPasswordResetService) Exec(ctx context.Context, tenantID string, email string, locale string) error {
+	ret := _m.Called(ctx, tenantID, email, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, tenantID, email, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendPasswordResetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSendPasswordResetService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenantID string
+//   - email string
+//   - locale string
+func (_e *MockSendPasswordResetService_Expecter) Exec(ctx interface{}, tenantID interface{}, email interface{}, locale interface{}) *MockSendPasswordResetService_Exec_Call {
+	return &MockSendPasswordResetService_Exec_Call{Call: _e.mock.On("Exec", ctx, tenantID, email, locale)}
+}
+
+func (_c *MockSendPasswordResetService_Exec_Call) Run(run func(ctx context.Context, tenantID string, email string, locale string)) *MockSendPasswordResetService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSendPasswordResetService_Exec_Call) Return(_a0 error) *MockSendPasswordResetService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendPasswordResetService_Exec_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockSendPasswordResetService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSendPasswordResetService creates a new instance of MockSendPasswordResetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSendPasswordResetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSendPasswordResetService {
+	mock := &MockSendPasswordResetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
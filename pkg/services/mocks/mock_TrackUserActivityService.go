@@ -0,0 +1,71 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTrackUserActivityService is an autogenerated mock type for the TrackUserActivityService type
+type MockTrackUserActivityService struct {
+	mock.Mock
+}
+
+type MockTrackUserActivityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTrackUserActivityService) EXPECT() *MockTrackUserActivityService_Expecter {
+	return &MockTrackUserActivityService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, data
+func (_m *MockTrackUserActivityService) Exec(ctx context.Context, data *models.TrackUserActivity) {
+	_m.Called(ctx, data)
+}
+
+// MockTrackUserActivityService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTrackUserActivityService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.TrackUserActivity
+func (_e *MockTrackUserActivityService_Expecter) Exec(ctx interface{}, data interface{}) *MockTrackUserActivityService_Exec_Call {
+	return &MockTrackUserActivityService_Exec_Call{Call: _e.mock.On("Exec", ctx, data)}
+}
+
+func (_c *MockTrackUserActivityService_Exec_Call) Run(run func(ctx context.Context, data *models.TrackUserActivity)) *MockTrackUserActivityService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.TrackUserActivity))
+	})
+	return _c
+}
+
+func (_c *MockTrackUserActivityService_Exec_Call) Return() *MockTrackUserActivityService_Exec_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTrackUserActivityService_Exec_Call) RunAndReturn(run func(context.Context, *models.TrackUserActivity)) *MockTrackUserActivityService_Exec_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockTrackUserActivityService creates a new instance of MockTrackUserActivityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTrackUserActivityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTrackUserActivityService {
+	mock := &MockTrackUserActivityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-authentication/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpdateOrganizationService is an autogenerated mock type for the UpdateOrganizationService type
+type MockUpdateOrganizationService struct {
+	mock.Mock
+}
+
+type MockUpdateOrganizationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateOrganizationService) EXPECT() *MockUpdateOrganizationService_Expecter {
+	return &MockUpdateOrganizationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, token, data
+func (_m *MockUpdateOrganizationService) Exec(ctx context.Context, token string, data *models.UpdateOrganization) (*models.Organization, error) {
+	ret := _m.Called(ctx, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateOrganization) (*models.Organization, error)); ok {
+		return rf(ctx, token, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.UpdateOrganization) *models.Organization); ok {
+		r0 = rf(ctx, token, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.UpdateOrganization) error); ok {
+		r1 = rf(ctx, token, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateOrganizationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockUpdateOrganizationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - data *models.UpdateOrganization
+func (_e *MockUpdateOrganizationService_Expecter) Exec(ctx interface{}, token interface{}, data interface{}) *MockUpdateOrganizationService_Exec_Call {
+	return &MockUpdateOrganizationService_Exec_Call{Call: _e.mock.On("Exec", ctx, token, data)}
+}
+
+func (_c *MockUpdateOrganizationService_Exec_Call) Run(run func(ctx context.Context, token string, data *models.UpdateOrganization)) *MockUpdateOrganizationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.UpdateOrganization))
+	})
+	return _c
+}
+
+func (_c *MockUpdateOrganizationService_Exec_Call) Return(_a0 *models.Organization, _a1 error) *MockUpdateOrganizationService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateOrganizationService_Exec_Call) RunAndReturn(run func(context.Context, string, *models.UpdateOrganization) (*models.Organization, error)) *MockUpdateOrganizationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateOrganizationService creates a new instance of MockUpdateOrganizationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateOrganizationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateOrganizationService {
+	mock := &MockUpdateOrganizationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
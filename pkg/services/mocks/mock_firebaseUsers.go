@@ -0,0 +1,543 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	auth "firebase.google.com/go/v4/auth"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockfirebaseUsers is an autogenerated mock type for the firebaseUsers type
+type MockfirebaseUsers struct {
+	mock.Mock
+}
+
+type MockfirebaseUsers_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockfirebaseUsers) EXPECT() *MockfirebaseUsers_Expecter {
+	return &MockfirebaseUsers_Expecter{mock: &_m.Mock}
+}
+
+// CustomTokenWithClaims provides a mock function with given fields: ctx, uid, devClaims
+func (_m *MockfirebaseUsers) CustomTokenWithClaims(ctx context.Context, uid string, devClaims map[string]interface{}) (string, error) {
+	ret := _m.Called(ctx, uid, devClaims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CustomTokenWithClaims")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) (string, error)); ok {
+		return rf(ctx, uid, devClaims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) string); ok {
+		r0 = rf(ctx, uid, devClaims)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}) error); ok {
+		r1 = rf(ctx, uid, devClaims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_CustomTokenWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CustomTokenWithClaims'
+type MockfirebaseUsers_CustomTokenWithClaims_Call struct {
+	*mock.Call
+}
+
+// CustomTokenWithClaims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid string
+//   - devClaims map[string]interface{}
+func (_e *MockfirebaseUsers_Expecter) CustomTokenWithClaims(ctx interface{}, uid interface{}, devClaims interface{}) *MockfirebaseUsers_CustomTokenWithClaims_Call {
+	return &MockfirebaseUsers_CustomTokenWithClaims_Call{Call: _e.mock.On("CustomTokenWithClaims", ctx, uid, devClaims)}
+}
+
+func (_c *MockfirebaseUsers_CustomTokenWithClaims_Call) Run(run func(ctx context.Context, uid string, devClaims map[string]interface{})) *MockfirebaseUsers_CustomTokenWithClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_CustomTokenWithClaims_Call) Return(_a0 string, _a1 error) *MockfirebaseUsers_CustomTokenWithClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_CustomTokenWithClaims_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) (string, error)) *MockfirebaseUsers_CustomTokenWithClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, uid
+func (_m *MockfirebaseUsers) DeleteUser(ctx context.Context, uid string) error {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockfirebaseUsers_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockfirebaseUsers_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid string
+func (_e *MockfirebaseUsers_Expecter) DeleteUser(ctx interface{}, uid interface{}) *MockfirebaseUsers_DeleteUser_Call {
+	return &MockfirebaseUsers_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, uid)}
+}
+
+func (_c *MockfirebaseUsers_DeleteUser_Call) Run(run func(ctx context.Context, uid string)) *MockfirebaseUsers_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_DeleteUser_Call) Return(_a0 error) *MockfirebaseUsers_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_DeleteUser_Call) RunAndReturn(run func(context.Context, string) error) *MockfirebaseUsers_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmailVerificationLink provides a mock function with given fields: ctx, email
+func (_m *MockfirebaseUsers) EmailVerificationLink(ctx context.Context, email string) (string, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmailVerificationLink")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_EmailVerificationLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailVerificationLink'
+type MockfirebaseUsers_EmailVerificationLink_Call struct {
+	*mock.Call
+}
+
+// EmailVerificationLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockfirebaseUsers_Expecter) EmailVerificationLink(ctx interface{}, email interface{}) *MockfirebaseUsers_EmailVerificationLink_Call {
+	return &MockfirebaseUsers_EmailVerificationLink_Call{Call: _e.mock.On("EmailVerificationLink", ctx, email)}
+}
+
+func (_c *MockfirebaseUsers_EmailVerificationLink_Call) Run(run func(ctx context.Context, email string)) *MockfirebaseUsers_EmailVerificationLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_EmailVerificationLink_Call) Return(_a0 string, _a1 error) *MockfirebaseUsers_EmailVerificationLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_EmailVerificationLink_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockfirebaseUsers_EmailVerificationLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, uid
+func (_m *MockfirebaseUsers) GetUser(ctx context.Context, uid string) (*auth.UserRecord, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *auth.UserRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.UserRecord, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.UserRecord); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockfirebaseUsers_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid string
+func (_e *MockfirebaseUsers_Expecter) GetUser(ctx interface{}, uid interface{}) *MockfirebaseUsers_GetUser_Call {
+	return &MockfirebaseUsers_GetUser_Call{Call: _e.mock.On("GetUser", ctx, uid)}
+}
+
+func (_c *MockfirebaseUsers_GetUser_Call) Run(run func(ctx context.Context, uid string)) *MockfirebaseUsers_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_GetUser_Call) Return(_a0 *auth.UserRecord, _a1 error) *MockfirebaseUsers_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_GetUser_Call) RunAndReturn(run func(context.Context, string) (*auth.UserRecord, error)) *MockfirebaseUsers_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockfirebaseUsers) GetUserByEmail(ctx context.Context, email string) (*auth.UserRecord, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 *auth.UserRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.UserRecord, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.UserRecord); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockfirebaseUsers_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockfirebaseUsers_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockfirebaseUsers_GetUserByEmail_Call {
+	return &MockfirebaseUsers_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockfirebaseUsers_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockfirebaseUsers_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_GetUserByEmail_Call) Return(_a0 *auth.UserRecord, _a1 error) *MockfirebaseUsers_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (*auth.UserRecord, error)) *MockfirebaseUsers_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx, identifiers
+func (_m *MockfirebaseUsers) GetUsers(ctx context.Context, identifiers []auth.UserIdentifier) (*auth.GetUsersResult, error) {
+	ret := _m.Called(ctx, identifiers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 *auth.GetUsersResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []auth.UserIdentifier) (*auth.GetUsersResult, error)); ok {
+		return rf(ctx, identifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []auth.UserIdentifier) *auth.GetUsersResult); ok {
+		r0 = rf(ctx, identifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.GetUsersResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []auth.UserIdentifier) error); ok {
+		r1 = rf(ctx, identifiers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type MockfirebaseUsers_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identifiers []auth.UserIdentifier
+func (_e *MockfirebaseUsers_Expecter) GetUsers(ctx interface{}, identifiers interface{}) *MockfirebaseUsers_GetUsers_Call {
+	return &MockfirebaseUsers_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, identifiers)}
+}
+
+func (_c *MockfirebaseUsers_GetUsers_Call) Run(run func(ctx context.Context, identifiers []auth.UserIdentifier)) *MockfirebaseUsers_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]auth.UserIdentifier))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_GetUsers_Call) Return(_a0 *auth.GetUsersResult, _a1 error) *MockfirebaseUsers_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_GetUsers_Call) RunAndReturn(run func(context.Context, []auth.UserIdentifier) (*auth.GetUsersResult, error)) *MockfirebaseUsers_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PasswordResetLink provides a mock function with given fields: ctx, email
+func (_m *MockfirebaseUsers) PasswordResetLink(ctx context.Context, email string) (string, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PasswordResetLink")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_PasswordResetLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PasswordResetLink'
+type MockfirebaseUsers_PasswordResetLink_Call struct {
+	*mock.Call
+}
+
+// PasswordResetLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockfirebaseUsers_Expecter) PasswordResetLink(ctx interface{}, email interface{}) *MockfirebaseUsers_PasswordResetLink_Call {
+	return &MockfirebaseUsers_PasswordResetLink_Call{Call: _e.mock.On("PasswordResetLink", ctx, email)}
+}
+
+func (_c *MockfirebaseUsers_PasswordResetLink_Call) Run(run func(ctx context.Context, email string)) *MockfirebaseUsers_PasswordResetLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_PasswordResetLink_Call) Return(_a0 string, _a1 error) *MockfirebaseUsers_PasswordResetLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_PasswordResetLink_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockfirebaseUsers_PasswordResetLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, uid, user
+func (_m *MockfirebaseUsers) UpdateUser(ctx context.Context, uid string, user *auth.UserToUpdate) (*auth.UserRecord, error) {
+	ret := _m.Called(ctx, uid, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 *auth.UserRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *auth.UserToUpdate) (*auth.UserRecord, error)); ok {
+		return rf(ctx, uid, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *auth.UserToUpdate) *auth.UserRecord); ok {
+		r0 = rf(ctx, uid, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *auth.UserToUpdate) error); ok {
+		r1 = rf(ctx, uid, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockfirebaseUsers_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockfirebaseUsers_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid string
+//   - user *auth.UserToUpdate
+func (_e *MockfirebaseUsers_Expecter) UpdateUser(ctx interface{}, uid interface{}, user interface{}) *MockfirebaseUsers_UpdateUser_Call {
+	return &MockfirebaseUsers_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, uid, user)}
+}
+
+func (_c *MockfirebaseUsers_UpdateUser_Call) Run(run func(ctx context.Context, uid string, user *auth.UserToUpdate)) *MockfirebaseUsers_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*auth.UserToUpdate))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_UpdateUser_Call) Return(_a0 *auth.UserRecord, _a1 error) *MockfirebaseUsers_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_UpdateUser_Call) RunAndReturn(run func(context.Context, string, *auth.UserToUpdate) (*auth.UserRecord, error)) *MockfirebaseUsers_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Users provides a mock function with given fields: ctx, nextPageToken
+func (_m *MockfirebaseUsers) Users(ctx context.Context, nextPageToken string) *auth.UserIterator {
+	ret := _m.Called(ctx, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Users")
+	}
+
+	var r0 *auth.UserIterator
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.UserIterator); ok {
+		r0 = rf(ctx, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserIterator)
+		}
+	}
+
+	return r0
+}
+
+// MockfirebaseUsers_Users_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Users'
+type MockfirebaseUsers_Users_Call struct {
+	*mock.Call
+}
+
+// Users is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nextPageToken string
+func (_e *MockfirebaseUsers_Expecter) Users(ctx interface{}, nextPageToken interface{}) *MockfirebaseUsers_Users_Call {
+	return &MockfirebaseUsers_Users_Call{Call: _e.mock.On("Users", ctx, nextPageToken)}
+}
+
+func (_c *MockfirebaseUsers_Users_Call) Run(run func(ctx context.Context, nextPageToken string)) *MockfirebaseUsers_Users_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockfirebaseUsers_Users_Call) Return(_a0 *auth.UserIterator) *MockfirebaseUsers_Users_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockfirebaseUsers_Users_Call) RunAndReturn(run func(context.Context, string) *auth.UserIterator) *MockfirebaseUsers_Users_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockfirebaseUsers creates a new instance of MockfirebaseUsers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockfirebaseUsers(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockfirebaseUsers {
+	mock := &MockfirebaseUsers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+// membershipRoleRanks orders roles by privilege. A member can only manage members with a lower rank, except owners
+// who can also manage other owners.
+var membershipRoleRanks = map[entities.MembershipRole]int{
+	entities.MembershipRoleMember: 1,
+	entities.MembershipRoleAdmin:  2,
+	entities.MembershipRoleOwner:  3,
+}
+
+func canManageMembers(role entities.MembershipRole) bool {
+	return membershipRoleRanks[role] >= membershipRoleRanks[entities.MembershipRoleAdmin]
+}
+
+func outranks(role, other entities.MembershipRole) bool {
+	return role == entities.MembershipRoleOwner || membershipRoleRanks[role] > membershipRoleRanks[other]
+}
+
+// getManagerMembership returns the membership of a user allowed to manage the members of the organization.
+func getManagerMembership(
+	ctx context.Context, repository dao.GetMembershipRepository, organizationID uuid.UUID, firebaseUID string,
+) (*entities.Membership, error) {
+	membership, err := repository.GetMembership(ctx, organizationID, firebaseUID)
+	if err != nil {
+		if errors.Is(err, dao.ErrMembershipNotFound) {
+			return nil, ErrNotOrganizationMember
+		}
+
+		return nil, err
+	}
+
+	if !canManageMembers(membership.Role) {
+		return nil, ErrInsufficientOrganizationRole
+	}
+
+	return membership, nil
+}
+
+func organizationToModel(organization *entities.Organization) *models.Organization {
+	if organization == nil {
+		return nil
+	}
+
+	return &models.Organization{
+		ID:         organization.ID.String(),
+		Name:       organization.Name,
+		RequireMFA: organization.RequireMFA,
+		CreatedAt:  organization.CreatedAt,
+	}
+}
+
+func membershipToModel(membership *entities.Membership) *models.Membership {
+	return &models.Membership{
+		Organization: organizationToModel(membership.Organization),
+		FirebaseUID:  membership.FirebaseUID,
+		Role:         models.MembershipRole(membership.Role),
+		CreatedAt:    membership.CreatedAt,
+	}
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"strings"
+)
+
+// PersonalAccessTokenPrefix is prepended to every personal access token, so they can be told apart from Firebase
+// ID tokens without a round-trip to the database.
+const PersonalAccessTokenPrefix = "inr_pat_"
+
+func isPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+func personalAccessTokenToModel(token *entities.PersonalAccessToken) *models.PersonalAccessToken {
+	return &models.PersonalAccessToken{
+		ID:         token.ID.String(),
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"crypto/rand"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultPublicIdentifierMaxLength = 32
+	defaultPublicIdentifierFallback  = "user"
+	// defaultPublicIdentifierAttempts is the number of suffixed handles tried, when the plain one is taken.
+	defaultPublicIdentifierAttempts = 5
+	defaultPublicIdentifierSuffix   = "abcdefghijklmnopqrstuvwxyz0123456789"
+	defaultPublicIdentifierSuffixN  = 6
+)
+
+// defaultPublicIdentifiers returns the handles to try, in order, for a user who never picked one. They are derived
+// from the display name of the user, or from their email when they have none.
+func defaultPublicIdentifiers(displayName string, email string) ([]string, error) {
+	base := slugify(displayName)
+	if base == "" {
+		localPart, _, _ := strings.Cut(email, "@")
+		base = slugify(localPart)
+	}
+	if base == "" {
+		base = defaultPublicIdentifierFallback
+	}
+
+	candidates := []string{base}
+	for range defaultPublicIdentifierAttempts {
+		suffix, err := randomSuffix()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, base+"-"+suffix)
+	}
+
+	return candidates, nil
+}
+
+// slugify keeps the ASCII letters and digits of value, with accents removed, and joins the words with dashes.
+func slugify(value string) string {
+	value, _, _ = transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), value)
+
+	var builder strings.Builder
+	pendingDash := false
+
+	for _, r := range strings.ToLower(value) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			pendingDash = builder.Len() > 0
+			continue
+		}
+
+		if pendingDash {
+			builder.WriteRune('-')
+			pendingDash = false
+		}
+
+		builder.WriteRune(r)
+	}
+
+	slug := builder.String()
+	if len(slug) > defaultPublicIdentifierMaxLength {
+		slug = slug[:defaultPublicIdentifierMaxLength]
+	}
+
+	return strings.TrimRight(slug, "-")
+}
+
+func randomSuffix() (string, error) {
+	suffix := make([]byte, defaultPublicIdentifierSuffixN)
+	for i := range suffix {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(defaultPublicIdentifierSuffix))))
+		if err != nil {
+			return "", err
+		}
+
+		suffix[i] = defaultPublicIdentifierSuffix[n.Int64()]
+	}
+
+	return string(suffix), nil
+}
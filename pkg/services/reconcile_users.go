@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"google.golang.org/api/iterator"
+)
+
+// reconcileUsersBatchSize is the maximum number of UIDs Firebase accepts in a single GetUsers call.
+const reconcileUsersBatchSize = 100
+
+// ReconcileUsersService compares the Firebase accounts of every tenant with the users table, and returns a report for
+// each tenant. Rows whose Firebase account was deleted are removed, unless DryRun is set. Accounts that were never
+// provisioned, or that are disabled, are only reported. Linked accounts are checked against the row of their canonical
+// user, which is kept as long as one of its aliases exists. Users of external identity providers have no Firebase
+// account, and are left alone. It is meant for internal tooling.
+type ReconcileUsersService interface {
+	Exec(ctx context.Context, data *models.ReconcileUsers) ([]*models.UsersReconciliationReport, error)
+}
+
+type reconcileUsersServiceImpl struct {
+	client                   *auth.Client
+	listUsersRepository      dao.ListUsersRepository
+	listUsersAfterRepository dao.ListUsersAfterRepository
+	deleteUserRepository     dao.DeleteUserRepository
+
+	listUserAliasesRepository          dao.ListUserAliasesRepository
+	listCanonicalUserAliasesRepository dao.ListCanonicalUserAliasesRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	identityProviders []models.IdentityProviderConfig
+}
+
+func (s *reconcileUsersServiceImpl) Exec(
+	ctx context.Context, data *models.ReconcileUsers,
+) ([]*models.UsersReconciliationReport, error) {
+	tenantIDs, err := listTenantIDs(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*models.UsersReconciliationReport, 0, len(tenantIDs))
+
+	for _, tenantID := range tenantIDs {
+		report, err := s.reconcileTenant(ctx, tenantID, data.DryRun)
+		if err != nil {
+			return reports, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+func (s *reconcileUsersServiceImpl) reconcileTenant(
+	ctx context.Context, tenantID string, dryRun bool,
+) (*models.UsersReconciliationReport, error) {
+	client, err := firebaseUsersForTenant(s.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.UsersReconciliationReport{
+		TenantID:    tenantID,
+		DryRun:      dryRun,
+		Orphans:     []string{},
+		MissingRows: []string{},
+		Disabled:    []string{},
+	}
+
+	if err := s.checkFirebaseUsers(ctx, client, tenantID, report); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRows(ctx, client, tenantID, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// checkFirebaseUsers looks for Firebase accounts without a row, or that are disabled.
+func (s *reconcileUsersServiceImpl) checkFirebaseUsers(
+	ctx context.Context, client firebaseUsers, tenantID string, report *models.UsersReconciliationReport,
+) error {
+	accounts := client.Users(ctx, "")
+	batch := make([]*auth.ExportedUserRecord, 0, reconcileUsersBatchSize)
+
+	checkBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		uids := make([]string, len(batch))
+		for i, user := range batch {
+			uids[i] = user.UID
+		}
+
+		// Linked accounts have no row of their own, and share the one of their canonical user.
+		aliases, err := s.listUserAliasesRepository.ListUserAliases(ctx, tenantID, uids)
+		if err != nil {
+			return err
+		}
+
+		canonicalUIDs := lo.SliceToMap(aliases, func(item *entities.UserAlias) (string, string) {
+			return item.AliasUID, item.CanonicalUID
+		})
+		rowUID := func(uid string) string {
+			return lo.ValueOr(canonicalUIDs, uid, uid)
+		}
+
+		rowUIDs := lo.Uniq(lo.Map(uids, func(item string, _ int) string {
+			return rowUID(item)
+		}))
+
+		rows, err := s.listUsersRepository.ListUsers(ctx, tenantID, rowUIDs)
+		if err != nil {
+			return err
+		}
+
+		provisioned := make(map[string]bool, len(rows))
+		for _, row := range rows {
+			provisioned[row.FirebaseUID] = true
+		}
+
+		for _, user := range batch {
+			switch {
+			case !provisioned[rowUID(user.UID)]:
+				report.MissingRows = append(report.MissingRows, user.UID)
+			case user.Disabled:
+				report.Disabled = append(report.Disabled, user.UID)
+			}
+		}
+
+		report.FirebaseUsersChecked += len(batch)
+		batch = batch[:0]
+
+		return nil
+	}
+
+	for {
+		user, err := accounts.Next()
+		if errors.Is(err, iterator.Done) {
+			return checkBatch()
+		}
+		if err != nil {
+			return err
+		}
+
+		batch = append(batch, user)
+		if len(batch) == reconcileUsersBatchSize {
+			if err := checkBatch(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkRows looks for rows whose Firebase account no longer exists, and deletes them unless the report is a dry run.
+// Rows of canonical users are still used by their aliases, and are not orphans as long as one of them is left.
+func (s *reconcileUsersServiceImpl) checkRows(
+	ctx context.Context, client firebaseUsers, tenantID string, report *models.UsersReconciliationReport,
+) error {
+	afterUID := ""
+
+	for {
+		rows, err := s.listUsersAfterRepository.ListUsersAfter(ctx, tenantID, afterUID, reconcileUsersBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		afterUID = rows[len(rows)-1].FirebaseUID
+
+		// Users of external providers have no Firebase account to compare with.
+		rows = lo.Reject(rows, func(item *entities.User, _ int) bool {
+			return externalIdentityProviderName(s.identityProviders, item.FirebaseUID) != ""
+		})
+		if len(rows) == 0 {
+			continue
+		}
+
+		identifiers := lo.Map(rows, func(item *entities.User, _ int) auth.UserIdentifier {
+			return auth.UIDIdentifier{UID: item.FirebaseUID}
+		})
+
+		result, err := client.GetUsers(ctx, identifiers)
+		if err != nil {
+			return err
+		}
+
+		notFound := lo.Map(result.NotFound, func(item auth.UserIdentifier, _ int) string {
+			return item.(auth.UIDIdentifier).UID
+		})
+
+		orphans, err := s.withoutAliases(ctx, tenantID, notFound)
+		if err != nil {
+			return err
+		}
+
+		for _, uid := range orphans {
+			report.Orphans = append(report.Orphans, uid)
+
+			if !report.DryRun {
+				if err := s.deleteOrphan(ctx, tenantID, uid); err != nil {
+					return err
+				}
+			}
+		}
+
+		report.RowsChecked += len(rows)
+	}
+}
+
+// withoutAliases removes the canonical UIDs that still have aliases from the given UIDs.
+func (s *reconcileUsersServiceImpl) withoutAliases(
+	ctx context.Context, tenantID string, uids []string,
+) ([]string, error) {
+	if len(uids) == 0 {
+		return uids, nil
+	}
+
+	aliases, err := s.listCanonicalUserAliasesRepository.ListCanonicalUserAliases(ctx, tenantID, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := lo.SliceToMap(aliases, func(item *entities.UserAlias) (string, bool) {
+		return item.CanonicalUID, true
+	})
+
+	return lo.Reject(uids, func(item string, _ int) bool {
+		return linked[item]
+	}), nil
+}
+
+func (s *reconcileUsersServiceImpl) deleteOrphan(ctx context.Context, tenantID string, uid string) error {
+	if err := s.deleteUserRepository.DeleteUser(ctx, tenantID, uid); err != nil && !errors.Is(err, dao.ErrUserNotFound) {
+		return err
+	}
+
+	return s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		SubjectUID: uid,
+		Action:     models.AuditActionDeleteUser,
+		Outcome:    models.AuditOutcomeSuccess,
+		Reason:     "firebase account not found",
+	})
+}
+
+func NewReconcileUsersService(
+	client *auth.Client,
+	listUsersRepository dao.ListUsersRepository,
+	listUsersAfterRepository dao.ListUsersAfterRepository,
+	deleteUserRepository dao.DeleteUserRepository,
+	listUserAliasesRepository dao.ListUserAliasesRepository,
+	listCanonicalUserAliasesRepository dao.ListCanonicalUserAliasesRepository,
+	recordAuditEvent RecordAuditEventService,
+	identityProviders []models.IdentityProviderConfig,
+) ReconcileUsersService {
+	return &reconcileUsersServiceImpl{
+		client:                   client,
+		listUsersRepository:      listUsersRepository,
+		listUsersAfterRepository: listUsersAfterRepository,
+		deleteUserRepository:     deleteUserRepository,
+
+		listUserAliasesRepository:          listUserAliasesRepository,
+		listCanonicalUserAliasesRepository: listCanonicalUserAliasesRepository,
+
+		recordAuditEvent:  recordAuditEvent,
+		identityProviders: identityProviders,
+	}
+}
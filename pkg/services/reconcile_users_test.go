@@ -0,0 +1,278 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var reconcileUsersFixtures = []*FixtureUser{
+	{
+		Email:         "user-1@gmail.com",
+		EmailVerified: true,
+		UID:           "reconcile-uid-1",
+		Password:      "password",
+	},
+	{
+		Email:         "user-2@gmail.com",
+		EmailVerified: true,
+		UID:           "reconcile-uid-2",
+		Password:      "password",
+	},
+	{
+		Email:         "user-3@gmail.com",
+		EmailVerified: true,
+		UID:           "reconcile-uid-3",
+		Password:      "password",
+		Disabled:      true,
+	},
+}
+
+func TestReconcileUsers(t *testing.T) {
+	testData := []struct {
+		name string
+
+		dryRun  bool
+		rows    []*entities.User
+		aliases []*entities.UserAlias
+
+		listUsersAfterErr error
+		deleteUserErr     error
+
+		expectDeleted []string
+		expect        *models.UsersReconciliationReport
+		expectErr     error
+	}{
+		{
+			name: "ReconcileUsers",
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-3"},
+				{FirebaseUID: "reconcile-uid-4"},
+			},
+			expectDeleted: []string{"reconcile-uid-4"},
+			expect: &models.UsersReconciliationReport{
+				FirebaseUsersChecked: 3,
+				RowsChecked:          3,
+				Orphans:              []string{"reconcile-uid-4"},
+				MissingRows:          []string{"reconcile-uid-2"},
+				Disabled:             []string{"reconcile-uid-3"},
+			},
+		},
+		{
+			name:   "DryRun",
+			dryRun: true,
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-3"},
+				{FirebaseUID: "reconcile-uid-4"},
+			},
+			expect: &models.UsersReconciliationReport{
+				DryRun:               true,
+				FirebaseUsersChecked: 3,
+				RowsChecked:          3,
+				Orphans:              []string{"reconcile-uid-4"},
+				MissingRows:          []string{"reconcile-uid-2"},
+				Disabled:             []string{"reconcile-uid-3"},
+			},
+		},
+		{
+			// The row may have been deleted since it was listed.
+			name: "OrphanAlreadyDeleted",
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-2"},
+				{FirebaseUID: "reconcile-uid-4"},
+			},
+			deleteUserErr: dao.ErrUserNotFound,
+			expectDeleted: []string{"reconcile-uid-4"},
+			expect: &models.UsersReconciliationReport{
+				FirebaseUsersChecked: 3,
+				RowsChecked:          3,
+				Orphans:              []string{"reconcile-uid-4"},
+				MissingRows:          []string{"reconcile-uid-3"},
+				Disabled:             []string{},
+			},
+		},
+		{
+			name: "InSync",
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-2"},
+			},
+			expect: &models.UsersReconciliationReport{
+				FirebaseUsersChecked: 3,
+				RowsChecked:          2,
+				Orphans:              []string{},
+				MissingRows:          []string{"reconcile-uid-3"},
+				Disabled:             []string{},
+			},
+		},
+		{
+			// Users of external providers have no Firebase account, but are not orphans.
+			name: "ExternalUsers",
+			rows: []*entities.User{
+				{FirebaseUID: "acme:subject-1"},
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-2"},
+			},
+			expect: &models.UsersReconciliationReport{
+				FirebaseUsersChecked: 3,
+				RowsChecked:          2,
+				Orphans:              []string{},
+				MissingRows:          []string{"reconcile-uid-3"},
+				Disabled:             []string{},
+			},
+		},
+		{
+			// Linked accounts share the row of their canonical user.
+			name: "LinkedAccount",
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-3"},
+			},
+			aliases: []*entities.UserAlias{
+				{AliasUID: "reconcile-uid-2", CanonicalUID: "reconcile-uid-1"},
+			},
+			expect: &models.UsersReconciliationReport{
+				FirebaseUsersChecked: 3,
+				RowsChecked:          2,
+				Orphans:              []string{},
+				MissingRows:          []string{},
+				Disabled:             []string{"reconcile-uid-3"},
+			},
+		},
+		{
+			// The canonical account may have been deleted, while its aliases still sign in with its row.
+			name: "AliasOnlyCanonical",
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-1"},
+				{FirebaseUID: "reconcile-uid-2"},
+				{FirebaseUID: "reconcile-uid-4"},
+			},
+			aliases: []*entities.UserAlias{
+				{AliasUID: "reconcile-uid-3", CanonicalUID: "reconcile-uid-4"},
+			},
+			expect: &models.UsersReconciliationReport{
+				FirebaseUsersChecked: 3,
+				RowsChecked:          3,
+				Orphans:              []string{},
+				MissingRows:          []string{},
+				Disabled:             []string{"reconcile-uid-3"},
+			},
+		},
+		{
+			name:              "ListUsersAfterError",
+			listUsersAfterErr: FooErr,
+			expectErr:         FooErr,
+		},
+		{
+			name: "DeleteUserError",
+			rows: []*entities.User{
+				{FirebaseUID: "reconcile-uid-4"},
+			},
+			deleteUserErr: FooErr,
+			expectDeleted: []string{"reconcile-uid-4"},
+			expectErr:     FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			CleanUsersFixtures(reconcileUsersFixtures)
+			require.NoError(t, CreateUsersFixtures(reconcileUsersFixtures))
+			defer CleanUsersFixtures(reconcileUsersFixtures)
+
+			listUsersRepository := daomocks.NewMockListUsersRepository(t)
+			listUsersAfterRepository := daomocks.NewMockListUsersAfterRepository(t)
+			deleteUserRepository := daomocks.NewMockDeleteUserRepository(t)
+			listUserAliasesRepository := daomocks.NewMockListUserAliasesRepository(t)
+			listCanonicalUserAliasesRepository := daomocks.NewMockListCanonicalUserAliasesRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			listUsersRepository.
+				On("ListUsers", context.TODO(), "", mock.Anything).
+				Return(func(_ context.Context, _ string, uids []string) ([]*entities.User, error) {
+					return lo.Filter(data.rows, func(row *entities.User, _ int) bool {
+						return lo.Contains(uids, row.FirebaseUID)
+					}), nil
+				}).
+				Maybe()
+
+			listUserAliasesRepository.
+				On("ListUserAliases", context.TODO(), "", mock.Anything).
+				Return(func(_ context.Context, _ string, uids []string) ([]*entities.UserAlias, error) {
+					return lo.Filter(data.aliases, func(alias *entities.UserAlias, _ int) bool {
+						return lo.Contains(uids, alias.AliasUID)
+					}), nil
+				}).
+				Maybe()
+
+			listCanonicalUserAliasesRepository.
+				On("ListCanonicalUserAliases", context.TODO(), "", mock.Anything).
+				Return(func(_ context.Context, _ string, uids []string) ([]*entities.UserAlias, error) {
+					return lo.Filter(data.aliases, func(alias *entities.UserAlias, _ int) bool {
+						return lo.Contains(uids, alias.CanonicalUID)
+					}), nil
+				}).
+				Maybe()
+
+			if data.listUsersAfterErr != nil {
+				listUsersAfterRepository.
+					On("ListUsersAfter", context.TODO(), "", "", 100).
+					Return(nil, data.listUsersAfterErr)
+			} else {
+				listUsersAfterRepository.
+					On("ListUsersAfter", context.TODO(), "", "", 100).
+					Return(data.rows, nil)
+				if len(data.rows) > 0 {
+					listUsersAfterRepository.
+						On("ListUsersAfter", context.TODO(), "", data.rows[len(data.rows)-1].FirebaseUID, 100).
+						Return([]*entities.User{}, nil).
+						Maybe()
+				}
+			}
+
+			for _, uid := range data.expectDeleted {
+				deleteUserRepository.On("DeleteUser", context.TODO(), "", uid).Return(data.deleteUserErr)
+			}
+
+			if len(data.expectDeleted) > 0 && data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionDeleteUser && lo.Contains(data.expectDeleted, in.SubjectUID)
+					})).
+					Return(nil)
+			}
+
+			service := services.NewReconcileUsersService(
+				config.AuthClient,
+				listUsersRepository,
+				listUsersAfterRepository,
+				deleteUserRepository,
+				listUserAliasesRepository,
+				listCanonicalUserAliasesRepository,
+				recordAuditEventService,
+				[]models.IdentityProviderConfig{{Name: "acme"}},
+			)
+
+			reports, err := service.Exec(context.TODO(), &models.ReconcileUsers{DryRun: data.dryRun})
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			if data.expect != nil {
+				require.NotEmpty(t, reports)
+				require.Equal(t, data.expect, reports[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+type RecordAuditEventService interface {
+	Exec(ctx context.Context, data *models.RecordAuditEvent) error
+}
+
+type recordAuditEventServiceImpl struct {
+	createAuditEventRepository dao.CreateAuditEventRepository
+	trustedProxies             int
+}
+
+func (s *recordAuditEventServiceImpl) Exec(ctx context.Context, data *models.RecordAuditEvent) error {
+	_, err := s.createAuditEventRepository.CreateAuditEvent(ctx, auditEventData(ctx, s.trustedProxies, data))
+	return err
+}
+
+// NewRecordAuditEventService reads the address of the caller behind trustedProxies proxies. See ClientIP.
+func NewRecordAuditEventService(
+	createAuditEventRepository dao.CreateAuditEventRepository, trustedProxies int,
+) RecordAuditEventService {
+	return &recordAuditEventServiceImpl{
+		createAuditEventRepository: createAuditEventRepository,
+		trustedProxies:             trustedProxies,
+	}
+}
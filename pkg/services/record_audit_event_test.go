@@ -0,0 +1,148 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"testing"
+)
+
+func TestRecordAuditEvent(t *testing.T) {
+	peerCtx := peer.NewContext(context.TODO(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 51234},
+	})
+
+	testData := []struct {
+		name string
+
+		ctx  context.Context
+		data *models.RecordAuditEvent
+
+		createAuditEventData *dao.CreateAuditEventData
+		createAuditEventErr  error
+
+		expectErr error
+	}{
+		{
+			name: "RecordAuditEvent",
+			ctx:  metadata.NewIncomingContext(peerCtx, metadata.Pairs("user-agent", "grpc-go/1.64.0")),
+			data: &models.RecordAuditEvent{
+				ActorUID:   "user-one-uid",
+				SubjectUID: "user-one-uid",
+				Action:     models.AuditActionUpdateUser,
+				Outcome:    models.AuditOutcomeSuccess,
+				Diff: map[string]*models.AuditChange{
+					"publicIdentifier": {Before: "public-identifier-1", After: "public-identifier-2"},
+				},
+			},
+			createAuditEventData: &dao.CreateAuditEventData{
+				ActorUID:   lo.ToPtr("user-one-uid"),
+				SubjectUID: lo.ToPtr("user-one-uid"),
+				Action:     models.AuditActionUpdateUser,
+				Outcome:    entities.AuditOutcomeSuccess,
+				PeerIP:     lo.ToPtr("10.0.0.1"),
+				UserAgent:  lo.ToPtr("grpc-go/1.64.0"),
+				Diff: map[string]*entities.AuditChange{
+					"publicIdentifier": {Before: "public-identifier-1", After: "public-identifier-2"},
+				},
+			},
+		},
+		{
+			name: "ForwardedFor",
+			ctx:  metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "203.0.113.7, 10.0.0.1")),
+			data: &models.RecordAuditEvent{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: models.AuditOutcomeFailure,
+				Reason:  "unauthenticated",
+			},
+			createAuditEventData: &dao.CreateAuditEventData{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: entities.AuditOutcomeFailure,
+				Reason:  lo.ToPtr("unauthenticated"),
+				PeerIP:  lo.ToPtr("203.0.113.7"),
+			},
+		},
+		{
+			// Entries before the one added by the load balancer are sent by the client.
+			name: "SpoofedForwardedFor",
+			ctx: metadata.NewIncomingContext(peerCtx, metadata.Pairs(
+				"x-forwarded-for", "198.51.100.1, 203.0.113.7, 10.0.0.1",
+			)),
+			data: &models.RecordAuditEvent{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: models.AuditOutcomeFailure,
+			},
+			createAuditEventData: &dao.CreateAuditEventData{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: entities.AuditOutcomeFailure,
+				PeerIP:  lo.ToPtr("203.0.113.7"),
+			},
+		},
+		{
+			// The header cannot come from the proxies if they did not add enough entries.
+			name: "ShortForwardedFor",
+			ctx:  metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "203.0.113.7")),
+			data: &models.RecordAuditEvent{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: models.AuditOutcomeFailure,
+			},
+			createAuditEventData: &dao.CreateAuditEventData{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: entities.AuditOutcomeFailure,
+				PeerIP:  lo.ToPtr("10.0.0.1"),
+			},
+		},
+		{
+			name: "NoRequestMetadata",
+			ctx:  context.TODO(),
+			data: &models.RecordAuditEvent{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: models.AuditOutcomeFailure,
+			},
+			createAuditEventData: &dao.CreateAuditEventData{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: entities.AuditOutcomeFailure,
+			},
+		},
+		{
+			name: "CreateAuditEventError",
+			ctx:  context.TODO(),
+			data: &models.RecordAuditEvent{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: models.AuditOutcomeFailure,
+			},
+			createAuditEventData: &dao.CreateAuditEventData{
+				Action:  models.AuditActionAuthenticate,
+				Outcome: entities.AuditOutcomeFailure,
+			},
+			createAuditEventErr: FooErr,
+			expectErr:           FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			createAuditEventRepository := daomocks.NewMockCreateAuditEventRepository(t)
+
+			createAuditEventRepository.
+				On("CreateAuditEvent", data.ctx, data.createAuditEventData).
+				Return(new(entities.AuditEvent), data.createAuditEventErr)
+
+			service := services.NewRecordAuditEventService(createAuditEventRepository, 2)
+
+			err := service.Exec(data.ctx, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			createAuditEventRepository.AssertExpectations(t)
+		})
+	}
+}
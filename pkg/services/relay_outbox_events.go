@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"strconv"
+	"time"
+)
+
+// RelayOutboxEventsService publishes a batch of pending outbox events, and returns how many were published. An event
+// is published at least once: if the relay stops between publishing and marking the event, it is published again
+// once its lease expires. An event that fails MaxAttempts times is marked dead, and no longer blocks the later
+// events of its user.
+type RelayOutboxEventsService interface {
+	Exec(ctx context.Context) (int, error)
+}
+
+type relayOutboxEventsServiceImpl struct {
+	claimOutboxEventsRepository        dao.ClaimOutboxEventsRepository
+	markOutboxEventPublishedRepository dao.MarkOutboxEventPublishedRepository
+	markOutboxEventFailedRepository    dao.MarkOutboxEventFailedRepository
+	markOutboxEventDeadRepository      dao.MarkOutboxEventDeadRepository
+	publisher                          clients.Publisher
+	config                             models.OutboxRelayConfig
+}
+
+func (s *relayOutboxEventsServiceImpl) Exec(ctx context.Context) (int, error) {
+	events, err := s.claimOutboxEventsRepository.ClaimOutboxEvents(ctx, time.Now().Add(s.config.Lease), s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	// Once an event fails, later events of the same user are left for the next run, so they are never published
+	// out of order.
+	failedAggregates := make(map[string]bool)
+
+	for _, event := range events {
+		if failedAggregates[event.AggregateID] {
+			continue
+		}
+
+		if err := s.publisher.Publish(ctx, outboxEventToUserEvent(event)); err != nil {
+			failedAggregates[event.AggregateID] = true
+
+			if err := s.markFailed(ctx, event, err); err != nil {
+				return published, err
+			}
+
+			continue
+		}
+
+		if err := s.markOutboxEventPublishedRepository.MarkOutboxEventPublished(ctx, event.ID); err != nil {
+			return published, err
+		}
+
+		published++
+	}
+
+	return published, nil
+}
+
+func (s *relayOutboxEventsServiceImpl) markFailed(ctx context.Context, event *entities.OutboxEvent, err error) error {
+	if event.Attempts+1 >= s.config.MaxAttempts {
+		return s.markOutboxEventDeadRepository.MarkOutboxEventDead(ctx, event.ID, err.Error())
+	}
+
+	nextAttemptAt := time.Now().Add(exponentialBackoff(s.config.MinBackoff, s.config.MaxBackoff, event.Attempts))
+	return s.markOutboxEventFailedRepository.MarkOutboxEventFailed(ctx, event.ID, err.Error(), nextAttemptAt)
+}
+
+func outboxEventToUserEvent(event *entities.OutboxEvent) *clients.UserEvent {
+	return &clients.UserEvent{
+		ID:               strconv.FormatInt(event.ID, 10),
+		Type:             event.Type,
+		TenantID:         event.Payload.TenantID,
+		FirebaseUID:      event.Payload.FirebaseUID,
+		PublicIdentifier: event.Payload.PublicIdentifier,
+		MergedUID:        event.Payload.MergedUID,
+		OccurredAt:       lo.FromPtr(event.CreatedAt),
+	}
+}
+
+func NewRelayOutboxEventsService(
+	claimOutboxEventsRepository dao.ClaimOutboxEventsRepository,
+	markOutboxEventPublishedRepository dao.MarkOutboxEventPublishedRepository,
+	markOutboxEventFailedRepository dao.MarkOutboxEventFailedRepository,
+	markOutboxEventDeadRepository dao.MarkOutboxEventDeadRepository,
+	publisher clients.Publisher,
+	config models.OutboxRelayConfig,
+) RelayOutboxEventsService {
+	return &relayOutboxEventsServiceImpl{
+		claimOutboxEventsRepository:        claimOutboxEventsRepository,
+		markOutboxEventPublishedRepository: markOutboxEventPublishedRepository,
+		markOutboxEventFailedRepository:    markOutboxEventFailedRepository,
+		markOutboxEventDeadRepository:      markOutboxEventDeadRepository,
+		publisher:                          publisher,
+		config:                             config,
+	}
+}
@@ -0,0 +1,179 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var relayOutboxEventsConfig = models.OutboxRelayConfig{
+	BatchSize:  10,
+	Lease:      time.Minute,
+	MinBackoff: time.Second,
+	MaxBackoff: 5 * time.Second,
+	// Above the attempts of the events, unless they are meant to die.
+	MaxAttempts: 20,
+}
+
+func newOutboxEvent(id int64, uid string, attempts int) *entities.OutboxEvent {
+	return &entities.OutboxEvent{
+		ID:          id,
+		AggregateID: uid,
+		Type:        entities.OutboxEventUserUpdated,
+		Payload: &entities.UserEventPayload{
+			FirebaseUID:      uid,
+			PublicIdentifier: "public-identifier",
+		},
+		Attempts:  attempts,
+		CreatedAt: lo.ToPtr(time.Date(2024, 10, 9, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestRelayOutboxEvents(t *testing.T) {
+	testData := []struct {
+		name string
+
+		claimResponse []*entities.OutboxEvent
+		claimErr      error
+
+		// publishErr maps event ids to the error returned when publishing them.
+		publishErr map[int64]error
+
+		expectPublished []int64
+		// expectFailed maps event ids to the expected retry delay.
+		expectFailed map[int64]time.Duration
+		expectDead   []int64
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name: "RelayOutboxEvents",
+			claimResponse: []*entities.OutboxEvent{
+				newOutboxEvent(1, "user-one-uid", 0),
+				newOutboxEvent(2, "user-two-uid", 0),
+			},
+			expectPublished: []int64{1, 2},
+			expect:          2,
+		},
+		{
+			name:            "NoEvents",
+			claimResponse:   []*entities.OutboxEvent{},
+			expectPublished: []int64{},
+		},
+		{
+			name:      "ClaimError",
+			claimErr:  FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name: "PublishError",
+			claimResponse: []*entities.OutboxEvent{
+				newOutboxEvent(1, "user-one-uid", 0),
+				newOutboxEvent(2, "user-two-uid", 0),
+				newOutboxEvent(3, "user-one-uid", 0),
+			},
+			publishErr:      map[int64]error{1: FooErr},
+			expectPublished: []int64{2},
+			expectFailed:    map[int64]time.Duration{1: time.Second},
+			expect:          1,
+		},
+		{
+			name: "Backoff",
+			claimResponse: []*entities.OutboxEvent{
+				newOutboxEvent(1, "user-one-uid", 2),
+				newOutboxEvent(2, "user-two-uid", 10),
+			},
+			publishErr:      map[int64]error{1: FooErr, 2: FooErr},
+			expectPublished: []int64{},
+			expectFailed:    map[int64]time.Duration{1: 4 * time.Second, 2: 5 * time.Second},
+		},
+		{
+			name: "Dead",
+			claimResponse: []*entities.OutboxEvent{
+				newOutboxEvent(1, "user-one-uid", 19),
+				newOutboxEvent(2, "user-two-uid", 18),
+			},
+			publishErr:      map[int64]error{1: FooErr, 2: FooErr},
+			expectPublished: []int64{},
+			expectFailed:    map[int64]time.Duration{2: 5 * time.Second},
+			expectDead:      []int64{1},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			claimRepository := daomocks.NewMockClaimOutboxEventsRepository(t)
+			markPublishedRepository := daomocks.NewMockMarkOutboxEventPublishedRepository(t)
+			markFailedRepository := daomocks.NewMockMarkOutboxEventFailedRepository(t)
+			markDeadRepository := daomocks.NewMockMarkOutboxEventDeadRepository(t)
+			publisher := clientsmocks.NewMockPublisher(t)
+
+			claimRepository.
+				On("ClaimOutboxEvents", context.TODO(), mock.AnythingOfType("time.Time"), relayOutboxEventsConfig.BatchSize).
+				Return(data.claimResponse, data.claimErr)
+
+			published := make([]int64, 0)
+			for _, event := range data.claimResponse {
+				id := event.ID
+				_, isFailed := data.expectFailed[id]
+				if !isFailed && !lo.Contains(data.expectDead, id) && !lo.Contains(data.expectPublished, id) {
+					continue
+				}
+
+				publisher.
+					On("Publish", context.TODO(), mock.MatchedBy(func(in *clients.UserEvent) bool {
+						return in.ID == strconv.FormatInt(id, 10)
+					})).
+					Return(data.publishErr[id])
+			}
+
+			for _, id := range data.expectPublished {
+				markPublishedRepository.
+					On("MarkOutboxEventPublished", context.TODO(), id).
+					Run(func(args mock.Arguments) { published = append(published, id) }).
+					Return(nil)
+			}
+
+			for id, delay := range data.expectFailed {
+				markFailedRepository.
+					On("MarkOutboxEventFailed", context.TODO(), id, FooErr.Error(), mock.MatchedBy(func(in time.Time) bool {
+						return in.Sub(time.Now().Add(delay)).Abs() < time.Second/2
+					})).
+					Return(nil)
+			}
+
+			for _, id := range data.expectDead {
+				markDeadRepository.On("MarkOutboxEventDead", context.TODO(), id, FooErr.Error()).Return(nil)
+			}
+
+			service := services.NewRelayOutboxEventsService(
+				claimRepository,
+				markPublishedRepository,
+				markFailedRepository,
+				markDeadRepository,
+				publisher,
+				relayOutboxEventsConfig,
+			)
+
+			count, err := service.Exec(context.TODO())
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, count)
+
+			if data.expectPublished != nil {
+				require.Equal(t, data.expectPublished, published)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type RemoveOrganizationMemberService interface {
+	Exec(ctx context.Context, token string, data *models.RemoveOrganizationMember) error
+}
+
+type removeOrganizationMemberServiceImpl struct {
+	auth                       AuthenticateService
+	getMembershipRepository    dao.GetMembershipRepository
+	deleteMembershipRepository dao.DeleteMembershipRepository
+
+	trustedProxies int
+}
+
+func (s *removeOrganizationMemberServiceImpl) Exec(
+	ctx context.Context, token string, data *models.RemoveOrganizationMember,
+) error {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return err
+	}
+
+	if user.Scopes != nil && !lo.Contains(user.Scopes, models.ScopeUserWrite) {
+		return ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return errors.Join(ErrInvalidRemoveOrganizationMember, err)
+	}
+
+	organizationID, err := uuid.Parse(data.OrganizationID)
+	if err != nil {
+		return errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	actor, err := s.getMembershipRepository.GetMembership(ctx, organizationID, user.FirebaseUID)
+	if err != nil {
+		if errors.Is(err, dao.ErrMembershipNotFound) {
+			return ErrNotOrganizationMember
+		}
+
+		return err
+	}
+
+	// Anyone can leave an organization. Removing someone else requires to be an admin that outranks them.
+	target := actor
+	if data.FirebaseUID != user.FirebaseUID {
+		if !canManageMembers(actor.Role) {
+			return ErrInsufficientOrganizationRole
+		}
+
+		target, err = s.getMembershipRepository.GetMembership(ctx, organizationID, data.FirebaseUID)
+		if err != nil {
+			if errors.Is(err, dao.ErrMembershipNotFound) {
+				return ErrOrganizationMemberNotFound
+			}
+
+			return err
+		}
+
+		if !outranks(actor.Role, target.Role) {
+			return ErrInsufficientOrganizationRole
+		}
+	}
+
+	audit := auditEventData(ctx, s.trustedProxies, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: target.FirebaseUID,
+		Target:     "organizations/" + organizationID.String(),
+		Action:     models.AuditActionRemoveOrganizationMember,
+		Outcome:    models.AuditOutcomeSuccess,
+		Diff: map[string]*models.AuditChange{
+			"role": {Before: models.MembershipRole(target.Role)},
+		},
+	})
+
+	_, err = s.deleteMembershipRepository.DeleteMembership(ctx, organizationID, target.FirebaseUID, audit)
+	if err != nil {
+		if errors.Is(err, dao.ErrMembershipNotFound) {
+			return ErrOrganizationMemberNotFound
+		}
+		if errors.Is(err, dao.ErrLastOrganizationOwner) {
+			return ErrLastOrganizationOwner
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// NewRemoveOrganizationMemberService reads the address of the caller behind trustedProxies proxies. See ClientIP.
+func NewRemoveOrganizationMemberService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	deleteMembershipRepository dao.DeleteMembershipRepository,
+	trustedProxies int,
+) RemoveOrganizationMemberService {
+	return &removeOrganizationMemberServiceImpl{
+		auth:                       auth,
+		getMembershipRepository:    getMembershipRepository,
+		deleteMembershipRepository: deleteMembershipRepository,
+		trustedProxies:             trustedProxies,
+	}
+}
@@ -0,0 +1,315 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.RemoveOrganizationMember
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetActor bool
+		getActorResponse   *entities.Membership
+		getActorErr        error
+
+		shouldCallGetTarget bool
+		getTargetResponse   *entities.Membership
+		getTargetErr        error
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name:  "RemoveMember",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleAdmin,
+			},
+			shouldCallGetTarget: true,
+			getTargetResponse: &entities.Membership{
+				FirebaseUID: "user-two-uid",
+				Role:        entities.MembershipRoleMember,
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name:  "LeaveOrganization",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-one-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleMember,
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name:  "OwnerLeavesWithOtherOwners",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-one-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name:  "LastOwner",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-one-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrLastOrganizationOwner,
+			expectErr:        services.ErrLastOrganizationOwner,
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "InsufficientScope",
+			token: "inr_pat_foo",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidData",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: "not-a-uuid",
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse: &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:    services.ErrInvalidRemoveOrganizationMember,
+		},
+		{
+			name:  "NotOrganizationMember",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorErr:        dao.ErrMembershipNotFound,
+			expectErr:          services.ErrNotOrganizationMember,
+		},
+		{
+			name:  "MemberCannotRemoveOthers",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleMember,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "AdminCannotRemoveOwner",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleAdmin,
+			},
+			shouldCallGetTarget: true,
+			getTargetResponse: &entities.Membership{
+				FirebaseUID: "user-two-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "AdminCannotRemoveAdmin",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleAdmin,
+			},
+			shouldCallGetTarget: true,
+			getTargetResponse: &entities.Membership{
+				FirebaseUID: "user-two-uid",
+				Role:        entities.MembershipRoleAdmin,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "OwnerRemovesOwner",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallGetTarget: true,
+			getTargetResponse: &entities.Membership{
+				FirebaseUID: "user-two-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name:  "TargetNotFound",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-two-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallGetTarget: true,
+			getTargetErr:        dao.ErrMembershipNotFound,
+			expectErr:           services.ErrOrganizationMemberNotFound,
+		},
+		{
+			name:  "DeleteError",
+			token: "foo-token",
+			data: &models.RemoveOrganizationMember{
+				OrganizationID: organizationID.String(),
+				FirebaseUID:    "user-one-uid",
+			},
+			authResponse:       &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetActor: true,
+			getActorResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleMember,
+			},
+			shouldCallDelete: true,
+			deleteErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			deleteMembershipRepository := daomocks.NewMockDeleteMembershipRepository(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetActor {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), organizationID, data.authResponse.FirebaseUID).
+					Return(data.getActorResponse, data.getActorErr)
+			}
+
+			if data.shouldCallGetTarget {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), organizationID, data.data.FirebaseUID).
+					Return(data.getTargetResponse, data.getTargetErr)
+			}
+
+			if data.shouldCallDelete {
+				deleteMembershipRepository.
+					On(
+						"DeleteMembership", context.TODO(), organizationID, data.data.FirebaseUID,
+						mock.MatchedBy(func(in *dao.CreateAuditEventData) bool {
+							return in.Action == models.AuditActionRemoveOrganizationMember &&
+								in.Outcome == entities.AuditOutcomeSuccess &&
+								lo.FromPtr(in.Target) == "organizations/"+organizationID.String()
+						}),
+					).
+					Return(nil, data.deleteErr)
+			}
+
+			service := services.NewRemoveOrganizationMemberService(
+				authService,
+				getMembershipRepository,
+				deleteMembershipRepository,
+				0,
+			)
+
+			err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			deleteMembershipRepository.AssertExpectations(t)
+		})
+	}
+}
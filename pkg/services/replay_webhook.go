@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+// ReplayWebhookService is meant for internal tooling. It schedules a delivery again, typically after a receiver
+// recovered from an outage and the delivery went dead. The receiver gets the same delivery ID, so it can tell a
+// replay from a new event.
+type ReplayWebhookService interface {
+	Exec(ctx context.Context, deliveryID string) (*models.WebhookDelivery, error)
+}
+
+type replayWebhookServiceImpl struct {
+	replayWebhookDeliveryRepository dao.ReplayWebhookDeliveryRepository
+}
+
+func (s *replayWebhookServiceImpl) Exec(ctx context.Context, deliveryID string) (*models.WebhookDelivery, error) {
+	id, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidWebhookDeliveryID, err)
+	}
+
+	delivery, err := s.replayWebhookDeliveryRepository.ReplayWebhookDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, dao.ErrWebhookDeliveryNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+
+		return nil, err
+	}
+
+	return webhookDeliveryToModel(delivery), nil
+}
+
+func NewReplayWebhookService(replayWebhookDeliveryRepository dao.ReplayWebhookDeliveryRepository) ReplayWebhookService {
+	return &replayWebhookServiceImpl{
+		replayWebhookDeliveryRepository: replayWebhookDeliveryRepository,
+	}
+}
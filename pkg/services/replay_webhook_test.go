@@ -0,0 +1,95 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestReplayWebhook(t *testing.T) {
+	testData := []struct {
+		name string
+
+		id string
+
+		shouldCallReplay bool
+		replayResponse   *entities.WebhookDelivery
+		replayErr        error
+
+		expect    *models.WebhookDelivery
+		expectErr error
+	}{
+		{
+			name:             "ReplayWebhook",
+			id:               "00000000-0000-0000-0000-000000000001",
+			shouldCallReplay: true,
+			replayResponse: &entities.WebhookDelivery{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				EventID:        "1",
+				EventType:      entities.OutboxEventUserCreated,
+				Payload:        json.RawMessage(`{"id": "1"}`),
+				Status:         entities.WebhookDeliveryStatusPending,
+				NextAttemptAt:  lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.WebhookDelivery{
+				ID:             "00000000-0000-0000-0000-000000000001",
+				SubscriptionID: "00000000-0000-0000-0000-000000000002",
+				EventID:        "1",
+				EventType:      entities.OutboxEventUserCreated,
+				Status:         models.WebhookDeliveryStatusPending,
+				NextAttemptAt:  lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				Log:            []*models.WebhookDeliveryAttempt{},
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "InvalidID",
+			id:        "not-a-uuid",
+			expectErr: services.ErrInvalidWebhookDeliveryID,
+		},
+		{
+			name:             "DeliveryNotFound",
+			id:               "00000000-0000-0000-0000-000000000001",
+			shouldCallReplay: true,
+			replayErr:        dao.ErrWebhookDeliveryNotFound,
+			expectErr:        services.ErrWebhookDeliveryNotFound,
+		},
+		{
+			name:             "ReplayError",
+			id:               "00000000-0000-0000-0000-000000000001",
+			shouldCallReplay: true,
+			replayErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			replayRepository := daomocks.NewMockReplayWebhookDeliveryRepository(t)
+
+			if data.shouldCallReplay {
+				replayRepository.
+					On("ReplayWebhookDelivery", context.TODO(), uuid.MustParse(data.id)).
+					Return(data.replayResponse, data.replayErr)
+			}
+
+			service := services.NewReplayWebhookService(replayRepository)
+
+			delivery, err := service.Exec(context.TODO(), data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, delivery)
+		})
+	}
+}
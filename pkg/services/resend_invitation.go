@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// ResendInvitationService sends a pending invitation again. Since only the hash of the code is stored, a new code is
+// generated, which invalidates the previous one and extends the expiration date.
+type ResendInvitationService interface {
+	Exec(ctx context.Context, token string, data *models.ResendInvitation) (*models.Invitation, error)
+}
+
+type resendInvitationServiceImpl struct {
+	auth                           AuthenticateService
+	getMembershipRepository        dao.GetMembershipRepository
+	getInvitationRepository        dao.GetInvitationRepository
+	updateInvitationCodeRepository dao.UpdateInvitationCodeRepository
+	sendEmail                      SendEmailService
+
+	recordAuditEvent RecordAuditEventService
+
+	ttl       time.Duration
+	acceptURL string
+}
+
+func (s *resendInvitationServiceImpl) Exec(
+	ctx context.Context, token string, data *models.ResendInvitation,
+) (*models.Invitation, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil && !lo.Contains(user.Scopes, models.ScopeUserWrite) {
+		return nil, ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidResendInvitation, err)
+	}
+
+	organizationID, err := uuid.Parse(data.OrganizationID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	invitationID, err := uuid.Parse(data.InvitationID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidInvitationID, err)
+	}
+
+	actor, err := getManagerMembership(ctx, s.getMembershipRepository, organizationID, user.FirebaseUID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.getInvitationRepository.GetInvitation(ctx, organizationID, invitationID)
+	if err != nil {
+		if errors.Is(err, dao.ErrInvitationNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, err
+	}
+
+	// Resending renews the invitation, so it requires the same role as creating it.
+	if !outranks(actor.Role, pending.Role) {
+		return nil, ErrInsufficientOrganizationRole
+	}
+
+	code, err := generateSecret(InvitationCodePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.updateInvitationCodeRepository.UpdateInvitationCode(
+		ctx, organizationID, invitationID, &dao.UpdateInvitationCodeData{
+			CodeHash:  hashSecret(code),
+			ExpiresAt: time.Now().Add(s.ttl),
+		},
+	)
+	if err != nil {
+		if errors.Is(err, dao.ErrInvitationNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, err
+	}
+
+	if err := sendInvitation(ctx, s.sendEmail, s.acceptURL, invitation, actor.Organization, code, data.Locale); err != nil {
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID: user.FirebaseUID,
+		Target:   "organizations/" + organizationID.String() + "/invitations/" + invitationID.String(),
+		Action:   models.AuditActionResendInvitation,
+		Outcome:  models.AuditOutcomeSuccess,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invitationToModel(invitation), nil
+}
+
+func NewResendInvitationService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	getInvitationRepository dao.GetInvitationRepository,
+	updateInvitationCodeRepository dao.UpdateInvitationCodeRepository,
+	sendEmail SendEmailService,
+	recordAuditEvent RecordAuditEventService,
+	ttl time.Duration,
+	acceptURL string,
+) ResendInvitationService {
+	return &resendInvitationServiceImpl{
+		auth:                           auth,
+		getMembershipRepository:        getMembershipRepository,
+		getInvitationRepository:        getInvitationRepository,
+		updateInvitationCodeRepository: updateInvitationCodeRepository,
+		sendEmail:                      sendEmail,
+		recordAuditEvent:               recordAuditEvent,
+		ttl:                            ttl,
+		acceptURL:                      acceptURL,
+	}
+}
@@ -0,0 +1,335 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResendInvitation(t *testing.T) {
+	organization := &entities.Organization{
+		ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: "organization-1",
+	}
+
+	invitationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	expiresAt := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	pending := &entities.OrganizationInvitation{
+		ID:             &invitationID,
+		OrganizationID: *organization.ID,
+		Email:          "invitee@gmail.com",
+		Role:           entities.MembershipRoleMember,
+		InvitedBy:      "user-one-uid",
+	}
+
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.ResendInvitation
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		shouldCallGetInvitation bool
+		getInvitationResponse   *entities.OrganizationInvitation
+		getInvitationErr        error
+
+		shouldCallUpdateInvitationCode bool
+		updateInvitationCodeErr        error
+
+		shouldCallSendEmail bool
+		sendEmailErr        error
+
+		shouldCallRecordAuditEvent bool
+		recordAuditEventErr        error
+
+		expect    *models.Invitation
+		expectErr error
+	}{
+		{
+			name:  "ResendInvitation",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+				Locale:         "en",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			shouldCallGetInvitation:        true,
+			getInvitationResponse:          pending,
+			shouldCallUpdateInvitationCode: true,
+			shouldCallSendEmail:            true,
+			shouldCallRecordAuditEvent:     true,
+			expect: &models.Invitation{
+				ID:             invitationID.String(),
+				OrganizationID: organization.ID.String(),
+				Email:          "invitee@gmail.com",
+				Role:           models.MembershipRoleMember,
+				InvitedBy:      "user-one-uid",
+				ExpiresAt:      &expiresAt,
+			},
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "InvalidData",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   "not-a-uuid",
+			},
+			authResponse: &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:    services.ErrInvalidResendInvitation,
+		},
+		{
+			name:  "MemberCannotResend",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleMember,
+				Organization: organization,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "AdminCannotResendAdminInvitation",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			shouldCallGetInvitation: true,
+			getInvitationResponse: &entities.OrganizationInvitation{
+				ID:             &invitationID,
+				OrganizationID: *organization.ID,
+				Email:          "invitee@gmail.com",
+				Role:           entities.MembershipRoleAdmin,
+				InvitedBy:      "user-two-uid",
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			name:  "InvitationNotFound",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallGetInvitation: true,
+			getInvitationErr:        dao.ErrInvitationNotFound,
+			expectErr:               services.ErrInvitationNotFound,
+		},
+		{
+			name:  "InvitationAcceptedMeanwhile",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallGetInvitation:        true,
+			getInvitationResponse:          pending,
+			shouldCallUpdateInvitationCode: true,
+			updateInvitationCodeErr:        dao.ErrInvitationNotFound,
+			expectErr:                      services.ErrInvitationNotFound,
+		},
+		{
+			name:  "SendEmailError",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallGetInvitation:        true,
+			getInvitationResponse:          pending,
+			shouldCallUpdateInvitationCode: true,
+			shouldCallSendEmail:            true,
+			sendEmailErr:                   services.ErrEmailThrottled,
+			expectErr:                      services.ErrEmailThrottled,
+		},
+		{
+			name:  "RecordAuditEventError",
+			token: "foo-token",
+			data: &models.ResendInvitation{
+				OrganizationID: organization.ID.String(),
+				InvitationID:   invitationID.String(),
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallGetInvitation:        true,
+			getInvitationResponse:          pending,
+			shouldCallUpdateInvitationCode: true,
+			shouldCallSendEmail:            true,
+			shouldCallRecordAuditEvent:     true,
+			recordAuditEventErr:            FooErr,
+			expectErr:                      FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			getInvitationRepository := daomocks.NewMockGetInvitationRepository(t)
+			updateInvitationCodeRepository := daomocks.NewMockUpdateInvitationCodeRepository(t)
+			sendEmailService := servicesmocks.NewMockSendEmailService(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), *organization.ID, data.authResponse.FirebaseUID).
+					Return(data.getMembershipResponse, data.getMembershipErr)
+			}
+
+			if data.shouldCallGetInvitation {
+				getInvitationRepository.
+					On("GetInvitation", context.TODO(), *organization.ID, invitationID).
+					Return(data.getInvitationResponse, data.getInvitationErr)
+			}
+
+			var codeHash string
+
+			if data.shouldCallUpdateInvitationCode {
+				updateInvitationCodeRepository.
+					On(
+						"UpdateInvitationCode", context.TODO(), *organization.ID, invitationID,
+						mock.MatchedBy(func(in *dao.UpdateInvitationCodeData) bool {
+							codeHash = in.CodeHash
+							return in.ExpiresAt.After(time.Now())
+						}),
+					).
+					Return(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, in *dao.UpdateInvitationCodeData) *entities.OrganizationInvitation {
+						if data.updateInvitationCodeErr != nil {
+							return nil
+						}
+
+						return &entities.OrganizationInvitation{
+							ID:             &invitationID,
+							OrganizationID: *organization.ID,
+							Email:          "invitee@gmail.com",
+							Role:           entities.MembershipRoleMember,
+							CodeHash:       in.CodeHash,
+							InvitedBy:      "user-one-uid",
+							ExpiresAt:      &expiresAt,
+						}
+					}, data.updateInvitationCodeErr)
+			}
+
+			if data.shouldCallSendEmail {
+				sendEmailService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.SendEmail) bool {
+						code := strings.TrimPrefix(in.Data.Link, "https://app.example.com/invitations?code=")
+						return in.Kind == models.EmailKindOrganizationInvitation &&
+							in.To == "invitee@gmail.com" &&
+							hashToken(code) == codeHash
+					})).
+					Return(data.sendEmailErr)
+			}
+
+			if data.shouldCallRecordAuditEvent {
+				recordAuditEventService.
+					On("Exec", context.TODO(), &models.RecordAuditEvent{
+						ActorUID: "user-one-uid",
+						Target:   "organizations/" + organization.ID.String() + "/invitations/" + invitationID.String(),
+						Action:   models.AuditActionResendInvitation,
+						Outcome:  models.AuditOutcomeSuccess,
+					}).
+					Return(data.recordAuditEventErr)
+			}
+
+			service := services.NewResendInvitationService(
+				authService,
+				getMembershipRepository,
+				getInvitationRepository,
+				updateInvitationCodeRepository,
+				sendEmailService,
+				recordAuditEventService,
+				7*24*time.Hour,
+				"https://app.example.com/invitations",
+			)
+
+			invitation, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, invitation)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			getInvitationRepository.AssertExpectations(t)
+			updateInvitationCodeRepository.AssertExpectations(t)
+			sendEmailService.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
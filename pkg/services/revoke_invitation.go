@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+type RevokeInvitationService interface {
+	Exec(ctx context.Context, token string, organizationID string, invitationID string) error
+}
+
+type revokeInvitationServiceImpl struct {
+	auth                       AuthenticateService
+	getMembershipRepository    dao.GetMembershipRepository
+	deleteInvitationRepository dao.DeleteInvitationRepository
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *revokeInvitationServiceImpl) Exec(
+	ctx context.Context, token string, organizationID string, invitationID string,
+) error {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return err
+	}
+
+	if user.Scopes != nil && !lo.Contains(user.Scopes, models.ScopeUserWrite) {
+		return ErrInsufficientScope
+	}
+
+	parsedOrganizationID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	parsedInvitationID, err := uuid.Parse(invitationID)
+	if err != nil {
+		return errors.Join(ErrInvalidInvitationID, err)
+	}
+
+	if _, err := getManagerMembership(ctx, s.getMembershipRepository, parsedOrganizationID, user.FirebaseUID); err != nil {
+		return err
+	}
+
+	if err := s.deleteInvitationRepository.DeleteInvitation(ctx, parsedOrganizationID, parsedInvitationID); err != nil {
+		if errors.Is(err, dao.ErrInvitationNotFound) {
+			return ErrInvitationNotFound
+		}
+
+		return err
+	}
+
+	return s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID: user.FirebaseUID,
+		Target:   "organizations/" + parsedOrganizationID.String() + "/invitations/" + parsedInvitationID.String(),
+		Action:   models.AuditActionRevokeInvitation,
+		Outcome:  models.AuditOutcomeSuccess,
+	})
+}
+
+func NewRevokeInvitationService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	deleteInvitationRepository dao.DeleteInvitationRepository,
+	recordAuditEvent RecordAuditEventService,
+) RevokeInvitationService {
+	return &revokeInvitationServiceImpl{
+		auth:                       auth,
+		getMembershipRepository:    getMembershipRepository,
+		deleteInvitationRepository: deleteInvitationRepository,
+		recordAuditEvent:           recordAuditEvent,
+	}
+}
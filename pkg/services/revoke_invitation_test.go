@@ -0,0 +1,155 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRevokeInvitation(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token          string
+		organizationID string
+		invitationID   string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name:                    "RevokeInvitation",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			invitationID:            "00000000-0000-0000-0000-000000000002",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleAdmin,
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name:           "AuthError",
+			token:          "foo-token",
+			organizationID: "00000000-0000-0000-0000-000000000001",
+			invitationID:   "00000000-0000-0000-0000-000000000002",
+			authErr:        FooErr,
+			expectErr:      FooErr,
+		},
+		{
+			name:           "InsufficientScope",
+			token:          "inr_pat_foo",
+			organizationID: "00000000-0000-0000-0000-000000000001",
+			invitationID:   "00000000-0000-0000-0000-000000000002",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:           "InvalidOrganizationID",
+			token:          "foo-token",
+			organizationID: "not-a-uuid",
+			invitationID:   "00000000-0000-0000-0000-000000000002",
+			authResponse:   &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:      services.ErrInvalidOrganizationID,
+		},
+		{
+			name:           "InvalidInvitationID",
+			token:          "foo-token",
+			organizationID: "00000000-0000-0000-0000-000000000001",
+			invitationID:   "not-a-uuid",
+			authResponse:   &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:      services.ErrInvalidInvitationID,
+		},
+		{
+			name:                    "NotOrganizationMember",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			invitationID:            "00000000-0000-0000-0000-000000000002",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipErr:        dao.ErrMembershipNotFound,
+			expectErr:               services.ErrNotOrganizationMember,
+		},
+		{
+			name:                    "InvitationNotFound",
+			token:                   "foo-token",
+			organizationID:          "00000000-0000-0000-0000-000000000001",
+			invitationID:            "00000000-0000-0000-0000-000000000002",
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID: "user-one-uid",
+				Role:        entities.MembershipRoleOwner,
+			},
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrInvitationNotFound,
+			expectErr:        services.ErrInvitationNotFound,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			deleteInvitationRepository := daomocks.NewMockDeleteInvitationRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), uuid.MustParse(data.organizationID), data.authResponse.FirebaseUID).
+					Return(data.getMembershipResponse, data.getMembershipErr)
+			}
+
+			if data.shouldCallDelete {
+				deleteInvitationRepository.
+					On("DeleteInvitation", context.TODO(), uuid.MustParse(data.organizationID), uuid.MustParse(data.invitationID)).
+					Return(data.deleteErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionRevokeInvitation && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewRevokeInvitationService(
+				authService, getMembershipRepository, deleteInvitationRepository, recordAuditEventService,
+			)
+
+			err := service.Exec(context.TODO(), data.token, data.organizationID, data.invitationID)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			deleteInvitationRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
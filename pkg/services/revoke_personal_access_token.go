@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+type RevokePersonalAccessTokenService interface {
+	Exec(ctx context.Context, token string, id string) error
+}
+
+type revokePersonalAccessTokenServiceImpl struct {
+	auth AuthenticateService
+	dao  dao.DeletePersonalAccessTokenRepository
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *revokePersonalAccessTokenServiceImpl) Exec(ctx context.Context, token string, id string) error {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return err
+	}
+
+	if user.Scopes != nil {
+		return ErrInsufficientScope
+	}
+
+	tokenID, err := uuid.Parse(id)
+	if err != nil {
+		return errors.Join(ErrInvalidPersonalAccessTokenID, err)
+	}
+
+	if err := s.dao.DeletePersonalAccessToken(ctx, user.FirebaseUID, tokenID); err != nil {
+		if errors.Is(err, dao.ErrPersonalAccessTokenNotFound) {
+			return ErrPersonalAccessTokenNotFound
+		}
+
+		return err
+	}
+
+	return s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   user.FirebaseUID,
+		SubjectUID: user.FirebaseUID,
+		Target:     "personal_access_tokens/" + tokenID.String(),
+		Action:     models.AuditActionRevokePersonalAccessToken,
+		Outcome:    models.AuditOutcomeSuccess,
+	})
+}
+
+func NewRevokePersonalAccessTokenService(
+	auth AuthenticateService,
+	dao dao.DeletePersonalAccessTokenRepository,
+	recordAuditEvent RecordAuditEventService,
+) RevokePersonalAccessTokenService {
+	return &revokePersonalAccessTokenServiceImpl{
+		auth:             auth,
+		dao:              dao,
+		recordAuditEvent: recordAuditEvent,
+	}
+}
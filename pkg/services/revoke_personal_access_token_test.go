@@ -0,0 +1,128 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	testData := []struct {
+		name string
+
+		token string
+		id    string
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name:  "RevokePersonalAccessToken",
+			token: "foo-token",
+			id:    "00000000-0000-0000-0000-000000000001",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name:      "AuthError",
+			token:     "foo-token",
+			id:        "00000000-0000-0000-0000-000000000001",
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:  "AuthenticatedWithPersonalAccessToken",
+			token: "inr_pat_foo",
+			id:    "00000000-0000-0000-0000-000000000001",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+				Scopes:      []string{models.ScopeUserWrite},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidID",
+			token: "foo-token",
+			id:    "not-a-uuid",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			expectErr: services.ErrInvalidPersonalAccessTokenID,
+		},
+		{
+			name:  "PersonalAccessTokenNotFound",
+			token: "foo-token",
+			id:    "00000000-0000-0000-0000-000000000001",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrPersonalAccessTokenNotFound,
+			expectErr:        services.ErrPersonalAccessTokenNotFound,
+		},
+		{
+			name:  "DeleteError",
+			token: "foo-token",
+			id:    "00000000-0000-0000-0000-000000000001",
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallDelete: true,
+			deleteErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			deleteRepository := daomocks.NewMockDeletePersonalAccessTokenRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallDelete {
+				deleteRepository.
+					On("DeletePersonalAccessToken", context.TODO(), data.authResponse.FirebaseUID, uuid.MustParse(data.id)).
+					Return(data.deleteErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.RecordAuditEvent) bool {
+						return in.Action == models.AuditActionRevokePersonalAccessToken && in.Outcome == models.AuditOutcomeSuccess
+					})).
+					Return(nil)
+			}
+
+			service := services.NewRevokePersonalAccessTokenService(authService, deleteRepository, recordAuditEventService)
+
+			err := service.Exec(context.TODO(), data.token, data.id)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			authService.AssertExpectations(t)
+			deleteRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
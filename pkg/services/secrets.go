@@ -0,0 +1,26 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const secretEntropy = 32
+
+// generateSecret returns a random, URL-safe secret. The prefix makes the kind of secret recognizable at a glance.
+func generateSecret(prefix string) (string, error) {
+	raw := make([]byte, secretEntropy)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return prefix + base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Secrets carry enough entropy that a fast, unsalted hash is sufficient, and it allows for direct lookups.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"time"
+)
+
+// SendEmailService renders and sends a transactional email, unless the recipient already received too many emails
+// of the same kind recently.
+type SendEmailService interface {
+	Exec(ctx context.Context, data *models.SendEmail) error
+}
+
+type sendEmailServiceImpl struct {
+	renderer  clients.EmailRenderer
+	mailer    clients.Mailer
+	createDAO dao.CreateSentEmailRepository
+	deleteDAO dao.DeleteSentEmailRepository
+	throttle  models.EmailThrottle
+}
+
+func (s *sendEmailServiceImpl) Exec(ctx context.Context, data *models.SendEmail) error {
+	subject, html, err := s.renderer.Render(data.Kind, data.Locale, data.Data)
+	if err != nil {
+		return err
+	}
+
+	// The email is recorded before it is sent, so concurrent requests cannot exceed the throttle.
+	sentEmail, err := s.createDAO.CreateSentEmail(ctx, &dao.CreateSentEmailData{
+		Recipient: data.To,
+		Kind:      data.Kind,
+		Since:     time.Now().Add(-s.throttle.Window),
+		Max:       s.throttle.Max,
+	})
+	if err != nil {
+		if errors.Is(err, dao.ErrSentEmailThrottled) {
+			return ErrEmailThrottled
+		}
+
+		return err
+	}
+
+	if err := s.mailer.Send(ctx, &clients.Email{To: data.To, Subject: subject, HTML: html}); err != nil {
+		// Emails that could not be sent do not count toward the throttle. The context may be done already.
+		deleteErr := s.deleteDAO.DeleteSentEmail(context.WithoutCancel(ctx), *sentEmail.ID)
+		return errors.Join(err, deleteErr)
+	}
+
+	return nil
+}
+
+func NewSendEmailService(
+	renderer clients.EmailRenderer,
+	mailer clients.Mailer,
+	createDAO dao.CreateSentEmailRepository,
+	deleteDAO dao.DeleteSentEmailRepository,
+	throttle models.EmailThrottle,
+) SendEmailService {
+	return &sendEmailServiceImpl{
+		renderer:  renderer,
+		mailer:    mailer,
+		createDAO: createDAO,
+		deleteDAO: deleteDAO,
+		throttle:  throttle,
+	}
+}
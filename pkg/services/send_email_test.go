@@ -0,0 +1,149 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/clients"
+	clientsmocks "github.com/in-rich/uservice-authentication/pkg/clients/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSendEmail(t *testing.T) {
+	throttle := models.EmailThrottle{Window: time.Hour, Max: 3}
+	sentEmailID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	sendEmailData := &models.SendEmail{
+		Kind:   models.EmailKindEmailVerification,
+		To:     "user@gmail.com",
+		Locale: "fr",
+		Data: &models.EmailLinkData{
+			Email: "user@gmail.com",
+			Link:  "https://example.com",
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		data *models.SendEmail
+
+		renderErr error
+
+		shouldCallCreate bool
+		createErr        error
+
+		shouldCallSend bool
+		sendErr        error
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name:             "SendEmail",
+			data:             sendEmailData,
+			shouldCallCreate: true,
+			shouldCallSend:   true,
+		},
+		{
+			name:             "Throttled",
+			data:             sendEmailData,
+			shouldCallCreate: true,
+			createErr:        dao.ErrSentEmailThrottled,
+			expectErr:        services.ErrEmailThrottled,
+		},
+		{
+			name:      "RenderError",
+			data:      sendEmailData,
+			renderErr: FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:             "CreateError",
+			data:             sendEmailData,
+			shouldCallCreate: true,
+			createErr:        FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name:             "SendError",
+			data:             sendEmailData,
+			shouldCallCreate: true,
+			shouldCallSend:   true,
+			sendErr:          FooErr,
+			shouldCallDelete: true,
+			expectErr:        FooErr,
+		},
+		{
+			name:             "DeleteError",
+			data:             sendEmailData,
+			shouldCallCreate: true,
+			shouldCallSend:   true,
+			sendErr:          FooErr,
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrSentEmailNotFound,
+			expectErr:        dao.ErrSentEmailNotFound,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			renderer := clientsmocks.NewMockEmailRenderer(t)
+			mailer := clientsmocks.NewMockMailer(t)
+			createRepository := daomocks.NewMockCreateSentEmailRepository(t)
+			deleteRepository := daomocks.NewMockDeleteSentEmailRepository(t)
+
+			renderer.
+				On("Render", data.data.Kind, data.data.Locale, data.data.Data).
+				Return("subject", "html", data.renderErr)
+
+			if data.shouldCallCreate {
+				createRepository.
+					On("CreateSentEmail", context.TODO(), mock.MatchedBy(func(in *dao.CreateSentEmailData) bool {
+						return in.Recipient == data.data.To && in.Kind == data.data.Kind && in.Max == throttle.Max
+					})).
+					Return(
+						lo.Ternary(
+							data.createErr == nil,
+							&entities.SentEmail{ID: &sentEmailID, Recipient: data.data.To, Kind: data.data.Kind},
+							nil,
+						),
+						data.createErr,
+					)
+			}
+
+			if data.shouldCallSend {
+				mailer.
+					On("Send", context.TODO(), &clients.Email{To: "user@gmail.com", Subject: "subject", HTML: "html"}).
+					Return(data.sendErr)
+			}
+
+			if data.shouldCallDelete {
+				deleteRepository.
+					On("DeleteSentEmail", mock.Anything, sentEmailID).
+					Return(data.deleteErr)
+			}
+
+			service := services.NewSendEmailService(renderer, mailer, createRepository, deleteRepository, throttle)
+
+			err := service.Exec(context.TODO(), data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			renderer.AssertExpectations(t)
+			mailer.AssertExpectations(t)
+			createRepository.AssertExpectations(t)
+			deleteRepository.AssertExpectations(t)
+		})
+	}
+}
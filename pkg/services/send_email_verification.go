@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+// SendEmailVerificationService sends a new email verification link to the authenticated user. It cannot rely on
+// AuthenticateService, since the latter rejects users whose email is not verified.
+type SendEmailVerificationService interface {
+	Exec(ctx context.Context, token string, locale string) error
+}
+
+type sendEmailVerificationServiceImpl struct {
+	client    *auth.Client
+	sendEmail SendEmailService
+}
+
+func (s *sendEmailVerificationServiceImpl) Exec(ctx context.Context, token string, locale string) error {
+	if token == "" {
+		return ErrUnauthenticated
+	}
+
+	authToken, err := s.client.VerifyIDToken(ctx, token)
+	if err != nil {
+		return errors.Join(ErrVerifyToken, err)
+	}
+
+	client, err := firebaseUsersForTenant(s.client, authToken.Firebase.Tenant)
+	if err != nil {
+		return err
+	}
+
+	user, err := client.GetUser(ctx, authToken.UID)
+	if err != nil {
+		return err
+	}
+
+	if user.Email == "" {
+		return ErrNoEmail
+	}
+
+	if user.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+
+	link, err := client.EmailVerificationLink(ctx, user.Email)
+	if err != nil {
+		return err
+	}
+
+	return s.sendEmail.Exec(ctx, &models.SendEmail{
+		Kind:   models.EmailKindEmailVerification,
+		To:     user.Email,
+		Locale: locale,
+		Data: &models.EmailLinkData{
+			Email:       user.Email,
+			DisplayName: user.DisplayName,
+			Link:        link,
+		},
+	})
+}
+
+func NewSendEmailVerificationService(client *auth.Client, sendEmail SendEmailService) SendEmailVerificationService {
+	return &sendEmailVerificationServiceImpl{
+		client:    client,
+		sendEmail: sendEmail,
+	}
+}
@@ -0,0 +1,106 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var sendEmailVerificationFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+	{
+		Email:         "user2@gmail.com",
+		EmailVerified: false,
+		DisplayName:   "user two",
+		UID:           "user-two-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+}
+
+func TestSendEmailVerification(t *testing.T) {
+	require.NoError(t, CreateUsersFixtures(sendEmailVerificationFixtures))
+	defer CleanUsersFixtures(sendEmailVerificationFixtures)
+
+	verifiedIDToken := getIDToken(t, "user-one-uid")
+	notVerifiedIDToken := getIDToken(t, "user-two-uid")
+
+	testData := []struct {
+		name string
+
+		token  string
+		locale string
+
+		shouldCallSendEmail bool
+		sendEmailErr        error
+
+		expectErr error
+	}{
+		{
+			name:                "SendEmailVerification",
+			token:               notVerifiedIDToken,
+			locale:              "fr",
+			shouldCallSendEmail: true,
+		},
+		{
+			name:      "EmptyToken",
+			token:     "",
+			expectErr: services.ErrUnauthenticated,
+		},
+		{
+			name:      "InvalidToken",
+			token:     "invalid-token",
+			expectErr: services.ErrVerifyToken,
+		},
+		{
+			name:      "EmailAlreadyVerified",
+			token:     verifiedIDToken,
+			expectErr: services.ErrEmailAlreadyVerified,
+		},
+		{
+			name:                "SendEmailError",
+			token:               notVerifiedIDToken,
+			locale:              "fr",
+			shouldCallSendEmail: true,
+			sendEmailErr:        services.ErrEmailThrottled,
+			expectErr:           services.ErrEmailThrottled,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			sendEmailService := servicesmocks.NewMockSendEmailService(t)
+
+			if data.shouldCallSendEmail {
+				sendEmailService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.SendEmail) bool {
+						return in.Kind == models.EmailKindEmailVerification &&
+							in.To == "user2@gmail.com" &&
+							in.Locale == data.locale &&
+							in.Data.Link != ""
+					})).
+					Return(data.sendEmailErr)
+			}
+
+			service := services.NewSendEmailVerificationService(config.AuthClient, sendEmailService)
+
+			err := service.Exec(context.TODO(), data.token, data.locale)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			sendEmailService.AssertExpectations(t)
+		})
+	}
+}
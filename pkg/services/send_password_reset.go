@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+)
+
+type SendPasswordResetService interface {
+	Exec(ctx context.Context, tenantID string, email string, locale string) error
+}
+
+type sendPasswordResetServiceImpl struct {
+	client    *auth.Client
+	sendEmail SendEmailService
+}
+
+func (s *sendPasswordResetServiceImpl) Exec(ctx context.Context, tenantID string, email string, locale string) error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Var(email, "required,email"); err != nil {
+		return errors.Join(ErrInvalidEmail, err)
+	}
+
+	client, err := firebaseUsersForTenant(s.client, tenantID)
+	if err != nil {
+		return err
+	}
+
+	user, err := client.GetUserByEmail(ctx, email)
+	if err != nil {
+		// Do not disclose whether an account exists for this email.
+		if auth.IsUserNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	link, err := client.PasswordResetLink(ctx, user.Email)
+	if err != nil {
+		return err
+	}
+
+	err = s.sendEmail.Exec(ctx, &models.SendEmail{
+		Kind:   models.EmailKindPasswordReset,
+		To:     user.Email,
+		Locale: locale,
+		Data: &models.EmailLinkData{
+			Email:       user.Email,
+			DisplayName: user.DisplayName,
+			Link:        link,
+		},
+	})
+	// Unknown emails are never throttled, so reporting it would disclose that the account exists.
+	if errors.Is(err, ErrEmailThrottled) {
+		return nil
+	}
+
+	return err
+}
+
+func NewSendPasswordResetService(client *auth.Client, sendEmail SendEmailService) SendPasswordResetService {
+	return &sendPasswordResetServiceImpl{
+		client:    client,
+		sendEmail: sendEmail,
+	}
+}
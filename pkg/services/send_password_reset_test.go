@@ -0,0 +1,97 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/config"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var sendPasswordResetFixtures = []*FixtureUser{
+	{
+		Email:         "user@gmail.com",
+		EmailVerified: true,
+		DisplayName:   "user one",
+		UID:           "user-one-uid",
+		Password:      "password",
+		PhotoURL:      "https://image.png",
+	},
+}
+
+func TestSendPasswordReset(t *testing.T) {
+	require.NoError(t, CreateUsersFixtures(sendPasswordResetFixtures))
+	defer CleanUsersFixtures(sendPasswordResetFixtures)
+
+	testData := []struct {
+		name string
+
+		email  string
+		locale string
+
+		shouldCallSendEmail bool
+		sendEmailErr        error
+
+		expectErr error
+	}{
+		{
+			name:                "SendPasswordReset",
+			email:               "user@gmail.com",
+			locale:              "en",
+			shouldCallSendEmail: true,
+		},
+		{
+			name:   "UnknownEmail",
+			email:  "unknown@gmail.com",
+			locale: "en",
+		},
+		{
+			name:      "InvalidEmail",
+			email:     "not-an-email",
+			expectErr: services.ErrInvalidEmail,
+		},
+		{
+			name:                "Throttled",
+			email:               "user@gmail.com",
+			locale:              "en",
+			shouldCallSendEmail: true,
+			sendEmailErr:        services.ErrEmailThrottled,
+		},
+		{
+			name:                "SendEmailError",
+			email:               "user@gmail.com",
+			locale:              "en",
+			shouldCallSendEmail: true,
+			sendEmailErr:        FooErr,
+			expectErr:           FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			sendEmailService := servicesmocks.NewMockSendEmailService(t)
+
+			if data.shouldCallSendEmail {
+				sendEmailService.
+					On("Exec", context.TODO(), mock.MatchedBy(func(in *models.SendEmail) bool {
+						return in.Kind == models.EmailKindPasswordReset &&
+							in.To == data.email &&
+							in.Locale == data.locale &&
+							in.Data.Link != ""
+					})).
+					Return(data.sendEmailErr)
+			}
+
+			service := services.NewSendPasswordResetService(config.AuthClient, sendEmailService)
+
+			err := service.Exec(context.TODO(), "", data.email, data.locale)
+
+			require.ErrorIs(t, err, data.expectErr)
+
+			sendEmailService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package services
+
+import "strings"
+
+// sessionCookieIssuerPrefix is the issuer of Firebase session cookies. ID tokens are issued by
+// https://securetoken.google.com/ instead.
+const sessionCookieIssuerPrefix = "https://session.firebase.google.com/"
+
+// isSessionCookie tells session cookies apart from ID tokens by their issuer, so they can be verified with the right
+// method. The token is not verified here.
+func isSessionCookie(token string) bool {
+	return strings.HasPrefix(unverifiedIssuer(token), sessionCookieIssuerPrefix)
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/auth"
+	"google.golang.org/api/iterator"
+)
+
+// firebaseUsers is implemented by both the project-level Firebase client and the Identity Platform tenant clients.
+type firebaseUsers interface {
+	GetUser(ctx context.Context, uid string) (*auth.UserRecord, error)
+	GetUsers(ctx context.Context, identifiers []auth.UserIdentifier) (*auth.GetUsersResult, error)
+	GetUserByEmail(ctx context.Context, email string) (*auth.UserRecord, error)
+	EmailVerificationLink(ctx context.Context, email string) (string, error)
+	PasswordResetLink(ctx context.Context, email string) (string, error)
+	UpdateUser(ctx context.Context, uid string, user *auth.UserToUpdate) (*auth.UserRecord, error)
+	DeleteUser(ctx context.Context, uid string) error
+	Users(ctx context.Context, nextPageToken string) *auth.UserIterator
+	CustomTokenWithClaims(ctx context.Context, uid string, devClaims map[string]interface{}) (string, error)
+}
+
+// firebaseUsersForTenant returns a client scoped to the given Identity Platform tenant. An empty tenant designates
+// users that do not belong to any tenant, managed by the project-level client.
+func firebaseUsersForTenant(client *auth.Client, tenantID string) (firebaseUsers, error) {
+	if tenantID == "" {
+		return client, nil
+	}
+
+	return client.TenantManager.AuthForTenant(tenantID)
+}
+
+// listTenantIDs returns every Identity Platform tenant of the project, preceded by the empty tenant of project-level
+// users.
+func listTenantIDs(ctx context.Context, client *auth.Client) ([]string, error) {
+	tenantIDs := []string{""}
+
+	tenants := client.TenantManager.Tenants(ctx, "")
+	for {
+		tenant, err := tenants.Next()
+		if errors.Is(err, iterator.Done) {
+			return tenantIDs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tenantIDs = append(tenantIDs, tenant.ID)
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// TrackUserActivityService records a successful authentication in memory. It is written to the database by
+// FlushUserActivityService.
+type TrackUserActivityService interface {
+	Exec(ctx context.Context, data *models.TrackUserActivity)
+}
+
+type trackUserActivityServiceImpl struct {
+	buffer         *UserActivityBuffer
+	trustedProxies int
+}
+
+func (s *trackUserActivityServiceImpl) Exec(ctx context.Context, data *models.TrackUserActivity) {
+	peerIP, userAgent := requestMetadata(ctx, s.trustedProxies)
+
+	s.buffer.add(&dao.RecordUserActivityData{
+		TenantID:            data.TenantID,
+		FirebaseUID:         data.FirebaseUID,
+		LastSeenAt:          time.Now(),
+		AuthenticationCount: 1,
+		LastPeerIP:          lo.EmptyableToPtr(peerIP),
+		LastUserAgent:       lo.EmptyableToPtr(userAgent),
+	})
+}
+
+// NewTrackUserActivityService reads the address of the caller behind trustedProxies proxies. See ClientIP.
+func NewTrackUserActivityService(buffer *UserActivityBuffer, trustedProxies int) TrackUserActivityService {
+	return &trackUserActivityServiceImpl{
+		buffer:         buffer,
+		trustedProxies: trustedProxies,
+	}
+}
@@ -0,0 +1,125 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrackUserActivity(t *testing.T) {
+	firstCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "203.0.113.7", "user-agent", "grpc-go/1.64.0",
+	))
+	secondCtx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		"x-forwarded-for", "203.0.113.8", "user-agent", "grpc-go/1.67.0",
+	))
+
+	testData := []struct {
+		name string
+
+		calls []*models.TrackUserActivity
+		ctxs  []context.Context
+
+		expect []*dao.RecordUserActivityData
+	}{
+		{
+			name:  "TrackUserActivity",
+			calls: []*models.TrackUserActivity{{FirebaseUID: "firebase-uid-1"}},
+			ctxs:  []context.Context{firstCtx},
+			expect: []*dao.RecordUserActivityData{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					AuthenticationCount: 1,
+					LastPeerIP:          lo.ToPtr("203.0.113.7"),
+					LastUserAgent:       lo.ToPtr("grpc-go/1.64.0"),
+				},
+			},
+		},
+		{
+			// Hot users only cost a single write, with the metadata of their latest authentication.
+			name: "Coalesce",
+			calls: []*models.TrackUserActivity{
+				{FirebaseUID: "firebase-uid-1"},
+				{FirebaseUID: "firebase-uid-1"},
+				{FirebaseUID: "firebase-uid-1"},
+			},
+			ctxs: []context.Context{firstCtx, firstCtx, secondCtx},
+			expect: []*dao.RecordUserActivityData{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					AuthenticationCount: 3,
+					LastPeerIP:          lo.ToPtr("203.0.113.8"),
+					LastUserAgent:       lo.ToPtr("grpc-go/1.67.0"),
+				},
+			},
+		},
+		{
+			name: "SameUIDInAnotherTenant",
+			calls: []*models.TrackUserActivity{
+				{FirebaseUID: "firebase-uid-1"},
+				{TenantID: "tenant-1", FirebaseUID: "firebase-uid-1"},
+			},
+			ctxs: []context.Context{context.TODO(), context.TODO()},
+			expect: []*dao.RecordUserActivityData{
+				{
+					FirebaseUID:         "firebase-uid-1",
+					AuthenticationCount: 1,
+				},
+				{
+					TenantID:            "tenant-1",
+					FirebaseUID:         "firebase-uid-1",
+					AuthenticationCount: 1,
+				},
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			recordUserActivitiesRepository := daomocks.NewMockRecordUserActivitiesRepository(t)
+
+			var recorded []*dao.RecordUserActivityData
+			recordUserActivitiesRepository.
+				On("RecordUserActivities", context.TODO(), mock.Anything).
+				Run(func(args mock.Arguments) {
+					recorded = args.Get(1).([]*dao.RecordUserActivityData)
+				}).
+				Return(nil)
+
+			buffer := services.NewUserActivityBuffer()
+			service := services.NewTrackUserActivityService(buffer, 1)
+
+			for i, call := range data.calls {
+				service.Exec(data.ctxs[i], call)
+			}
+
+			count, err := services.NewFlushUserActivityService(buffer, recordUserActivitiesRepository).Exec(context.TODO())
+
+			require.NoError(t, err)
+			require.Equal(t, len(data.expect), count)
+
+			for _, activity := range recorded {
+				require.False(t, activity.LastSeenAt.IsZero())
+				// Since the time is set by the service, nullify it for comparison.
+				activity.LastSeenAt = time.Time{}
+			}
+
+			slices.SortFunc(recorded, func(a, b *dao.RecordUserActivityData) int {
+				return strings.Compare(a.TenantID, b.TenantID)
+			})
+			require.Equal(t, data.expect, recorded)
+
+			recordUserActivitiesRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+)
+
+// UpdateOrganizationService changes the security policy of an organization. Only its owners can change it, and not
+// through personal access tokens or impersonation sessions.
+type UpdateOrganizationService interface {
+	Exec(ctx context.Context, token string, data *models.UpdateOrganization) (*models.Organization, error)
+}
+
+type updateOrganizationServiceImpl struct {
+	auth                         AuthenticateService
+	getMembershipRepository      dao.GetMembershipRepository
+	updateOrganizationRepository dao.UpdateOrganizationRepository
+
+	recordAuditEvent RecordAuditEventService
+}
+
+func (s *updateOrganizationServiceImpl) Exec(
+	ctx context.Context, token string, data *models.UpdateOrganization,
+) (*models.Organization, error) {
+	user, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Scopes != nil {
+		return nil, ErrInsufficientScope
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(data); err != nil {
+		return nil, errors.Join(ErrInvalidUpdateOrganization, err)
+	}
+
+	organizationID, err := uuid.Parse(data.OrganizationID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidOrganizationID, err)
+	}
+
+	membership, err := s.getMembershipRepository.GetMembership(ctx, organizationID, user.FirebaseUID)
+	if err != nil {
+		if errors.Is(err, dao.ErrMembershipNotFound) {
+			return nil, ErrNotOrganizationMember
+		}
+
+		return nil, err
+	}
+
+	if membership.Role != entities.MembershipRoleOwner {
+		return nil, ErrInsufficientOrganizationRole
+	}
+
+	organization, err := s.updateOrganizationRepository.UpdateOrganization(
+		ctx, organizationID, &dao.UpdateOrganizationData{RequireMFA: data.RequireMFA},
+	)
+	if err != nil {
+		if errors.Is(err, dao.ErrOrganizationNotFound) {
+			return nil, ErrOrganizationNotFound
+		}
+
+		return nil, err
+	}
+
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID: user.FirebaseUID,
+		Target:   "organizations/" + organizationID.String(),
+		Action:   models.AuditActionUpdateOrganization,
+		Outcome:  models.AuditOutcomeSuccess,
+		Diff: auditDiff(map[string]*models.AuditChange{
+			"requireMFA": {Before: lo.FromPtr(membership.Organization).RequireMFA, After: organization.RequireMFA},
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return organizationToModel(organization), nil
+}
+
+func NewUpdateOrganizationService(
+	auth AuthenticateService,
+	getMembershipRepository dao.GetMembershipRepository,
+	updateOrganizationRepository dao.UpdateOrganizationRepository,
+	recordAuditEvent RecordAuditEventService,
+) UpdateOrganizationService {
+	return &updateOrganizationServiceImpl{
+		auth:                         auth,
+		getMembershipRepository:      getMembershipRepository,
+		updateOrganizationRepository: updateOrganizationRepository,
+		recordAuditEvent:             recordAuditEvent,
+	}
+}
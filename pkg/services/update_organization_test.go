@@ -0,0 +1,241 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUpdateOrganization(t *testing.T) {
+	organization := &entities.Organization{
+		ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Name: "organization-1",
+	}
+
+	testData := []struct {
+		name string
+
+		token string
+		data  *models.UpdateOrganization
+
+		authResponse *models.User
+		authErr      error
+
+		shouldCallGetMembership bool
+		getMembershipResponse   *entities.Membership
+		getMembershipErr        error
+
+		shouldCallUpdateOrganization bool
+		updateOrganizationResponse   *entities.Organization
+		updateOrganizationErr        error
+
+		expectAudit map[string]*models.AuditChange
+		expect      *models.Organization
+		expectErr   error
+	}{
+		{
+			name:  "RequireMFA",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+				RequireMFA:     true,
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallUpdateOrganization: true,
+			updateOrganizationResponse: &entities.Organization{
+				ID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Name:       "organization-1",
+				RequireMFA: true,
+			},
+			expectAudit: map[string]*models.AuditChange{
+				"requireMFA": {Before: false, After: true},
+			},
+			expect: &models.Organization{
+				ID:         "00000000-0000-0000-0000-000000000001",
+				Name:       "organization-1",
+				RequireMFA: true,
+			},
+		},
+		{
+			name:  "Unchanged",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallUpdateOrganization: true,
+			updateOrganizationResponse:   organization,
+			expectAudit:                  map[string]*models.AuditChange{},
+			expect: &models.Organization{
+				ID:   "00000000-0000-0000-0000-000000000001",
+				Name: "organization-1",
+			},
+		},
+		{
+			name:  "AuthError",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			// Personal access tokens and impersonation sessions cannot change the security policy.
+			name:  "InsufficientScope",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Scopes:      []string{models.ScopeUserRead, models.ScopeUserWrite},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
+		{
+			name:  "InvalidData",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "foo",
+			},
+			authResponse: &models.User{FirebaseUID: "user-one-uid"},
+			expectErr:    services.ErrInvalidUpdateOrganization,
+		},
+		{
+			name:  "NotOrganizationMember",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipErr:        dao.ErrMembershipNotFound,
+			expectErr:               services.ErrNotOrganizationMember,
+		},
+		{
+			name:  "NotOwner",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleAdmin,
+				Organization: organization,
+			},
+			expectErr: services.ErrInsufficientOrganizationRole,
+		},
+		{
+			// The organization may have been deleted since the membership was read.
+			name:  "OrganizationNotFound",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallUpdateOrganization: true,
+			updateOrganizationErr:        dao.ErrOrganizationNotFound,
+			expectErr:                    services.ErrOrganizationNotFound,
+		},
+		{
+			name:  "UpdateOrganizationError",
+			token: "foo-token",
+			data: &models.UpdateOrganization{
+				OrganizationID: "00000000-0000-0000-0000-000000000001",
+			},
+			authResponse:            &models.User{FirebaseUID: "user-one-uid"},
+			shouldCallGetMembership: true,
+			getMembershipResponse: &entities.Membership{
+				FirebaseUID:  "user-one-uid",
+				Role:         entities.MembershipRoleOwner,
+				Organization: organization,
+			},
+			shouldCallUpdateOrganization: true,
+			updateOrganizationErr:        FooErr,
+			expectErr:                    FooErr,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			authService := servicesmocks.NewMockAuthenticateService(t)
+			getMembershipRepository := daomocks.NewMockGetMembershipRepository(t)
+			updateOrganizationRepository := daomocks.NewMockUpdateOrganizationRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
+
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
+
+			if data.shouldCallGetMembership {
+				getMembershipRepository.
+					On("GetMembership", context.TODO(), uuid.MustParse(data.data.OrganizationID), data.authResponse.FirebaseUID).
+					Return(data.getMembershipResponse, data.getMembershipErr)
+			}
+
+			if data.shouldCallUpdateOrganization {
+				updateOrganizationRepository.
+					On(
+						"UpdateOrganization",
+						context.TODO(),
+						uuid.MustParse(data.data.OrganizationID),
+						&dao.UpdateOrganizationData{RequireMFA: data.data.RequireMFA},
+					).
+					Return(data.updateOrganizationResponse, data.updateOrganizationErr)
+			}
+
+			if data.expectErr == nil {
+				recordAuditEventService.
+					On("Exec", context.TODO(), &models.RecordAuditEvent{
+						ActorUID: data.authResponse.FirebaseUID,
+						Target:   "organizations/" + data.data.OrganizationID,
+						Action:   models.AuditActionUpdateOrganization,
+						Outcome:  models.AuditOutcomeSuccess,
+						Diff:     data.expectAudit,
+					}).
+					Return(nil)
+			}
+
+			service := services.NewUpdateOrganizationService(
+				authService, getMembershipRepository, updateOrganizationRepository, recordAuditEventService,
+			)
+
+			organization, err := service.Exec(context.TODO(), data.token, data.data)
+
+			require.ErrorIs(t, err, data.expectErr)
+			require.Equal(t, data.expect, organization)
+
+			authService.AssertExpectations(t)
+			getMembershipRepository.AssertExpectations(t)
+			updateOrganizationRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/in-rich/uservice-authentication/pkg/dao"
 	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
 )
 
 type UpdateUserService interface {
@@ -16,39 +18,58 @@ type updateUserServiceImpl struct {
 	auth      AuthenticateService
 	createDAO dao.CreateUserRepository
 	updateDAO dao.UpdateUserRepository
+
+	recordAuditEvent RecordAuditEventService
+
+	// maxAuthAge is how recently the user must have signed in to change their public identifier. Choosing the first
+	// one is part of signing up, and is not subject to it.
+	maxAuthAge time.Duration
 }
 
 func (s *updateUserServiceImpl) Exec(ctx context.Context, token string, data *models.UpdateUser) (*models.User, error) {
-	firebaseUser, err := s.auth.Exec(ctx, token)
+	firebaseUser, err := s.auth.Exec(ctx, &models.Authenticate{Token: token})
 	if err != nil {
 		return nil, err
 	}
 
+	if firebaseUser.Scopes != nil && !lo.Contains(firebaseUser.Scopes, models.ScopeUserWrite) {
+		return nil, ErrInsufficientScope
+	}
+
+	// Personal access tokens have no sign-in time, and can never change an existing public identifier.
+	current := firebaseUser.PublicIdentifier
+	if current != "" && current != data.PublicIdentifier && s.maxAuthAge > 0 &&
+		time.Since(firebaseUser.AuthTime) > s.maxAuthAge {
+		return nil, ErrReauthenticationRequired
+	}
+
 	validate := validator.New(validator.WithRequiredStructEnabled())
 	if err := validate.Struct(data); err != nil {
 		return nil, errors.Join(ErrInvalidUpdateUser, err)
 	}
 
-	user, err := s.createDAO.CreateUser(ctx, firebaseUser.FirebaseUID, &dao.CreateUserData{
+	user, err := s.createDAO.CreateUser(ctx, firebaseUser.TenantID, firebaseUser.FirebaseUID, &dao.CreateUserData{
 		PublicIdentifier: data.PublicIdentifier,
 	})
 
-	// User was successfully created.
-	if err == nil {
-		return &models.User{
-			PublicIdentifier: user.PublicIdentifier,
-			FirebaseUID:      firebaseUser.FirebaseUID,
-			Email:            firebaseUser.Email,
-		}, nil
+	if errors.Is(err, dao.ErrUserAlreadyExists) {
+		// User already existed. Update it.
+		user, err = s.updateDAO.UpdateUser(ctx, firebaseUser.TenantID, firebaseUser.FirebaseUID, &dao.UpdateUserData{
+			PublicIdentifier: data.PublicIdentifier,
+		})
 	}
-
-	if !errors.Is(err, dao.ErrUserAlreadyExists) {
+	if err != nil {
 		return nil, err
 	}
 
-	// User already existed. Update it.
-	user, err = s.updateDAO.UpdateUser(ctx, firebaseUser.FirebaseUID, &dao.UpdateUserData{
-		PublicIdentifier: data.PublicIdentifier,
+	err = s.recordAuditEvent.Exec(ctx, &models.RecordAuditEvent{
+		ActorUID:   firebaseUser.FirebaseUID,
+		SubjectUID: firebaseUser.FirebaseUID,
+		Action:     models.AuditActionUpdateUser,
+		Outcome:    models.AuditOutcomeSuccess,
+		Diff: auditDiff(map[string]*models.AuditChange{
+			"publicIdentifier": {Before: firebaseUser.PublicIdentifier, After: user.PublicIdentifier},
+		}),
 	})
 	if err != nil {
 		return nil, err
@@ -56,6 +77,7 @@ func (s *updateUserServiceImpl) Exec(ctx context.Context, token string, data *mo
 
 	return &models.User{
 		PublicIdentifier: user.PublicIdentifier,
+		TenantID:         firebaseUser.TenantID,
 		FirebaseUID:      firebaseUser.FirebaseUID,
 		Email:            firebaseUser.Email,
 	}, nil
@@ -65,10 +87,14 @@ func NewUpdateUserService(
 	auth AuthenticateService,
 	createDAO dao.CreateUserRepository,
 	updateDAO dao.UpdateUserRepository,
+	recordAuditEvent RecordAuditEventService,
+	maxAuthAge time.Duration,
 ) UpdateUserService {
 	return &updateUserServiceImpl{
-		auth:      auth,
-		createDAO: createDAO,
-		updateDAO: updateDAO,
+		auth:             auth,
+		createDAO:        createDAO,
+		updateDAO:        updateDAO,
+		recordAuditEvent: recordAuditEvent,
+		maxAuthAge:       maxAuthAge,
 	}
 }
@@ -10,6 +10,7 @@ import (
 	servicesmocks "github.com/in-rich/uservice-authentication/pkg/services/mocks"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestUpdateUser(t *testing.T) {
@@ -43,6 +44,7 @@ func TestUpdateUser(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
+				AuthTime:         time.Now(),
 			},
 			shouldCallCreateUser: true,
 			createUserResponse: &entities.User{
@@ -65,6 +67,7 @@ func TestUpdateUser(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
+				AuthTime:         time.Now(),
 			},
 			shouldCallCreateUser: true,
 			createUserErr:        dao.ErrUserAlreadyExists,
@@ -79,6 +82,82 @@ func TestUpdateUser(t *testing.T) {
 				Email:            "user@gmail.com",
 			},
 		},
+		{
+			name:  "CreateTenantUser",
+			token: "foo-token",
+			data: &models.UpdateUser{
+				PublicIdentifier: "public-identifier-2",
+			},
+			authResponse: &models.User{
+				TenantID:    "tenant-one",
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+			},
+			shouldCallCreateUser: true,
+			createUserResponse: &entities.User{
+				TenantID:         "tenant-one",
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-2",
+			},
+			expect: &models.User{
+				TenantID:         "tenant-one",
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-2",
+				Email:            "user@gmail.com",
+			},
+		},
+		{
+			// Choosing a first public identifier is part of signing up, which may have started long ago.
+			name:  "FirstPublicIdentifier",
+			token: "foo-token",
+			data: &models.UpdateUser{
+				PublicIdentifier: "public-identifier-2",
+			},
+			authResponse: &models.User{
+				FirebaseUID: "user-one-uid",
+				Email:       "user@gmail.com",
+				AuthTime:    time.Now().Add(-time.Hour),
+			},
+			shouldCallCreateUser: true,
+			createUserResponse: &entities.User{
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-2",
+			},
+			expect: &models.User{
+				FirebaseUID:      "user-one-uid",
+				PublicIdentifier: "public-identifier-2",
+				Email:            "user@gmail.com",
+			},
+		},
+		{
+			name:  "ReauthenticationRequired",
+			token: "foo-token",
+			data: &models.UpdateUser{
+				PublicIdentifier: "public-identifier-2",
+			},
+			authResponse: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				AuthTime:         time.Now().Add(-time.Hour),
+			},
+			expectErr: services.ErrReauthenticationRequired,
+		},
+		{
+			// Personal access tokens have no sign-in time.
+			name:  "PersonalAccessTokenReauthenticationRequired",
+			token: "foo-token",
+			data: &models.UpdateUser{
+				PublicIdentifier: "public-identifier-2",
+			},
+			authResponse: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				Scopes:           []string{models.ScopeUserWrite},
+			},
+			expectErr: services.ErrReauthenticationRequired,
+		},
 		{
 			name:  "AuthError",
 			token: "foo-token",
@@ -88,6 +167,20 @@ func TestUpdateUser(t *testing.T) {
 			authErr:   FooErr,
 			expectErr: FooErr,
 		},
+		{
+			name:  "InsufficientScope",
+			token: "foo-token",
+			data: &models.UpdateUser{
+				PublicIdentifier: "public-identifier-2",
+			},
+			authResponse: &models.User{
+				PublicIdentifier: "public-identifier-1",
+				FirebaseUID:      "user-one-uid",
+				Email:            "user@gmail.com",
+				Scopes:           []string{models.ScopeUserRead},
+			},
+			expectErr: services.ErrInsufficientScope,
+		},
 		{
 			name:  "CreateUserError",
 			token: "foo-token",
@@ -98,6 +191,7 @@ func TestUpdateUser(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
+				AuthTime:         time.Now(),
 			},
 			shouldCallCreateUser: true,
 			createUserErr:        FooErr,
@@ -113,6 +207,7 @@ func TestUpdateUser(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				FirebaseUID:      "user-one-uid",
 				Email:            "user@gmail.com",
+				AuthTime:         time.Now(),
 			},
 			shouldCallCreateUser: true,
 			createUserErr:        dao.ErrUserAlreadyExists,
@@ -127,22 +222,37 @@ func TestUpdateUser(t *testing.T) {
 			authService := servicesmocks.NewMockAuthenticateService(t)
 			createUserRepository := daomocks.NewMockCreateUserRepository(t)
 			updateUserRepository := daomocks.NewMockUpdateUserRepository(t)
+			recordAuditEventService := servicesmocks.NewMockRecordAuditEventService(t)
 
-			authService.On("Exec", context.TODO(), data.token).Return(data.authResponse, data.authErr)
+			authService.On("Exec", context.TODO(), &models.Authenticate{Token: data.token}).Return(data.authResponse, data.authErr)
 
 			if data.shouldCallCreateUser {
-				createUserRepository.On("CreateUser", context.TODO(), data.authResponse.FirebaseUID, &dao.CreateUserData{
+				createUserRepository.On("CreateUser", context.TODO(), data.authResponse.TenantID, data.authResponse.FirebaseUID, &dao.CreateUserData{
 					PublicIdentifier: data.data.PublicIdentifier,
 				}).Return(data.createUserResponse, data.createUserErr)
 			}
 
 			if data.shouldCallUpdateUser {
-				updateUserRepository.On("UpdateUser", context.TODO(), data.authResponse.FirebaseUID, &dao.UpdateUserData{
+				updateUserRepository.On("UpdateUser", context.TODO(), data.authResponse.TenantID, data.authResponse.FirebaseUID, &dao.UpdateUserData{
 					PublicIdentifier: data.data.PublicIdentifier,
 				}).Return(data.updateUserResponse, data.updateUserErr)
 			}
 
-			service := services.NewUpdateUserService(authService, createUserRepository, updateUserRepository)
+			if data.expectErr == nil {
+				recordAuditEventService.On("Exec", context.TODO(), &models.RecordAuditEvent{
+					ActorUID:   data.authResponse.FirebaseUID,
+					SubjectUID: data.authResponse.FirebaseUID,
+					Action:     models.AuditActionUpdateUser,
+					Outcome:    models.AuditOutcomeSuccess,
+					Diff: map[string]*models.AuditChange{
+						"publicIdentifier": {Before: data.authResponse.PublicIdentifier, After: data.expect.PublicIdentifier},
+					},
+				}).Return(nil)
+			}
+
+			service := services.NewUpdateUserService(
+				authService, createUserRepository, updateUserRepository, recordAuditEventService, 5*time.Minute,
+			)
 
 			user, err := service.Exec(context.TODO(), data.token, data.data)
 
@@ -152,6 +262,7 @@ func TestUpdateUser(t *testing.T) {
 			authService.AssertExpectations(t)
 			createUserRepository.AssertExpectations(t)
 			updateUserRepository.AssertExpectations(t)
+			recordAuditEventService.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"sync"
+)
+
+type userActivityKey struct {
+	tenantID    string
+	firebaseUID string
+}
+
+// UserActivityBuffer holds the authentications that were not written to the database yet. Authentications of the
+// same user are merged, so a user costs a single write per flush, however often they authenticate.
+type UserActivityBuffer struct {
+	mu      sync.Mutex
+	pending map[userActivityKey]*dao.RecordUserActivityData
+}
+
+func (b *UserActivityBuffer) add(activity *dao.RecordUserActivityData) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := userActivityKey{tenantID: activity.TenantID, firebaseUID: activity.FirebaseUID}
+
+	current, ok := b.pending[key]
+	if !ok {
+		b.pending[key] = activity
+		return
+	}
+
+	current.AuthenticationCount += activity.AuthenticationCount
+	if !activity.LastSeenAt.Before(current.LastSeenAt) {
+		current.LastSeenAt = activity.LastSeenAt
+		current.LastPeerIP = activity.LastPeerIP
+		current.LastUserAgent = activity.LastUserAgent
+	}
+}
+
+// take empties the buffer, and returns its content.
+func (b *UserActivityBuffer) take() []*dao.RecordUserActivityData {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	activities := lo.Values(b.pending)
+	b.pending = make(map[userActivityKey]*dao.RecordUserActivityData)
+
+	return activities
+}
+
+func NewUserActivityBuffer() *UserActivityBuffer {
+	return &UserActivityBuffer{
+		pending: make(map[userActivityKey]*dao.RecordUserActivityData),
+	}
+}
+
+func userActivityToModel(activity *entities.UserActivity) *models.UserActivity {
+	return &models.UserActivity{
+		LastSeenAt:          activity.LastSeenAt,
+		AuthenticationCount: activity.AuthenticationCount,
+		LastPeerIP:          lo.FromPtr(activity.LastPeerIP),
+		LastUserAgent:       lo.FromPtr(activity.LastUserAgent),
+	}
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/samber/lo"
+)
+
+// resolveUserAlias returns the UID of the user uid was linked to, or uid itself if it was never linked.
+func resolveUserAlias(
+	ctx context.Context, repository dao.GetUserAliasRepository, tenantID string, uid string,
+) (string, error) {
+	alias, err := repository.GetUserAlias(ctx, tenantID, uid)
+	if err != nil {
+		if errors.Is(err, dao.ErrUserAliasNotFound) {
+			return uid, nil
+		}
+
+		return "", err
+	}
+
+	return alias.CanonicalUID, nil
+}
+
+// resolveUserAliases is resolveUserAlias for many UIDs at once. UIDs linked to the same user are only returned once.
+func resolveUserAliases(
+	ctx context.Context, repository dao.ListUserAliasesRepository, tenantID string, uids []string,
+) ([]string, error) {
+	aliases, err := repository.ListUserAliases(ctx, tenantID, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalUIDs := lo.SliceToMap(aliases, func(item *entities.UserAlias) (string, string) {
+		return item.AliasUID, item.CanonicalUID
+	})
+
+	return lo.Uniq(lo.Map(uids, func(item string, _ int) string {
+		if canonicalUID, ok := canonicalUIDs[item]; ok {
+			return canonicalUID
+		}
+
+		return item
+	})), nil
+}
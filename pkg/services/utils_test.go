@@ -16,6 +16,8 @@ type FixtureUser struct {
 	UID           string
 	Password      string
 	PhotoURL      string
+	Disabled      bool
+	CustomClaims  map[string]interface{}
 }
 
 func CreateUsersFixtures(fixtures []*FixtureUser) error {
@@ -29,12 +31,19 @@ func CreateUsersFixtures(fixtures []*FixtureUser) error {
 			DisplayName(fixture.DisplayName).
 			UID(fixture.UID).
 			Password(fixture.Password).
-			PhotoURL(fixture.PhotoURL)
+			PhotoURL(fixture.PhotoURL).
+			Disabled(fixture.Disabled)
 
 		_, err := config.AuthClient.CreateUser(context.TODO(), user)
 		if err != nil {
 			return err
 		}
+
+		if fixture.CustomClaims != nil {
+			if err := config.AuthClient.SetCustomUserClaims(context.TODO(), fixture.UID, fixture.CustomClaims); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
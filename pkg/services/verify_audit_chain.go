@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// VerifyAuditChainService walks the whole audit trail, and reports the first event that was tampered with. When
+// maxCheckpointLag is set, the chain is also reported as broken if it has gone longer than that without a checkpoint,
+// which reveals checkpoints deleted from the end of the sequence.
+type VerifyAuditChainService interface {
+	Exec(ctx context.Context) (*models.AuditChainReport, error)
+}
+
+type verifyAuditChainServiceImpl struct {
+	listAuditChainRepository       dao.ListAuditChainRepository
+	listAuditCheckpointsRepository dao.ListAuditCheckpointsRepository
+	key                            []byte
+	maxCheckpointLag               time.Duration
+}
+
+func (s *verifyAuditChainServiceImpl) Exec(ctx context.Context) (*models.AuditChainReport, error) {
+	if len(s.key) == 0 {
+		return nil, ErrAuditCheckpointKeyMissing
+	}
+
+	checkpoints, err := s.listAuditCheckpointsRepository.ListAuditCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointsByEvent := lo.GroupBy(checkpoints, func(item *entities.AuditCheckpoint) int64 {
+		return item.EventID
+	})
+
+	report := new(models.AuditChainReport)
+	brokenLink := func(eventID int64, reason models.AuditChainBreakReason) *models.AuditChainReport {
+		report.FirstBrokenLink = &models.AuditChainBreak{EventID: strconv.FormatInt(eventID, 10), Reason: reason}
+		return report
+	}
+
+	var previousHash *string
+	var afterID int64
+
+	var lastChainedID int64
+	var firstChainedAt *time.Time
+
+	for {
+		events, err := s.listAuditChainRepository.ListAuditChain(ctx, afterID, auditChainPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range events {
+			report.EventsChecked++
+
+			if reason := checkAuditEvent(event, previousHash); reason != "" {
+				return brokenLink(event.ID, reason), nil
+			}
+
+			for _, checkpoint := range checkpointsByEvent[event.ID] {
+				report.CheckpointsChecked++
+
+				if reason := checkAuditCheckpoint(s.key, checkpoint, event); reason != "" {
+					return brokenLink(event.ID, reason), nil
+				}
+			}
+
+			delete(checkpointsByEvent, event.ID)
+
+			if event.Hash != nil {
+				previousHash = event.Hash
+				lastChainedID = event.ID
+				firstChainedAt = lo.CoalesceOrEmpty(firstChainedAt, event.CreatedAt)
+			}
+			afterID = event.ID
+		}
+
+		if len(events) < auditChainPageSize {
+			break
+		}
+	}
+
+	// Checkpoints are listed by event, so the first remaining one points to the earliest missing event.
+	for _, checkpoint := range checkpoints {
+		if _, ok := checkpointsByEvent[checkpoint.EventID]; ok {
+			return brokenLink(checkpoint.EventID, models.AuditChainBreakCheckpointEventMissing), nil
+		}
+	}
+
+	// Checkpoints are numbered from 1 without gaps, so a hole in the sequence is a deleted checkpoint.
+	bySequence := append([]*entities.AuditCheckpoint{}, checkpoints...)
+	sort.Slice(bySequence, func(i, j int) bool {
+		return bySequence[i].Sequence < bySequence[j].Sequence
+	})
+
+	for i, checkpoint := range bySequence {
+		if checkpoint.Sequence != int64(i+1) {
+			return brokenLink(checkpoint.EventID, models.AuditChainBreakCheckpointMissing), nil
+		}
+	}
+
+	if s.maxCheckpointLag > 0 && lastChainedID != 0 {
+		lastCheckpointAt := firstChainedAt
+		if len(bySequence) > 0 {
+			lastCheckpointAt = bySequence[len(bySequence)-1].CreatedAt
+		}
+
+		if time.Since(lo.FromPtr(lastCheckpointAt)) > s.maxCheckpointLag {
+			return brokenLink(lastChainedID, models.AuditChainBreakCheckpointMissing), nil
+		}
+	}
+
+	return report, nil
+}
+
+func NewVerifyAuditChainService(
+	listAuditChainRepository dao.ListAuditChainRepository,
+	listAuditCheckpointsRepository dao.ListAuditCheckpointsRepository,
+	key []byte,
+	maxCheckpointLag time.Duration,
+) VerifyAuditChainService {
+	return &verifyAuditChainServiceImpl{
+		listAuditChainRepository:       listAuditChainRepository,
+		listAuditCheckpointsRepository: listAuditCheckpointsRepository,
+		key:                            key,
+		maxCheckpointLag:               maxCheckpointLag,
+	}
+}
@@ -0,0 +1,279 @@
+package services_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/in-rich/uservice-authentication/pkg/dao"
+	daomocks "github.com/in-rich/uservice-authentication/pkg/dao/mocks"
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/in-rich/uservice-authentication/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var checkpointKey = []byte("checkpoint-key")
+
+func signCheckpoint(sequence int64, eventID int64, eventHash string) string {
+	mac := hmac.New(sha256.New, checkpointKey)
+	mac.Write([]byte(fmt.Sprintf("%d:%d:%s", sequence, eventID, eventHash)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// buildAuditChain returns chained events with the given IDs, preceded by an event recorded before chaining.
+func buildAuditChain(ids ...int64) []*entities.AuditEvent {
+	events := []*entities.AuditEvent{
+		{ID: 1, Action: models.AuditActionAuthenticate, Outcome: entities.AuditOutcomeFailure},
+	}
+
+	previousHash := ""
+	for _, id := range ids {
+		event := &entities.AuditEvent{
+			ID:           id,
+			SubjectUID:   lo.ToPtr("user-one-uid"),
+			Action:       models.AuditActionUpdateUser,
+			Outcome:      entities.AuditOutcomeSuccess,
+			PreviousHash: lo.ToPtr(previousHash),
+			CreatedAt:    lo.ToPtr(time.Date(2021, 1, int(id), 0, 0, 0, 0, time.UTC)),
+		}
+		event.Hash = lo.ToPtr(dao.HashAuditEvent(event))
+		previousHash = *event.Hash
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func checkpointFor(sequence int64, event *entities.AuditEvent) *entities.AuditCheckpoint {
+	return &entities.AuditCheckpoint{
+		Sequence:  sequence,
+		EventID:   event.ID,
+		EventHash: *event.Hash,
+		Signature: signCheckpoint(sequence, event.ID, *event.Hash),
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	intact := buildAuditChain(2, 3, 4)
+
+	editedEvent := buildAuditChain(2, 3, 4)
+	editedEvent[2].SubjectUID = lo.ToPtr("user-two-uid")
+
+	removedEvent := buildAuditChain(2, 3, 4)
+	removedEvent = append(removedEvent[:2], removedEvent[3])
+
+	// Recomputing the whole chain after an edit keeps it consistent, but no longer matches signed checkpoints.
+	rewritten := buildAuditChain(2, 3, 4)
+	rewritten[2].SubjectUID = lo.ToPtr("user-two-uid")
+	rewritten[2].Hash = lo.ToPtr(dao.HashAuditEvent(rewritten[2]))
+	rewritten[3].PreviousHash = rewritten[2].Hash
+	rewritten[3].Hash = lo.ToPtr(dao.HashAuditEvent(rewritten[3]))
+
+	forgedCheckpoint := checkpointFor(1, rewritten[3])
+	forgedCheckpoint.Signature = "forged-signature"
+
+	missingHash := buildAuditChain(2, 3, 4)
+	missingHash[2].Hash = nil
+
+	// A checkpoint cannot be renumbered without its signature breaking.
+	renumberedCheckpoint := checkpointFor(2, intact[3])
+	renumberedCheckpoint.Sequence = 1
+
+	recentCheckpoint := checkpointFor(1, intact[3])
+	recentCheckpoint.CreatedAt = lo.ToPtr(time.Now())
+
+	testData := []struct {
+		name string
+
+		events           []*entities.AuditEvent
+		checkpoints      []*entities.AuditCheckpoint
+		maxCheckpointLag time.Duration
+
+		expect *models.AuditChainReport
+	}{
+		{
+			name:        "Intact",
+			events:      intact,
+			checkpoints: []*entities.AuditCheckpoint{checkpointFor(1, intact[2]), checkpointFor(2, intact[3])},
+			expect: &models.AuditChainReport{
+				EventsChecked:      4,
+				CheckpointsChecked: 2,
+			},
+		},
+		{
+			name:   "EditedEvent",
+			events: editedEvent,
+			expect: &models.AuditChainReport{
+				EventsChecked:   3,
+				FirstBrokenLink: &models.AuditChainBreak{EventID: "3", Reason: models.AuditChainBreakHashMismatch},
+			},
+		},
+		{
+			name:   "RemovedEvent",
+			events: removedEvent,
+			expect: &models.AuditChainReport{
+				EventsChecked:   3,
+				FirstBrokenLink: &models.AuditChainBreak{EventID: "4", Reason: models.AuditChainBreakPreviousHashMismatch},
+			},
+		},
+		{
+			name:   "MissingHash",
+			events: missingHash,
+			expect: &models.AuditChainReport{
+				EventsChecked:   3,
+				FirstBrokenLink: &models.AuditChainBreak{EventID: "3", Reason: models.AuditChainBreakMissingHash},
+			},
+		},
+		{
+			name:        "RewrittenChain",
+			events:      rewritten,
+			checkpoints: []*entities.AuditCheckpoint{checkpointFor(1, intact[2])},
+			expect: &models.AuditChainReport{
+				EventsChecked:      3,
+				CheckpointsChecked: 1,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "3", Reason: models.AuditChainBreakCheckpointHashMismatch,
+				},
+			},
+		},
+		{
+			name:        "ForgedCheckpoint",
+			events:      rewritten,
+			checkpoints: []*entities.AuditCheckpoint{forgedCheckpoint},
+			expect: &models.AuditChainReport{
+				EventsChecked:      4,
+				CheckpointsChecked: 1,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "4", Reason: models.AuditChainBreakCheckpointSignatureInvalid,
+				},
+			},
+		},
+		{
+			name:   "CheckpointEventMissing",
+			events: intact,
+			checkpoints: []*entities.AuditCheckpoint{
+				{Sequence: 1, EventID: 5, EventHash: "hash-5", Signature: signCheckpoint(1, 5, "hash-5")},
+			},
+			expect: &models.AuditChainReport{
+				EventsChecked: 4,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "5", Reason: models.AuditChainBreakCheckpointEventMissing,
+				},
+			},
+		},
+		{
+			name:        "DeletedCheckpoint",
+			events:      intact,
+			checkpoints: []*entities.AuditCheckpoint{checkpointFor(1, intact[1]), checkpointFor(3, intact[3])},
+			expect: &models.AuditChainReport{
+				EventsChecked:      4,
+				CheckpointsChecked: 2,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "4", Reason: models.AuditChainBreakCheckpointMissing,
+				},
+			},
+		},
+		{
+			name:        "RenumberedCheckpoint",
+			events:      intact,
+			checkpoints: []*entities.AuditCheckpoint{renumberedCheckpoint},
+			expect: &models.AuditChainReport{
+				EventsChecked:      4,
+				CheckpointsChecked: 1,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "4", Reason: models.AuditChainBreakCheckpointSignatureInvalid,
+				},
+			},
+		},
+		{
+			name:             "RecentCheckpoint",
+			events:           intact,
+			checkpoints:      []*entities.AuditCheckpoint{recentCheckpoint},
+			maxCheckpointLag: time.Hour,
+			expect: &models.AuditChainReport{
+				EventsChecked:      4,
+				CheckpointsChecked: 1,
+			},
+		},
+		{
+			// Deleting the latest checkpoints leaves no hole in the sequence, but the chain goes without checkpoint.
+			name:             "StaleCheckpoint",
+			events:           intact,
+			checkpoints:      []*entities.AuditCheckpoint{checkpointFor(1, intact[2])},
+			maxCheckpointLag: time.Hour,
+			expect: &models.AuditChainReport{
+				EventsChecked:      4,
+				CheckpointsChecked: 1,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "4", Reason: models.AuditChainBreakCheckpointMissing,
+				},
+			},
+		},
+		{
+			name:             "NoCheckpoint",
+			events:           intact,
+			maxCheckpointLag: time.Hour,
+			expect: &models.AuditChainReport{
+				EventsChecked: 4,
+				FirstBrokenLink: &models.AuditChainBreak{
+					EventID: "4", Reason: models.AuditChainBreakCheckpointMissing,
+				},
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			listAuditChainRepository := daomocks.NewMockListAuditChainRepository(t)
+			listAuditCheckpointsRepository := daomocks.NewMockListAuditCheckpointsRepository(t)
+
+			listAuditCheckpointsRepository.
+				On("ListAuditCheckpoints", context.TODO()).
+				Return(data.checkpoints, nil)
+
+			listAuditChainRepository.
+				On("ListAuditChain", context.TODO(), int64(0), 500).
+				Return(data.events, nil)
+
+			service := services.NewVerifyAuditChainService(
+				listAuditChainRepository, listAuditCheckpointsRepository, checkpointKey, data.maxCheckpointLag,
+			)
+
+			report, err := service.Exec(context.TODO())
+
+			require.NoError(t, err)
+			require.Equal(t, data.expect, report)
+
+			listAuditChainRepository.AssertExpectations(t)
+			listAuditCheckpointsRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVerifyAuditChainPagination(t *testing.T) {
+	ids := lo.RangeFrom(int64(2), 600)
+	events := buildAuditChain(ids...)
+
+	listAuditChainRepository := daomocks.NewMockListAuditChainRepository(t)
+	listAuditCheckpointsRepository := daomocks.NewMockListAuditCheckpointsRepository(t)
+
+	listAuditCheckpointsRepository.On("ListAuditCheckpoints", context.TODO()).Return(nil, nil)
+	listAuditChainRepository.On("ListAuditChain", context.TODO(), int64(0), 500).Return(events[:500], nil)
+	listAuditChainRepository.On("ListAuditChain", context.TODO(), int64(500), 500).Return(events[500:], nil)
+
+	service := services.NewVerifyAuditChainService(
+		listAuditChainRepository, listAuditCheckpointsRepository, checkpointKey, 0,
+	)
+
+	report, err := service.Exec(context.TODO())
+
+	require.NoError(t, err)
+	require.Equal(t, &models.AuditChainReport{EventsChecked: 601}, report)
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"github.com/in-rich/uservice-authentication/pkg/entities"
+	"github.com/in-rich/uservice-authentication/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// WebhookSecretPrefix is prepended to every webhook signing secret.
+const WebhookSecretPrefix = "inr_whsec_"
+
+const defaultWebhookDeliveriesPageSize = 50
+
+func webhookSubscriptionToModel(subscription *entities.WebhookSubscription) *models.WebhookSubscription {
+	return &models.WebhookSubscription{
+		ID:         subscription.ID.String(),
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+func webhookDeliveryToModel(delivery *entities.WebhookDelivery) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:             delivery.ID.String(),
+		SubscriptionID: delivery.SubscriptionID.String(),
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         models.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  lo.Ternary(delivery.Status == entities.WebhookDeliveryStatusPending, delivery.NextAttemptAt, nil),
+		DeliveredAt:    delivery.DeliveredAt,
+		Log: lo.Map(delivery.Log, func(attempt *entities.WebhookDeliveryAttempt, _ int) *models.WebhookDeliveryAttempt {
+			return &models.WebhookDeliveryAttempt{
+				StatusCode: lo.FromPtr(attempt.StatusCode),
+				Error:      lo.FromPtr(attempt.Error),
+				Duration:   time.Duration(attempt.DurationMS) * time.Millisecond,
+				CreatedAt:  attempt.CreatedAt,
+			}
+		}),
+		CreatedAt: delivery.CreatedAt,
+	}
+}
@@ -8,4 +8,7 @@ trap int_handler INT
 
 docker compose up -d
 
+# The server refuses to start without a key to sign audit checkpoints.
+export AUDIT_CHECKPOINT_KEY="${AUDIT_CHECKPOINT_KEY:-development-checkpoint-key}"
+
 go run cmd/server/main.go
@@ -0,0 +1,12 @@
+package templates
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed emails/*.html
+var emails embed.FS
+
+// Emails contains the email templates, named "<name>.<locale>.html".
+var Emails, _ = fs.Sub(emails, "emails")